@@ -19,6 +19,7 @@ package main
 import (
 	"os"
 
+	"k8s.io/apiextensions-apiserver/pkg/cmd/backup"
 	"k8s.io/apiextensions-apiserver/pkg/cmd/server"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/component-base/cli"
@@ -27,6 +28,7 @@ import (
 func main() {
 	stopCh := genericapiserver.SetupSignalHandler()
 	cmd := server.NewServerCommand(os.Stdout, os.Stderr, stopCh)
+	cmd.AddCommand(backup.NewExportCommand(os.Stdout), backup.NewImportCommand(os.Stdout))
 	code := cli.Run(cmd)
 	os.Exit(code)
 }
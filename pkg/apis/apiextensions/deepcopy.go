@@ -41,6 +41,13 @@ func (in *JSONSchemaProps) DeepCopy() *JSONSchemaProps {
 		out.Example = nil
 	}
 
+	if in.Const != nil {
+		constJSON := JSON(runtime.DeepCopyJSONValue(*(in.Const)))
+		out.Const = &(constJSON)
+	} else {
+		out.Const = nil
+	}
+
 	if in.Ref != nil {
 		in, out := &in.Ref, &out.Ref
 		if *in == nil {
@@ -196,6 +203,46 @@ func (in *JSONSchemaProps) DeepCopy() *JSONSchemaProps {
 		}
 	}
 
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(JSONSchemaProps)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+
+	if in.Then != nil {
+		in, out := &in.Then, &out.Then
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(JSONSchemaProps)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(JSONSchemaProps)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+
+	if in.PropertyNames != nil {
+		in, out := &in.PropertyNames, &out.PropertyNames
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(JSONSchemaProps)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make(map[string]JSONSchemaProps, len(*in))
@@ -230,6 +277,15 @@ func (in *JSONSchemaProps) DeepCopy() *JSONSchemaProps {
 		}
 	}
 
+	if in.DependentRequired != nil {
+		in, out := &in.DependentRequired, &out.DependentRequired
+		*out = make(JSONSchemaDependentRequired, len(*in))
+		for key, val := range *in {
+			(*out)[key] = make([]string, len(val))
+			copy((*out)[key], val)
+		}
+	}
+
 	if in.AdditionalItems != nil {
 		in, out := &in.AdditionalItems, &out.AdditionalItems
 		if *in == nil {
@@ -290,5 +346,47 @@ func (in *JSONSchemaProps) DeepCopy() *JSONSchemaProps {
 		**out = **in
 	}
 
+	if in.XValueType != nil {
+		in, out := &in.XValueType, &out.XValueType
+		*out = new(string)
+		**out = **in
+	}
+
+	if in.XNormalize != nil {
+		in, out := &in.XNormalize, &out.XNormalize
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+
+	if in.XDeprecated != nil {
+		in, out := &in.XDeprecated, &out.XDeprecated
+		*out = new(string)
+		**out = **in
+	}
+
+	if in.XWritePermission != nil {
+		in, out := &in.XWritePermission, &out.XWritePermission
+		*out = new(string)
+		**out = **in
+	}
+
+	if in.XConditions != nil {
+		in, out := &in.XConditions, &out.XConditions
+		*out = new(string)
+		**out = **in
+	}
+
+	if in.XEmbeddedResourceKinds != nil {
+		in, out := &in.XEmbeddedResourceKinds, &out.XEmbeddedResourceKinds
+		*out = make([]EmbeddedResourceKind, len(*in))
+		copy(*out, *in)
+	}
+
+	if in.XReference != nil {
+		in, out := &in.XReference, &out.XReference
+		*out = new(ReferenceTarget)
+		**out = **in
+	}
+
 	return out
 }
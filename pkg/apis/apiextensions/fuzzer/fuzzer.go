@@ -113,7 +113,7 @@ func Funcs(codecs runtimeserializer.CodecFactory) []interface{} {
 			for i := 0; i < tobj.NumField(); i++ {
 				field := tobj.Field(i)
 				switch field.Name {
-				case "Default", "Enum", "Example", "Ref":
+				case "Default", "Enum", "Example", "Const", "Ref":
 					continue
 				default:
 					isValue := true
@@ -137,6 +137,10 @@ func Funcs(codecs runtimeserializer.CodecFactory) []interface{} {
 				validJSON := apiextensions.JSON(`"foobarbaz"`)
 				obj.Example = &validJSON
 			}
+			if c.RandBool() {
+				validJSON := apiextensions.JSON(`"foobarbaz"`)
+				obj.Const = &validJSON
+			}
 			if c.RandBool() {
 				validRef := "validRef"
 				obj.Ref = &validRef
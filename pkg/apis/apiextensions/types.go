@@ -79,6 +79,18 @@ type CustomResourceDefinitionSpec struct {
 	// fields inside metadata are always preserved.
 	// Defaults to true in v1beta and will default to false in v1.
 	PreserveUnknownFields *bool
+
+	// Limits restricts the number and the size of the custom resources.
+	// +optional
+	Limits *CustomResourceLimits
+
+	// Retention keeps deleted custom resources for a while so that they can be restored.
+	// +optional
+	Retention *CustomResourceRetention
+
+	// History keeps the last revisions of the spec of each custom resource.
+	// +optional
+	History *CustomResourceHistory
 }
 
 // CustomResourceConversion describes how to convert different versions of a CR.
@@ -207,6 +219,10 @@ type CustomResourceDefinitionVersion struct {
 	// be explicitly set to null
 	// +optional
 	AdditionalPrinterColumns []CustomResourceColumnDefinition
+	// Generation specifies which changes of this version of the custom resource increment metadata.generation.
+	// By default, every change outside of metadata increments metadata.generation.
+	// +optional
+	Generation *CustomResourceGeneration
 }
 
 // CustomResourceColumnDefinition specifies a column for server side printing.
@@ -386,6 +402,8 @@ type CustomResourceSubresources struct {
 	Status *CustomResourceSubresourceStatus
 	// Scale denotes the scale subresource for CustomResources
 	Scale *CustomResourceSubresourceScale
+	// Custom denotes custom subresources for CustomResources, each serving the value at a JSON path
+	Custom []CustomResourceSubresourceCustom
 }
 
 // CustomResourceSubresourceStatus defines how to serve the status subresource for CustomResources.
@@ -395,6 +413,52 @@ type CustomResourceSubresources struct {
 // * PUT/POST/PATCH requests to the custom resource ignore changes to the status stanza
 type CustomResourceSubresourceStatus struct{}
 
+// CustomResourceSubresourceCustom defines a custom subresource for CustomResources which is
+// represented by a JSON path inside of a CustomResource. When set,
+// * exposes a /<name> subresource for the custom resource
+// * PUT requests to the /<name> subresource take a custom resource object, and ignore changes to anything except the value at the JSON path
+type CustomResourceSubresourceCustom struct {
+	// Name is the name of the subresource, e.g. approval.
+	Name string
+	// JSONPath is the simple JSON path of the value changed by the subresource, e.g. .spec.approval.
+	JSONPath string
+}
+
+// CustomResourceLimits restricts the number and the size of custom resources.
+type CustomResourceLimits struct {
+	// MaxObjects is the maximum number of custom resources in the cluster. It is enforced best-effort.
+	MaxObjects *int64
+	// MaxObjectsPerNamespace is the maximum number of custom resources in a namespace. It is enforced best-effort.
+	MaxObjectsPerNamespace *int64
+	// MaxObjectSizeBytes is the maximum size of the JSON serialization of a custom resource, not its size in storage.
+	MaxObjectSizeBytes *int64
+}
+
+// CustomResourceRetention describes how long deleted custom resources are retained.
+type CustomResourceRetention struct {
+	// TTLSeconds is how long deleted custom resources are retained before they are purged.
+	TTLSeconds int64
+}
+
+// CustomResourceHistory describes how many revisions of the spec of each custom resource are kept.
+type CustomResourceHistory struct {
+	// MaxRevisions is the maximum number of revisions kept for each custom resource.
+	MaxRevisions int32
+}
+
+// CustomResourceGeneration specifies which changes of a custom resource increment metadata.generation.
+// At most one of Paths and IgnoredPaths may be set.
+type CustomResourceGeneration struct {
+	// Paths lists simple JSON paths of the fields holding the desired state, e.g. `.spec`.
+	// Only changes within these fields increment metadata.generation.
+	// +optional
+	Paths []string
+	// IgnoredPaths lists simple JSON paths of fields whose changes do not increment metadata.generation,
+	// e.g. `.spec.cache`. Changes of all other fields outside of metadata increment metadata.generation.
+	// +optional
+	IgnoredPaths []string
+}
+
 // CustomResourceSubresourceScale defines how to serve the scale subresource for CustomResources.
 type CustomResourceSubresourceScale struct {
 	// SpecReplicasPath defines the JSON path inside of a CustomResource that corresponds to Scale.Spec.Replicas.
@@ -55,6 +55,12 @@ type JSONSchemaProps struct {
 	Definitions          JSONSchemaDefinitions
 	ExternalDocs         *ExternalDocumentation
 	Example              *JSON
+	Const                *JSON
+	If                   *JSONSchemaProps
+	Then                 *JSONSchemaProps
+	Else                 *JSONSchemaProps
+	DependentRequired    JSONSchemaDependentRequired
+	PropertyNames        *JSONSchemaProps
 
 	// x-kubernetes-preserve-unknown-fields stops the API server
 	// decoding step from pruning fields which are not specified
@@ -130,6 +136,83 @@ type JSONSchemaProps struct {
 	// +listType=map
 	// +listMapKey=rule
 	XValidations ValidationRules
+
+	// x-kubernetes-value-type marks a string or int-or-string value as a Kubernetes value type.
+	// The value is canonicalized on write, typed in validation rules and rendered in printer columns.
+	// Possible values are:
+	//
+	// 1) `quantity`: the value is a resource quantity, e.g. `500m` or `1Gi`. Validation
+	//      rules see the value as double.
+	// 2) `duration`: the value is a duration, e.g. `1h30m`. Integers are seconds. Validation
+	//      rules see the value as duration.
+	// +optional
+	XValueType *string
+
+	// x-kubernetes-normalize lists normalizations applied to a string value on write, before
+	// validation. Possible values are:
+	//
+	// 1) `trim`: leading and trailing whitespace is removed.
+	// 2) `collapse-whitespace`: runs of whitespace are replaced by a single space.
+	// 3) `lowercase`: the value is converted to lower case.
+	// 4) `nfc`: the value is converted to Unicode normalization form C.
+	//
+	// Normalizations are applied in the order above, independently of the order they are listed in.
+	// This extension must only be used on strings.
+	// +optional
+	// +listType=set
+	XNormalize []string
+
+	// x-kubernetes-deprecated marks the field as deprecated. The value is a non-empty message
+	// returned as a warning to clients setting the field, on create or by changing its value on
+	// update, to a value other than its default, e.g. "spec.foo is deprecated, use spec.bar instead".
+	// +optional
+	XDeprecated *string
+
+	// x-kubernetes-sensitive marks the value of the field as sensitive, e.g. a token or a
+	// connection string. The value is replaced with a placeholder in validation errors, in
+	// printer columns and in errors of conversion webhooks. If the field is an object or an
+	// array, this applies to all values nested in it.
+	// +optional
+	XSensitive bool
+
+	// x-kubernetes-write-permission names a subresource-style permission required to change the
+	// value of the field on update, e.g. `fields/tier`. Requesters changing the field must be
+	// authorized for the `update` verb on that subresource of the custom resource, e.g.
+	// `foos/fields/tier`. Other fields, and fields nested in the field with their own permission,
+	// are not affected.
+	// +optional
+	XWritePermission *string
+
+	// x-kubernetes-conditions marks a list as a list of conditions with the shape of the
+	// Kubernetes Condition type, keyed by `type`. On creates and updates, through the main resource
+	// as well as the status and custom subresources, the lastTransitionTime of a condition is set by
+	// the server whenever its status changes, and is kept otherwise. Possible values are:
+	//
+	// 1) `transitionTime`: only lastTransitionTime is set by the server.
+	// 2) `observedGeneration`: additionally, observedGeneration of all conditions is set to
+	//      metadata.generation on updates of the status subresource.
+	//
+	// This extension must only be used on lists with x-kubernetes-list-type `map` and
+	// x-kubernetes-list-map-keys `type`.
+	// +optional
+	XConditions *string
+
+	// x-kubernetes-embedded-resource-kinds lists the kinds allowed for an embedded resource. It
+	// must only be used together with x-kubernetes-embedded-resource. The embedded object must be
+	// of one of the listed kinds, and is pruned, defaulted and validated against the schema of
+	// that kind, as if it had been submitted on its own. Schemas of custom resources are taken
+	// from their CustomResourceDefinitions, schemas of other kinds from the OpenAPI models of the
+	// server.
+	// +optional
+	XEmbeddedResourceKinds []EmbeddedResourceKind
+
+	// x-kubernetes-reference marks a string field as holding the name of another object, the
+	// referent, of the given group, resource and scope. Referents of namespaced resources are
+	// looked up in the namespace of the referring object. Depending on the policy, creates and
+	// updates setting the field to the name of a referent which does not exist are warned about
+	// or rejected. The relationship is published in OpenAPI.
+	// +optional
+	XReference *ReferenceTarget
 }
 
 // ValidationRules describes a list of validation rules written in the CEL expression language.
@@ -228,9 +311,45 @@ type JSONSchemaPropsOrStringArray struct {
 	Property []string
 }
 
+// JSONSchemaDependentRequired represents a dependentRequired property.
+type JSONSchemaDependentRequired map[string][]string
+
 // JSONSchemaDefinitions contains the models explicitly defined in this spec.
 type JSONSchemaDefinitions map[string]JSONSchemaProps
 
+// EmbeddedResourceKind is a kind allowed for an embedded resource.
+type EmbeddedResourceKind struct {
+	// APIVersion is the group and version of the kind, e.g. apps/v1.
+	APIVersion string
+	// Kind is the kind, e.g. Deployment.
+	Kind string
+}
+
+// ReferenceTarget is the target of a reference field.
+type ReferenceTarget struct {
+	// Group is the API group of the referent. The resource must be served by a
+	// CustomResourceDefinition, so the group contains a dot and is not a Kubernetes group.
+	Group string
+	// Resource is the plural resource name of the referent.
+	Resource string
+	// Scope is the scope of the resource of the referent, Namespaced or Cluster.
+	Scope ResourceScope
+	// Policy is the policy for references to objects which do not exist.
+	Policy ReferencePolicy
+}
+
+// ReferencePolicy is the policy for references to objects which do not exist.
+type ReferencePolicy string
+
+const (
+	// ReferencePolicyIgnore means that the referent is not looked up.
+	ReferencePolicyIgnore ReferencePolicy = "Ignore"
+	// ReferencePolicyWarn means that a warning is returned if the referent does not exist.
+	ReferencePolicyWarn ReferencePolicy = "Warn"
+	// ReferencePolicyReject means that the request is rejected if the referent does not exist.
+	ReferencePolicyReject ReferencePolicy = "Reject"
+)
+
 // ExternalDocumentation allows referencing an external resource for extended documentation.
 type ExternalDocumentation struct {
 	Description string
@@ -384,10 +384,150 @@ func (m *CustomResourceDefinitionVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CustomResourceDefinitionVersion proto.InternalMessageInfo
 
+func (m *CustomResourceGeneration) Reset()      { *m = CustomResourceGeneration{} }
+func (*CustomResourceGeneration) ProtoMessage() {}
+func (*CustomResourceGeneration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{12}
+}
+func (m *CustomResourceGeneration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceGeneration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceGeneration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceGeneration.Merge(m, src)
+}
+func (m *CustomResourceGeneration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceGeneration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceGeneration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceGeneration proto.InternalMessageInfo
+
+func (m *CustomResourceHistory) Reset()      { *m = CustomResourceHistory{} }
+func (*CustomResourceHistory) ProtoMessage() {}
+func (*CustomResourceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{13}
+}
+func (m *CustomResourceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceHistory.Merge(m, src)
+}
+func (m *CustomResourceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceHistory proto.InternalMessageInfo
+
+func (m *CustomResourceLimits) Reset()      { *m = CustomResourceLimits{} }
+func (*CustomResourceLimits) ProtoMessage() {}
+func (*CustomResourceLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{14}
+}
+func (m *CustomResourceLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceLimits.Merge(m, src)
+}
+func (m *CustomResourceLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceLimits proto.InternalMessageInfo
+
+func (m *CustomResourceRetention) Reset()      { *m = CustomResourceRetention{} }
+func (*CustomResourceRetention) ProtoMessage() {}
+func (*CustomResourceRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{15}
+}
+func (m *CustomResourceRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceRetention.Merge(m, src)
+}
+func (m *CustomResourceRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceRetention proto.InternalMessageInfo
+
+func (m *CustomResourceSubresourceCustom) Reset()      { *m = CustomResourceSubresourceCustom{} }
+func (*CustomResourceSubresourceCustom) ProtoMessage() {}
+func (*CustomResourceSubresourceCustom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{16}
+}
+func (m *CustomResourceSubresourceCustom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceSubresourceCustom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceSubresourceCustom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceSubresourceCustom.Merge(m, src)
+}
+func (m *CustomResourceSubresourceCustom) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceSubresourceCustom) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceSubresourceCustom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceSubresourceCustom proto.InternalMessageInfo
+
 func (m *CustomResourceSubresourceScale) Reset()      { *m = CustomResourceSubresourceScale{} }
 func (*CustomResourceSubresourceScale) ProtoMessage() {}
 func (*CustomResourceSubresourceScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{12}
+	return fileDescriptor_f5a35c9667703937, []int{17}
 }
 func (m *CustomResourceSubresourceScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +555,7 @@ var xxx_messageInfo_CustomResourceSubresourceScale proto.InternalMessageInfo
 func (m *CustomResourceSubresourceStatus) Reset()      { *m = CustomResourceSubresourceStatus{} }
 func (*CustomResourceSubresourceStatus) ProtoMessage() {}
 func (*CustomResourceSubresourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{13}
+	return fileDescriptor_f5a35c9667703937, []int{18}
 }
 func (m *CustomResourceSubresourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +583,7 @@ var xxx_messageInfo_CustomResourceSubresourceStatus proto.InternalMessageInfo
 func (m *CustomResourceSubresources) Reset()      { *m = CustomResourceSubresources{} }
 func (*CustomResourceSubresources) ProtoMessage() {}
 func (*CustomResourceSubresources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{14}
+	return fileDescriptor_f5a35c9667703937, []int{19}
 }
 func (m *CustomResourceSubresources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +611,7 @@ var xxx_messageInfo_CustomResourceSubresources proto.InternalMessageInfo
 func (m *CustomResourceValidation) Reset()      { *m = CustomResourceValidation{} }
 func (*CustomResourceValidation) ProtoMessage() {}
 func (*CustomResourceValidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{15}
+	return fileDescriptor_f5a35c9667703937, []int{20}
 }
 func (m *CustomResourceValidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,10 +636,38 @@ func (m *CustomResourceValidation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CustomResourceValidation proto.InternalMessageInfo
 
+func (m *EmbeddedResourceKind) Reset()      { *m = EmbeddedResourceKind{} }
+func (*EmbeddedResourceKind) ProtoMessage() {}
+func (*EmbeddedResourceKind) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{21}
+}
+func (m *EmbeddedResourceKind) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmbeddedResourceKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EmbeddedResourceKind) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmbeddedResourceKind.Merge(m, src)
+}
+func (m *EmbeddedResourceKind) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmbeddedResourceKind) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmbeddedResourceKind.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmbeddedResourceKind proto.InternalMessageInfo
+
 func (m *ExternalDocumentation) Reset()      { *m = ExternalDocumentation{} }
 func (*ExternalDocumentation) ProtoMessage() {}
 func (*ExternalDocumentation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{16}
+	return fileDescriptor_f5a35c9667703937, []int{22}
 }
 func (m *ExternalDocumentation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +695,7 @@ var xxx_messageInfo_ExternalDocumentation proto.InternalMessageInfo
 func (m *JSON) Reset()      { *m = JSON{} }
 func (*JSON) ProtoMessage() {}
 func (*JSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{17}
+	return fileDescriptor_f5a35c9667703937, []int{23}
 }
 func (m *JSON) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,10 +720,38 @@ func (m *JSON) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JSON proto.InternalMessageInfo
 
+func (m *JSONSchemaPropertyNames) Reset()      { *m = JSONSchemaPropertyNames{} }
+func (*JSONSchemaPropertyNames) ProtoMessage() {}
+func (*JSONSchemaPropertyNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{24}
+}
+func (m *JSONSchemaPropertyNames) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONSchemaPropertyNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JSONSchemaPropertyNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONSchemaPropertyNames.Merge(m, src)
+}
+func (m *JSONSchemaPropertyNames) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONSchemaPropertyNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONSchemaPropertyNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONSchemaPropertyNames proto.InternalMessageInfo
+
 func (m *JSONSchemaProps) Reset()      { *m = JSONSchemaProps{} }
 func (*JSONSchemaProps) ProtoMessage() {}
 func (*JSONSchemaProps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{18}
+	return fileDescriptor_f5a35c9667703937, []int{25}
 }
 func (m *JSONSchemaProps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +779,7 @@ var xxx_messageInfo_JSONSchemaProps proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrArray) Reset()      { *m = JSONSchemaPropsOrArray{} }
 func (*JSONSchemaPropsOrArray) ProtoMessage() {}
 func (*JSONSchemaPropsOrArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{19}
+	return fileDescriptor_f5a35c9667703937, []int{26}
 }
 func (m *JSONSchemaPropsOrArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +807,7 @@ var xxx_messageInfo_JSONSchemaPropsOrArray proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrBool) Reset()      { *m = JSONSchemaPropsOrBool{} }
 func (*JSONSchemaPropsOrBool) ProtoMessage() {}
 func (*JSONSchemaPropsOrBool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{20}
+	return fileDescriptor_f5a35c9667703937, []int{27}
 }
 func (m *JSONSchemaPropsOrBool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +835,7 @@ var xxx_messageInfo_JSONSchemaPropsOrBool proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrStringArray) Reset()      { *m = JSONSchemaPropsOrStringArray{} }
 func (*JSONSchemaPropsOrStringArray) ProtoMessage() {}
 func (*JSONSchemaPropsOrStringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{21}
+	return fileDescriptor_f5a35c9667703937, []int{28}
 }
 func (m *JSONSchemaPropsOrStringArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,10 +860,38 @@ func (m *JSONSchemaPropsOrStringArray) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JSONSchemaPropsOrStringArray proto.InternalMessageInfo
 
+func (m *ReferenceTarget) Reset()      { *m = ReferenceTarget{} }
+func (*ReferenceTarget) ProtoMessage() {}
+func (*ReferenceTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a35c9667703937, []int{29}
+}
+func (m *ReferenceTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReferenceTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceTarget.Merge(m, src)
+}
+func (m *ReferenceTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceTarget proto.InternalMessageInfo
+
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{22}
+	return fileDescriptor_f5a35c9667703937, []int{30}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +919,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *ValidationRule) Reset()      { *m = ValidationRule{} }
 func (*ValidationRule) ProtoMessage() {}
 func (*ValidationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{23}
+	return fileDescriptor_f5a35c9667703937, []int{31}
 }
 func (m *ValidationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +947,7 @@ var xxx_messageInfo_ValidationRule proto.InternalMessageInfo
 func (m *WebhookClientConfig) Reset()      { *m = WebhookClientConfig{} }
 func (*WebhookClientConfig) ProtoMessage() {}
 func (*WebhookClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{24}
+	return fileDescriptor_f5a35c9667703937, []int{32}
 }
 func (m *WebhookClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +975,7 @@ var xxx_messageInfo_WebhookClientConfig proto.InternalMessageInfo
 func (m *WebhookConversion) Reset()      { *m = WebhookConversion{} }
 func (*WebhookConversion) ProtoMessage() {}
 func (*WebhookConversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5a35c9667703937, []int{25}
+	return fileDescriptor_f5a35c9667703937, []int{33}
 }
 func (m *WebhookConversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,20 +1013,29 @@ func init() {
 	proto.RegisterType((*CustomResourceDefinitionSpec)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceDefinitionSpec")
 	proto.RegisterType((*CustomResourceDefinitionStatus)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceDefinitionStatus")
 	proto.RegisterType((*CustomResourceDefinitionVersion)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceDefinitionVersion")
+	proto.RegisterType((*CustomResourceGeneration)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceGeneration")
+	proto.RegisterType((*CustomResourceHistory)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceHistory")
+	proto.RegisterType((*CustomResourceLimits)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceLimits")
+	proto.RegisterType((*CustomResourceRetention)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceRetention")
+	proto.RegisterType((*CustomResourceSubresourceCustom)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceSubresourceCustom")
 	proto.RegisterType((*CustomResourceSubresourceScale)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceSubresourceScale")
 	proto.RegisterType((*CustomResourceSubresourceStatus)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceSubresourceStatus")
 	proto.RegisterType((*CustomResourceSubresources)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceSubresources")
 	proto.RegisterType((*CustomResourceValidation)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.CustomResourceValidation")
+	proto.RegisterType((*EmbeddedResourceKind)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.EmbeddedResourceKind")
 	proto.RegisterType((*ExternalDocumentation)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.ExternalDocumentation")
 	proto.RegisterType((*JSON)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON")
+	proto.RegisterType((*JSONSchemaPropertyNames)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropertyNames")
 	proto.RegisterType((*JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps")
 	proto.RegisterMapType((JSONSchemaDefinitions)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps.DefinitionsEntry")
 	proto.RegisterMapType((JSONSchemaDependencies)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps.DependenciesEntry")
+	proto.RegisterMapType((JSONSchemaDependentRequired)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps.DependentRequiredEntry")
 	proto.RegisterMapType((map[string]JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps.PatternPropertiesEntry")
 	proto.RegisterMapType((map[string]JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps.PropertiesEntry")
 	proto.RegisterType((*JSONSchemaPropsOrArray)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropsOrArray")
 	proto.RegisterType((*JSONSchemaPropsOrBool)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropsOrBool")
 	proto.RegisterType((*JSONSchemaPropsOrStringArray)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropsOrStringArray")
+	proto.RegisterType((*ReferenceTarget)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.ReferenceTarget")
 	proto.RegisterType((*ServiceReference)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.ServiceReference")
 	proto.RegisterType((*ValidationRule)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.ValidationRule")
 	proto.RegisterType((*WebhookClientConfig)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.WebhookClientConfig")
@@ -814,198 +1047,242 @@ func init() {
 }
 
 var fileDescriptor_f5a35c9667703937 = []byte{
-	// 3046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdf, 0x6f, 0x24, 0x47,
-	0xf1, 0xbf, 0x59, 0x7b, 0xed, 0x75, 0xdb, 0x3e, 0xdb, 0x7d, 0x67, 0x7f, 0xe7, 0x9c, 0x3b, 0xaf,
-	0x6f, 0xf3, 0xcd, 0x7d, 0x9d, 0xe4, 0xb2, 0xce, 0xf9, 0x9b, 0x90, 0x10, 0x21, 0x90, 0xd7, 0xf6,
-	0x25, 0xce, 0xd9, 0x67, 0xab, 0xf7, 0xee, 0xe2, 0x24, 0xa0, 0x64, 0xbc, 0xd3, 0x5e, 0x4f, 0x3c,
-	0xbf, 0xae, 0x7b, 0x66, 0x6d, 0x4b, 0x20, 0x45, 0xa0, 0x08, 0x88, 0x04, 0xe1, 0x01, 0x85, 0x27,
-	0x84, 0x10, 0xca, 0x03, 0x3c, 0xc0, 0x1b, 0xfc, 0x0b, 0x79, 0x41, 0xca, 0x13, 0x8a, 0x84, 0xb4,
-	0x22, 0xcb, 0x3f, 0x80, 0x04, 0x08, 0xe1, 0x07, 0x84, 0xfa, 0xc7, 0xf4, 0xf4, 0xce, 0xee, 0xe6,
-	0x4e, 0xe7, 0x75, 0xf2, 0x66, 0x57, 0x55, 0xd7, 0xa7, 0xba, 0xba, 0xba, 0xaa, 0xba, 0x66, 0x81,
-	0x75, 0xf0, 0x22, 0x2d, 0x3b, 0xc1, 0xe2, 0x41, 0xbc, 0x8b, 0x89, 0x8f, 0x23, 0x4c, 0x17, 0x1b,
-	0xd8, 0xb7, 0x03, 0xb2, 0x28, 0x19, 0x56, 0xe8, 0xe0, 0xa3, 0x08, 0xfb, 0xd4, 0x09, 0x7c, 0xfa,
-	0x8c, 0x15, 0x3a, 0x14, 0x93, 0x06, 0x26, 0x8b, 0xe1, 0x41, 0x9d, 0xf1, 0x68, 0xbb, 0xc0, 0x62,
-	0xe3, 0xc6, 0x62, 0x1d, 0xfb, 0x98, 0x58, 0x11, 0xb6, 0xcb, 0x21, 0x09, 0xa2, 0x00, 0xbe, 0x28,
-	0x34, 0x95, 0xdb, 0x04, 0xdf, 0x52, 0x9a, 0xca, 0xe1, 0x41, 0x9d, 0xf1, 0x68, 0xbb, 0x40, 0xb9,
-	0x71, 0x63, 0xf6, 0x99, 0xba, 0x13, 0xed, 0xc7, 0xbb, 0xe5, 0x5a, 0xe0, 0x2d, 0xd6, 0x83, 0x7a,
-	0xb0, 0xc8, 0x15, 0xee, 0xc6, 0x7b, 0xfc, 0x3f, 0xfe, 0x0f, 0xff, 0x4b, 0x00, 0xcd, 0x3e, 0x97,
-	0x9a, 0xec, 0x59, 0xb5, 0x7d, 0xc7, 0xc7, 0xe4, 0x38, 0xb5, 0xd3, 0xc3, 0x91, 0xd5, 0xc5, 0xbc,
-	0xd9, 0xc5, 0x5e, 0xab, 0x48, 0xec, 0x47, 0x8e, 0x87, 0x3b, 0x16, 0x7c, 0xe5, 0x41, 0x0b, 0x68,
-	0x6d, 0x1f, 0x7b, 0x56, 0x76, 0x5d, 0xe9, 0xc4, 0x00, 0x53, 0x2b, 0x81, 0xdf, 0xc0, 0x84, 0x6d,
-	0x10, 0xe1, 0xfb, 0x31, 0xa6, 0x11, 0xac, 0x80, 0x81, 0xd8, 0xb1, 0x4d, 0x63, 0xde, 0x58, 0x18,
-	0xa9, 0x3c, 0xfb, 0x71, 0xb3, 0x78, 0xae, 0xd5, 0x2c, 0x0e, 0xdc, 0x5d, 0x5f, 0x3d, 0x69, 0x16,
-	0xaf, 0xf6, 0x42, 0x8a, 0x8e, 0x43, 0x4c, 0xcb, 0x77, 0xd7, 0x57, 0x11, 0x5b, 0x0c, 0x5f, 0x06,
-	0x53, 0x36, 0xa6, 0x0e, 0xc1, 0xf6, 0xf2, 0xf6, 0xfa, 0x3d, 0xa1, 0xdf, 0xcc, 0x71, 0x8d, 0x97,
-	0xa4, 0xc6, 0xa9, 0xd5, 0xac, 0x00, 0xea, 0x5c, 0x03, 0x77, 0xc0, 0x70, 0xb0, 0xfb, 0x0e, 0xae,
-	0x45, 0xd4, 0x1c, 0x98, 0x1f, 0x58, 0x18, 0x5d, 0x7a, 0xa6, 0x9c, 0x1e, 0x9e, 0x32, 0x81, 0x9f,
-	0x98, 0xdc, 0x6c, 0x19, 0x59, 0x87, 0x6b, 0xc9, 0xa1, 0x55, 0x26, 0x24, 0xda, 0xf0, 0x96, 0xd0,
-	0x82, 0x12, 0x75, 0xa5, 0x5f, 0xe5, 0x00, 0xd4, 0x37, 0x4f, 0xc3, 0xc0, 0xa7, 0xb8, 0x2f, 0xbb,
-	0xa7, 0x60, 0xb2, 0xc6, 0x35, 0x47, 0xd8, 0x96, 0xb8, 0x66, 0xee, 0x51, 0xac, 0x37, 0x25, 0xfe,
-	0xe4, 0x4a, 0x46, 0x1d, 0xea, 0x00, 0x80, 0x77, 0xc0, 0x10, 0xc1, 0x34, 0x76, 0x23, 0x73, 0x60,
-	0xde, 0x58, 0x18, 0x5d, 0xba, 0xde, 0x13, 0x8a, 0x87, 0x36, 0x0b, 0xbe, 0x72, 0xe3, 0x46, 0xb9,
-	0x1a, 0x59, 0x51, 0x4c, 0x2b, 0xe7, 0x25, 0xd2, 0x10, 0xe2, 0x3a, 0x90, 0xd4, 0x55, 0xfa, 0x8f,
-	0x01, 0x26, 0x75, 0x2f, 0x35, 0x1c, 0x7c, 0x08, 0x09, 0x18, 0x26, 0x22, 0x58, 0xb8, 0x9f, 0x46,
-	0x97, 0x6e, 0x95, 0x1f, 0xf5, 0x46, 0x95, 0x3b, 0xe2, 0xaf, 0x32, 0xca, 0x8e, 0x4b, 0xfe, 0x83,
-	0x12, 0x20, 0xd8, 0x00, 0x05, 0x22, 0xcf, 0x88, 0x07, 0xd2, 0xe8, 0xd2, 0x46, 0x7f, 0x40, 0x85,
-	0xce, 0xca, 0x58, 0xab, 0x59, 0x2c, 0x24, 0xff, 0x21, 0x85, 0x55, 0xfa, 0x45, 0x0e, 0xcc, 0xad,
-	0xc4, 0x34, 0x0a, 0x3c, 0x84, 0x69, 0x10, 0x93, 0x1a, 0x5e, 0x09, 0xdc, 0xd8, 0xf3, 0x57, 0xf1,
-	0x9e, 0xe3, 0x3b, 0x11, 0x8b, 0xd1, 0x79, 0x30, 0xe8, 0x5b, 0x1e, 0x96, 0x31, 0x33, 0x26, 0x3d,
-	0x39, 0x78, 0xdb, 0xf2, 0x30, 0xe2, 0x1c, 0x26, 0xc1, 0x42, 0x44, 0xde, 0x00, 0x25, 0x71, 0xe7,
-	0x38, 0xc4, 0x88, 0x73, 0xe0, 0x35, 0x30, 0xb4, 0x17, 0x10, 0xcf, 0x12, 0xa7, 0x37, 0x92, 0x9e,
-	0xc7, 0x4d, 0x4e, 0x45, 0x92, 0x0b, 0x9f, 0x07, 0xa3, 0x36, 0xa6, 0x35, 0xe2, 0x84, 0x0c, 0xda,
-	0x1c, 0xe4, 0xc2, 0x17, 0xa4, 0xf0, 0xe8, 0x6a, 0xca, 0x42, 0xba, 0x1c, 0xbc, 0x0e, 0x0a, 0x21,
-	0x71, 0x02, 0xe2, 0x44, 0xc7, 0x66, 0x7e, 0xde, 0x58, 0xc8, 0x57, 0x26, 0xe5, 0x9a, 0xc2, 0xb6,
-	0xa4, 0x23, 0x25, 0xc1, 0xa4, 0xdf, 0xa1, 0x81, 0xbf, 0x6d, 0x45, 0xfb, 0xe6, 0x10, 0x47, 0x50,
-	0xd2, 0xaf, 0x56, 0xb7, 0x6e, 0x33, 0x3a, 0x52, 0x12, 0xa5, 0x3f, 0x19, 0xc0, 0xcc, 0x7a, 0x28,
-	0x71, 0x2f, 0xbc, 0x09, 0x0a, 0x34, 0x62, 0x39, 0xa7, 0x7e, 0x2c, 0xfd, 0xf3, 0x54, 0xa2, 0xaa,
-	0x2a, 0xe9, 0x27, 0xcd, 0xe2, 0x4c, 0xba, 0x22, 0xa1, 0x72, 0xdf, 0xa8, 0xb5, 0x2c, 0xe4, 0x0e,
-	0xf1, 0xee, 0x7e, 0x10, 0x1c, 0xc8, 0xd3, 0x3f, 0x45, 0xc8, 0xbd, 0x26, 0x14, 0xa5, 0x98, 0x22,
-	0xe4, 0x24, 0x19, 0x25, 0x40, 0xa5, 0x7f, 0xe7, 0xb2, 0x1b, 0xd3, 0x0e, 0xfd, 0x6d, 0x50, 0x60,
-	0x57, 0xc8, 0xb6, 0x22, 0x4b, 0x5e, 0x82, 0x67, 0x1f, 0xee, 0xc2, 0x89, 0xfb, 0xba, 0x89, 0x23,
-	0xab, 0x02, 0xa5, 0x2b, 0x40, 0x4a, 0x43, 0x4a, 0x2b, 0x3c, 0x02, 0x83, 0x34, 0xc4, 0x35, 0xb9,
-	0xdf, 0x7b, 0xa7, 0x88, 0xf6, 0x1e, 0x7b, 0xa8, 0x86, 0xb8, 0x96, 0x06, 0x23, 0xfb, 0x0f, 0x71,
-	0x44, 0xf8, 0xae, 0x01, 0x86, 0x28, 0xcf, 0x0b, 0x32, 0x97, 0xec, 0x9c, 0x01, 0x78, 0x26, 0xef,
-	0x88, 0xff, 0x91, 0xc4, 0x2d, 0xfd, 0x23, 0x07, 0xae, 0xf6, 0x5a, 0xba, 0x12, 0xf8, 0xb6, 0x38,
-	0x84, 0x75, 0x79, 0xaf, 0x44, 0x64, 0x3d, 0xaf, 0xdf, 0xab, 0x93, 0x66, 0xf1, 0x89, 0x07, 0x2a,
-	0xd0, 0x2e, 0xe0, 0x57, 0xd5, 0x96, 0xc5, 0x25, 0xbd, 0xda, 0x6e, 0xd8, 0x49, 0xb3, 0x38, 0xa1,
-	0x96, 0xb5, 0xdb, 0x0a, 0x1b, 0x00, 0xba, 0x16, 0x8d, 0xee, 0x10, 0xcb, 0xa7, 0x42, 0xad, 0xe3,
-	0x61, 0xe9, 0xb9, 0xa7, 0x1e, 0x2e, 0x28, 0xd8, 0x8a, 0xca, 0xac, 0x84, 0x84, 0x1b, 0x1d, 0xda,
-	0x50, 0x17, 0x04, 0x96, 0x33, 0x08, 0xb6, 0xa8, 0x4a, 0x03, 0x5a, 0x0e, 0x67, 0x54, 0x24, 0xb9,
-	0xf0, 0x49, 0x30, 0xec, 0x61, 0x4a, 0xad, 0x3a, 0xe6, 0x77, 0x7f, 0x24, 0x2d, 0x8a, 0x9b, 0x82,
-	0x8c, 0x12, 0x7e, 0xe9, 0x9f, 0x06, 0xb8, 0xdc, 0xcb, 0x6b, 0x1b, 0x0e, 0x8d, 0xe0, 0x37, 0x3b,
-	0xc2, 0xbe, 0xfc, 0x70, 0x3b, 0x64, 0xab, 0x79, 0xd0, 0xab, 0x54, 0x92, 0x50, 0xb4, 0x90, 0x3f,
-	0x04, 0x79, 0x27, 0xc2, 0x5e, 0x52, 0x2d, 0x51, 0xff, 0xc3, 0xae, 0x32, 0x2e, 0xe1, 0xf3, 0xeb,
-	0x0c, 0x08, 0x09, 0xbc, 0xd2, 0x47, 0x39, 0x70, 0xa5, 0xd7, 0x12, 0x96, 0xc7, 0x29, 0x73, 0x76,
-	0xe8, 0xc6, 0xc4, 0x72, 0x65, 0xb0, 0x29, 0x67, 0x6f, 0x73, 0x2a, 0x92, 0x5c, 0x96, 0x3b, 0xa9,
-	0xe3, 0xd7, 0x63, 0xd7, 0x22, 0x32, 0x92, 0xd4, 0x86, 0xab, 0x92, 0x8e, 0x94, 0x04, 0x2c, 0x03,
-	0x40, 0xf7, 0x03, 0x12, 0x71, 0x0c, 0xde, 0xe1, 0x8c, 0x54, 0xce, 0xb3, 0x8c, 0x50, 0x55, 0x54,
-	0xa4, 0x49, 0xb0, 0x42, 0x72, 0xe0, 0xf8, 0xb6, 0x3c, 0x70, 0x75, 0x77, 0x6f, 0x39, 0xbe, 0x8d,
-	0x38, 0x87, 0xe1, 0xbb, 0x0e, 0x8d, 0x18, 0x45, 0x9e, 0x76, 0x9b, 0xc3, 0xb9, 0xa4, 0x92, 0x60,
-	0xf8, 0x35, 0x96, 0x60, 0x03, 0xe2, 0x60, 0x6a, 0x0e, 0xa5, 0xf8, 0x2b, 0x8a, 0x8a, 0x34, 0x89,
-	0xd2, 0x9f, 0x07, 0x7b, 0xc7, 0x07, 0x4b, 0x20, 0xf0, 0x71, 0x90, 0xaf, 0x93, 0x20, 0x0e, 0xa5,
-	0x97, 0x94, 0xb7, 0x5f, 0x66, 0x44, 0x24, 0x78, 0xf0, 0xdb, 0x20, 0xef, 0xcb, 0x0d, 0xb3, 0x08,
-	0x7a, 0xad, 0xff, 0xc7, 0xcc, 0xbd, 0x95, 0xa2, 0x0b, 0x47, 0x0a, 0x50, 0xf8, 0x1c, 0xc8, 0xd3,
-	0x5a, 0x10, 0x62, 0xe9, 0xc4, 0xb9, 0x44, 0xa8, 0xca, 0x88, 0x27, 0xcd, 0xe2, 0x78, 0xa2, 0x8e,
-	0x13, 0x90, 0x10, 0x86, 0xdf, 0x37, 0x40, 0x41, 0x96, 0x0b, 0x6a, 0x0e, 0xf3, 0xf0, 0x7c, 0xbd,
-	0xff, 0x76, 0xcb, 0xb6, 0x37, 0x3d, 0x33, 0x49, 0xa0, 0x48, 0x81, 0xc3, 0xef, 0x1a, 0x00, 0xd4,
-	0x54, 0xed, 0x32, 0x47, 0xb8, 0x0f, 0xfb, 0x76, 0x55, 0xb4, 0xaa, 0x28, 0x02, 0x21, 0x6d, 0x95,
-	0x34, 0x54, 0x58, 0x05, 0xd3, 0x21, 0xc1, 0x5c, 0xf7, 0x5d, 0xff, 0xc0, 0x0f, 0x0e, 0xfd, 0x9b,
-	0x0e, 0x76, 0x6d, 0x6a, 0x82, 0x79, 0x63, 0xa1, 0x50, 0xb9, 0x22, 0xed, 0x9f, 0xde, 0xee, 0x26,
-	0x84, 0xba, 0xaf, 0x2d, 0xbd, 0x37, 0x90, 0xed, 0xb5, 0xb2, 0xf5, 0x02, 0x7e, 0x20, 0x36, 0x2f,
-	0xf2, 0x30, 0x35, 0x0d, 0x7e, 0x10, 0x6f, 0xf6, 0xff, 0x20, 0x54, 0xae, 0x4f, 0x8b, 0xb4, 0x22,
-	0x51, 0xa4, 0x99, 0x00, 0x7f, 0x6a, 0x80, 0x71, 0xab, 0x56, 0xc3, 0x61, 0x84, 0x6d, 0x71, 0x8d,
-	0x73, 0x67, 0x1b, 0xd5, 0xd3, 0xd2, 0xa0, 0xf1, 0x65, 0x1d, 0x15, 0xb5, 0x1b, 0x01, 0x5f, 0x02,
-	0xe7, 0x69, 0x14, 0x10, 0x6c, 0x27, 0x11, 0x24, 0xb3, 0x0b, 0x6c, 0x35, 0x8b, 0xe7, 0xab, 0x6d,
-	0x1c, 0x94, 0x91, 0x2c, 0x7d, 0x92, 0x07, 0xc5, 0x07, 0x44, 0xe8, 0x43, 0x34, 0xbd, 0xd7, 0xc0,
-	0x10, 0xdf, 0xa9, 0xcd, 0x1d, 0x52, 0xd0, 0x4a, 0x3d, 0xa7, 0x22, 0xc9, 0x65, 0xe5, 0x89, 0xe1,
-	0xb3, 0xf2, 0x34, 0xc0, 0x05, 0x55, 0x79, 0xaa, 0x0a, 0x32, 0x4a, 0xf8, 0x70, 0x09, 0x00, 0x1b,
-	0x87, 0x04, 0xb3, 0x8c, 0x64, 0x9b, 0xc3, 0x5c, 0x5a, 0x9d, 0xcf, 0xaa, 0xe2, 0x20, 0x4d, 0x0a,
-	0xde, 0x04, 0x30, 0xf9, 0xcf, 0x09, 0xfc, 0xd7, 0x2c, 0xe2, 0x3b, 0x7e, 0xdd, 0x2c, 0x70, 0xb3,
-	0x67, 0x58, 0xb5, 0x5d, 0xed, 0xe0, 0xa2, 0x2e, 0x2b, 0x60, 0x03, 0x0c, 0x89, 0x67, 0x34, 0xcf,
-	0x1b, 0x7d, 0xbc, 0x71, 0xf7, 0x2c, 0xd7, 0xb1, 0x39, 0x54, 0x05, 0x70, 0xf7, 0x70, 0x14, 0x24,
-	0xd1, 0xe0, 0xfb, 0x06, 0x18, 0xa3, 0xf1, 0x2e, 0x91, 0xd2, 0x94, 0x67, 0xf5, 0xd1, 0xa5, 0x3b,
-	0xfd, 0x82, 0xaf, 0x6a, 0xba, 0x2b, 0x93, 0xad, 0x66, 0x71, 0x4c, 0xa7, 0xa0, 0x36, 0x6c, 0xf8,
-	0x7b, 0x03, 0x98, 0x96, 0x2d, 0x42, 0xdf, 0x72, 0xb7, 0x89, 0xe3, 0x47, 0x98, 0x88, 0x07, 0x91,
-	0x28, 0x1f, 0x7d, 0xec, 0x15, 0xb3, 0xef, 0xac, 0xca, 0xbc, 0x3c, 0x69, 0x73, 0xb9, 0x87, 0x05,
-	0xa8, 0xa7, 0x6d, 0xa5, 0x7f, 0x19, 0xd9, 0xd4, 0xa2, 0xed, 0xb2, 0x5a, 0xb3, 0x5c, 0x0c, 0x57,
-	0xc1, 0x24, 0xeb, 0x7e, 0x11, 0x0e, 0x5d, 0xa7, 0x66, 0x51, 0xfe, 0xfa, 0x11, 0xd1, 0xad, 0x9e,
-	0xe1, 0xd5, 0x0c, 0x1f, 0x75, 0xac, 0x80, 0xaf, 0x02, 0x28, 0xda, 0xc2, 0x36, 0x3d, 0xa2, 0x13,
-	0x50, 0x0d, 0x5e, 0xb5, 0x43, 0x02, 0x75, 0x59, 0x05, 0x57, 0xc0, 0x94, 0x6b, 0xed, 0x62, 0xb7,
-	0x8a, 0x5d, 0x5c, 0x8b, 0x02, 0xc2, 0x55, 0x89, 0xf7, 0xe1, 0x74, 0xab, 0x59, 0x9c, 0xda, 0xc8,
-	0x32, 0x51, 0xa7, 0x7c, 0xe9, 0x6a, 0xf6, 0x2e, 0xeb, 0x1b, 0x17, 0xcd, 0xf6, 0x87, 0x39, 0x30,
-	0xdb, 0x3b, 0x28, 0xe0, 0x77, 0x54, 0x6b, 0x2c, 0x3a, 0xbe, 0xd7, 0xcf, 0x20, 0xf4, 0xe4, 0x73,
-	0x00, 0x74, 0x3e, 0x05, 0xe0, 0x31, 0xab, 0xd7, 0x96, 0x9b, 0x3c, 0xfb, 0x77, 0xce, 0x02, 0x9d,
-	0xe9, 0xaf, 0x8c, 0x88, 0x2e, 0xc0, 0x72, 0x79, 0xd1, 0xb7, 0x5c, 0x5c, 0xfa, 0xa8, 0xe3, 0x69,
-	0x9b, 0x5e, 0x56, 0xf8, 0x03, 0x03, 0x4c, 0x04, 0x21, 0xf6, 0x97, 0xb7, 0xd7, 0xef, 0xfd, 0xbf,
-	0xb8, 0xb4, 0xd2, 0x41, 0xeb, 0x8f, 0x6e, 0x22, 0x7b, 0x5f, 0x0b, 0x5d, 0xdb, 0x24, 0x08, 0x69,
-	0xe5, 0x42, 0xab, 0x59, 0x9c, 0xd8, 0x6a, 0x47, 0x41, 0x59, 0xd8, 0x92, 0x07, 0xa6, 0xd7, 0x8e,
-	0x22, 0x4c, 0x7c, 0xcb, 0x5d, 0x0d, 0x6a, 0xb1, 0x87, 0xfd, 0x48, 0xd8, 0x98, 0x19, 0x17, 0x18,
-	0x0f, 0x39, 0x2e, 0xb8, 0x02, 0x06, 0x62, 0xe2, 0xca, 0xa8, 0x1d, 0x55, 0x43, 0x30, 0xb4, 0x81,
-	0x18, 0xbd, 0x74, 0x15, 0x0c, 0x32, 0x3b, 0xe1, 0x25, 0x30, 0x40, 0xac, 0x43, 0xae, 0x75, 0xac,
-	0x32, 0xcc, 0x44, 0x90, 0x75, 0x88, 0x18, 0xad, 0xf4, 0xb7, 0x79, 0x30, 0x91, 0xd9, 0x0b, 0x9c,
-	0x05, 0x39, 0x35, 0x59, 0x03, 0x52, 0x69, 0x6e, 0x7d, 0x15, 0xe5, 0x1c, 0x1b, 0xbe, 0xa0, 0xb2,
-	0xab, 0x00, 0x2d, 0xaa, 0x62, 0xc1, 0xa9, 0xac, 0x2d, 0x4b, 0xd5, 0x31, 0x43, 0x92, 0xf4, 0xc8,
-	0x6c, 0xc0, 0x7b, 0xf2, 0x56, 0x08, 0x1b, 0xf0, 0x1e, 0x62, 0xb4, 0x47, 0x9d, 0x95, 0x24, 0xc3,
-	0x9a, 0xfc, 0x43, 0x0c, 0x6b, 0x86, 0x3e, 0x77, 0x58, 0xf3, 0x38, 0xc8, 0x47, 0x4e, 0xe4, 0x62,
-	0x5e, 0xa9, 0xb4, 0x66, 0xf8, 0x0e, 0x23, 0x22, 0xc1, 0x83, 0x18, 0x0c, 0xdb, 0x78, 0xcf, 0x8a,
-	0xdd, 0x88, 0x17, 0xa5, 0xd1, 0xa5, 0xaf, 0x9f, 0x2e, 0x7a, 0xc4, 0x30, 0x63, 0x55, 0xa8, 0x44,
-	0x89, 0x6e, 0xf8, 0x04, 0x18, 0xf6, 0xac, 0x23, 0xc7, 0x8b, 0x3d, 0xde, 0x31, 0x1a, 0x42, 0x6c,
-	0x53, 0x90, 0x50, 0xc2, 0x63, 0x49, 0x10, 0x1f, 0xd5, 0xdc, 0x98, 0x3a, 0x0d, 0x2c, 0x99, 0xb2,
-	0xa5, 0x53, 0x49, 0x70, 0x2d, 0xc3, 0x47, 0x1d, 0x2b, 0x38, 0x98, 0xe3, 0xf3, 0xc5, 0xa3, 0x1a,
-	0x98, 0x20, 0xa1, 0x84, 0xd7, 0x0e, 0x26, 0xe5, 0xc7, 0x7a, 0x81, 0xc9, 0xc5, 0x1d, 0x2b, 0xe0,
-	0xd3, 0x60, 0xc4, 0xb3, 0x8e, 0x36, 0xb0, 0x5f, 0x8f, 0xf6, 0xcd, 0xf1, 0x79, 0x63, 0x61, 0xa0,
-	0x32, 0xde, 0x6a, 0x16, 0x47, 0x36, 0x13, 0x22, 0x4a, 0xf9, 0x5c, 0xd8, 0xf1, 0xa5, 0xf0, 0x79,
-	0x4d, 0x38, 0x21, 0xa2, 0x94, 0xcf, 0x3a, 0x93, 0xd0, 0x8a, 0xd8, 0xbd, 0x32, 0x27, 0xda, 0x1f,
-	0xce, 0xdb, 0x82, 0x8c, 0x12, 0x3e, 0x5c, 0x00, 0x05, 0xcf, 0x3a, 0xe2, 0x6f, 0x4a, 0x73, 0x92,
-	0xab, 0xe5, 0x03, 0xc5, 0x4d, 0x49, 0x43, 0x8a, 0xcb, 0x25, 0x1d, 0x5f, 0x48, 0x4e, 0x69, 0x92,
-	0x92, 0x86, 0x14, 0x97, 0xc5, 0x6f, 0xec, 0x3b, 0xf7, 0x63, 0x2c, 0x84, 0x21, 0xf7, 0x8c, 0x8a,
-	0xdf, 0xbb, 0x29, 0x0b, 0xe9, 0x72, 0xec, 0x4d, 0xe7, 0xc5, 0x6e, 0xe4, 0x84, 0x2e, 0xde, 0xda,
-	0x33, 0x2f, 0x70, 0xff, 0xf3, 0x56, 0x7e, 0x53, 0x51, 0x91, 0x26, 0x01, 0xdf, 0x06, 0x83, 0xd8,
-	0x8f, 0x3d, 0xf3, 0x22, 0x2f, 0xdf, 0xa7, 0x8d, 0x3e, 0x75, 0x5f, 0xd6, 0xfc, 0xd8, 0x43, 0x5c,
-	0x33, 0x7c, 0x01, 0x8c, 0x7b, 0xd6, 0x11, 0x4b, 0x02, 0x98, 0x44, 0xec, 0xa1, 0x39, 0xcd, 0xf7,
-	0x3d, 0xc5, 0x9a, 0xd8, 0x4d, 0x9d, 0x81, 0xda, 0xe5, 0xf8, 0x42, 0xc7, 0xd7, 0x16, 0xce, 0x68,
-	0x0b, 0x75, 0x06, 0x6a, 0x97, 0x63, 0x4e, 0x26, 0xf8, 0x7e, 0xec, 0x10, 0x6c, 0x9b, 0xff, 0xc3,
-	0xfb, 0x5e, 0x39, 0xdf, 0x15, 0x34, 0xa4, 0xb8, 0xf0, 0x7e, 0x32, 0x72, 0x30, 0xf9, 0xe5, 0xdb,
-	0xee, 0x5b, 0xea, 0xde, 0x22, 0xcb, 0x84, 0x58, 0xc7, 0xa2, 0xaa, 0xe8, 0xc3, 0x06, 0xe8, 0x83,
-	0xbc, 0xe5, 0xba, 0x5b, 0x7b, 0xe6, 0x25, 0xee, 0xf1, 0x3e, 0x56, 0x0b, 0x95, 0x61, 0x96, 0x99,
-	0x7e, 0x24, 0x60, 0x18, 0x5e, 0xe0, 0xb3, 0x58, 0x98, 0x3d, 0x33, 0xbc, 0x2d, 0xa6, 0x1f, 0x09,
-	0x18, 0xbe, 0x3f, 0xff, 0x78, 0x6b, 0xcf, 0x7c, 0xec, 0xec, 0xf6, 0xc7, 0xf4, 0x23, 0x01, 0x03,
-	0x6d, 0x30, 0xe0, 0x07, 0x91, 0x79, 0xb9, 0xdf, 0xb5, 0x97, 0x57, 0x93, 0xdb, 0x41, 0x84, 0x98,
-	0x7a, 0xf8, 0x23, 0x03, 0x80, 0x30, 0x8d, 0xc4, 0x2b, 0xa7, 0x1d, 0x01, 0x64, 0xd0, 0xca, 0x69,
-	0xf4, 0xae, 0xf9, 0x11, 0x39, 0x4e, 0xdf, 0x35, 0x5a, 0x94, 0x6b, 0x06, 0xc0, 0x9f, 0x1b, 0xe0,
-	0xa2, 0xde, 0xee, 0x2a, 0xcb, 0xe6, 0xb8, 0x1f, 0xb6, 0xfa, 0x18, 0xc8, 0x95, 0x20, 0x70, 0x2b,
-	0x66, 0xab, 0x59, 0xbc, 0xb8, 0xdc, 0x05, 0x10, 0x75, 0x35, 0x03, 0xfe, 0xda, 0x00, 0x53, 0x32,
-	0x3b, 0x6a, 0xc6, 0x15, 0xb9, 0xdb, 0xde, 0xee, 0xa3, 0xdb, 0xb2, 0x10, 0xc2, 0x7b, 0xea, 0x2b,
-	0x63, 0x07, 0x1f, 0x75, 0x5a, 0x05, 0x7f, 0x67, 0x80, 0x31, 0x1b, 0x87, 0xd8, 0xb7, 0xb1, 0x5f,
-	0x63, 0x66, 0xce, 0x9f, 0x76, 0xae, 0x90, 0x35, 0x73, 0x55, 0xd3, 0x2e, 0x2c, 0x2c, 0x4b, 0x0b,
-	0xc7, 0x74, 0xd6, 0x49, 0xb3, 0x38, 0x93, 0x2e, 0xd5, 0x39, 0xa8, 0xcd, 0x40, 0xf8, 0x63, 0x03,
-	0x4c, 0xa4, 0x6e, 0x17, 0x05, 0xe2, 0xea, 0xd9, 0x1c, 0x3c, 0x6f, 0x41, 0x97, 0xdb, 0xb1, 0x50,
-	0x16, 0x1c, 0xfe, 0xc6, 0x60, 0xdd, 0x56, 0xf2, 0x56, 0xa3, 0x66, 0x89, 0x7b, 0xf0, 0x8d, 0x7e,
-	0x7a, 0x50, 0x29, 0x17, 0x0e, 0xbc, 0x9e, 0x76, 0x72, 0x8a, 0x73, 0xd2, 0x2c, 0x4e, 0xeb, 0xfe,
-	0x53, 0x0c, 0xa4, 0x1b, 0x07, 0xdf, 0x33, 0xc0, 0x18, 0x4e, 0x1b, 0x66, 0x6a, 0x3e, 0x7e, 0x5a,
-	0xd7, 0x75, 0x6d, 0xbf, 0xc5, 0x73, 0x5a, 0x63, 0x51, 0xd4, 0x06, 0xcb, 0x7a, 0x3f, 0x7c, 0x64,
-	0x79, 0xa1, 0x8b, 0xcd, 0xff, 0xed, 0x5f, 0xef, 0xb7, 0x26, 0x54, 0xa2, 0x44, 0x37, 0xbc, 0x0e,
-	0x0a, 0x7e, 0xec, 0xba, 0xd6, 0xae, 0x8b, 0xcd, 0x27, 0x78, 0x17, 0xa1, 0xe6, 0x8b, 0xb7, 0x25,
-	0x1d, 0x29, 0x09, 0xb8, 0x07, 0xe6, 0x8f, 0x6e, 0xa9, 0x1f, 0x5f, 0x74, 0x1d, 0xe0, 0x99, 0xd7,
-	0xb8, 0x96, 0xd9, 0x56, 0xb3, 0x38, 0xb3, 0xd3, 0x7d, 0xc4, 0xf7, 0x40, 0x1d, 0xf0, 0x4d, 0xf0,
-	0x98, 0x26, 0xb3, 0xe6, 0xed, 0x62, 0xdb, 0xc6, 0x76, 0xf2, 0xd0, 0x32, 0xff, 0x8f, 0x43, 0xa8,
-	0x7b, 0xbc, 0x93, 0x15, 0x40, 0x9f, 0xb7, 0x1a, 0x6e, 0x80, 0x19, 0x8d, 0xbd, 0xee, 0x47, 0x5b,
-	0xa4, 0x1a, 0x11, 0xc7, 0xaf, 0x9b, 0x0b, 0x5c, 0xef, 0xc5, 0xe4, 0xf6, 0xed, 0x68, 0x3c, 0xd4,
-	0x63, 0x0d, 0x7c, 0xa5, 0x4d, 0x1b, 0xff, 0x70, 0x61, 0x85, 0xb7, 0xf0, 0x31, 0x35, 0x9f, 0xe4,
-	0xcd, 0x05, 0x3f, 0xe7, 0x1d, 0x8d, 0x8e, 0x7a, 0xc8, 0xc3, 0x6f, 0x80, 0x0b, 0x19, 0x0e, 0x7b,
-	0x57, 0x98, 0x4f, 0x89, 0x07, 0x02, 0xeb, 0x44, 0x77, 0x12, 0x22, 0xea, 0x26, 0x09, 0xbf, 0x06,
-	0xa0, 0x46, 0xde, 0xb4, 0x42, 0xbe, 0xfe, 0x69, 0xf1, 0x56, 0x61, 0x27, 0xba, 0x23, 0x69, 0xa8,
-	0x8b, 0x1c, 0xfc, 0xd0, 0x68, 0xdb, 0x49, 0xfa, 0x9a, 0xa5, 0xe6, 0x75, 0x7e, 0x61, 0x5f, 0x79,
-	0xf4, 0x00, 0x4c, 0x95, 0xa1, 0xd8, 0xc5, 0x9a, 0x87, 0x35, 0x14, 0xd4, 0x03, 0x7d, 0x96, 0x3d,
-	0xa6, 0x33, 0x39, 0x1c, 0x4e, 0x82, 0x81, 0x03, 0x2c, 0x3f, 0x1b, 0x23, 0xf6, 0x27, 0x7c, 0x0b,
-	0xe4, 0x1b, 0x96, 0x1b, 0x27, 0xa3, 0x80, 0xfe, 0xd5, 0x7a, 0x24, 0xf4, 0xbe, 0x94, 0x7b, 0xd1,
-	0x98, 0xfd, 0xc0, 0x00, 0x33, 0xdd, 0xab, 0xca, 0x97, 0x65, 0xd1, 0xcf, 0x0c, 0x30, 0xd5, 0x51,
-	0x40, 0xba, 0x18, 0xe3, 0xb6, 0x1b, 0x73, 0xaf, 0x8f, 0x95, 0x40, 0x5c, 0x04, 0xde, 0xd1, 0xea,
-	0x96, 0xfd, 0xd0, 0x00, 0x93, 0xd9, 0xc4, 0xfc, 0x25, 0x79, 0xa9, 0xf4, 0x7e, 0x0e, 0xcc, 0x74,
-	0xef, 0xc1, 0xa1, 0xa7, 0xa6, 0x0b, 0x7d, 0x1f, 0xd0, 0x74, 0x1b, 0xd9, 0xbe, 0x6b, 0x80, 0xd1,
-	0x77, 0x94, 0x5c, 0xf2, 0x35, 0xb3, 0x9f, 0x53, 0xa1, 0xa4, 0xf4, 0xa5, 0x0c, 0x8a, 0x74, 0xc8,
-	0xd2, 0x6f, 0x0d, 0x30, 0xdd, 0xb5, 0x9c, 0xc3, 0x6b, 0x60, 0xc8, 0x72, 0xdd, 0xe0, 0x50, 0x4c,
-	0xf3, 0xb4, 0xb1, 0xfc, 0x32, 0xa7, 0x22, 0xc9, 0xd5, 0x7c, 0x96, 0xfb, 0x02, 0x7c, 0x56, 0xfa,
-	0x83, 0x01, 0x2e, 0x7f, 0x5e, 0xd4, 0x7d, 0xd1, 0x67, 0xb8, 0x00, 0x0a, 0xb2, 0xd9, 0x3e, 0xe6,
-	0xe7, 0x27, 0xb3, 0xab, 0xcc, 0x08, 0xfc, 0xd7, 0x32, 0xe2, 0xaf, 0xd2, 0x2f, 0x0d, 0x30, 0x59,
-	0xc5, 0xa4, 0xe1, 0xd4, 0x30, 0xc2, 0x7b, 0x98, 0x60, 0xbf, 0x86, 0xe1, 0x22, 0x18, 0xe1, 0x5f,
-	0x1b, 0x43, 0xab, 0x96, 0x7c, 0x23, 0x99, 0x92, 0x8e, 0x1e, 0xb9, 0x9d, 0x30, 0x50, 0x2a, 0xa3,
-	0xbe, 0xa7, 0xe4, 0x7a, 0x7e, 0x4f, 0xb9, 0x0c, 0x06, 0xc3, 0x74, 0x00, 0x5c, 0x60, 0x5c, 0x3e,
-	0xf3, 0xe5, 0x54, 0xce, 0x0d, 0x48, 0xc4, 0xa7, 0x5c, 0x79, 0xc9, 0x0d, 0x48, 0x84, 0x38, 0xb5,
-	0xf4, 0x2d, 0x70, 0xbe, 0x3d, 0x3d, 0x33, 0x3c, 0x12, 0xbb, 0x1d, 0xdf, 0x6f, 0x18, 0x0f, 0x71,
-	0x8e, 0xfe, 0xb3, 0x81, 0xdc, 0x03, 0x7e, 0x36, 0xf0, 0x47, 0x03, 0x5c, 0x48, 0x7e, 0x55, 0xe3,
-	0x3a, 0xd8, 0x8f, 0x56, 0x02, 0x7f, 0xcf, 0xa9, 0xc3, 0x4b, 0x62, 0x8e, 0xa8, 0x0d, 0xe7, 0x92,
-	0x19, 0x22, 0xbc, 0x0f, 0x86, 0xa9, 0x70, 0x9a, 0x3c, 0xcf, 0x57, 0x1f, 0xfd, 0x3c, 0xb3, 0xde,
-	0x17, 0x6d, 0x50, 0x42, 0x4d, 0x70, 0xd8, 0x91, 0xd6, 0xac, 0x4a, 0xec, 0xdb, 0x72, 0x96, 0x3c,
-	0x26, 0x8e, 0x74, 0x65, 0x59, 0xd0, 0x90, 0xe2, 0x96, 0xfe, 0x6e, 0x80, 0xa9, 0x8e, 0x5f, 0x09,
-	0xc1, 0xef, 0x19, 0x60, 0xac, 0xa6, 0x6d, 0x4f, 0x5e, 0x8c, 0xcd, 0xd3, 0xff, 0x12, 0x49, 0x53,
-	0x2a, 0x7a, 0x09, 0x9d, 0x82, 0xda, 0x40, 0xe1, 0x0e, 0x30, 0x6b, 0x99, 0x1f, 0xe4, 0x65, 0x3e,
-	0xf1, 0x5d, 0x6e, 0x35, 0x8b, 0xe6, 0x4a, 0x0f, 0x19, 0xd4, 0x73, 0x75, 0x65, 0xe1, 0xe3, 0xcf,
-	0xe6, 0xce, 0x7d, 0xf2, 0xd9, 0xdc, 0xb9, 0x4f, 0x3f, 0x9b, 0x3b, 0xf7, 0x6e, 0x6b, 0xce, 0xf8,
-	0xb8, 0x35, 0x67, 0x7c, 0xd2, 0x9a, 0x33, 0x3e, 0x6d, 0xcd, 0x19, 0x7f, 0x69, 0xcd, 0x19, 0x3f,
-	0xf9, 0xeb, 0xdc, 0xb9, 0x37, 0x72, 0x8d, 0x1b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x19, 0x76,
-	0x99, 0xb1, 0xa4, 0x2b, 0x00, 0x00,
+	// 3760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xdd, 0x6f, 0x24, 0x57,
+	0x56, 0x9f, 0xea, 0xf6, 0xe7, 0xb5, 0x3d, 0xb6, 0xef, 0xd8, 0x4e, 0xc5, 0x99, 0xb8, 0x3d, 0x15,
+	0x12, 0xbc, 0xd9, 0x99, 0x76, 0xc6, 0x9b, 0x6c, 0x26, 0x11, 0x02, 0xb9, 0x6d, 0x4f, 0xe2, 0xc4,
+	0x1e, 0x3b, 0xa7, 0x9d, 0xa4, 0x77, 0x17, 0xb4, 0x29, 0x77, 0x5f, 0xb7, 0x2b, 0xae, 0xae, 0xaa,
+	0xa9, 0x5b, 0xed, 0x8f, 0x15, 0xa0, 0x00, 0x5a, 0x01, 0x2b, 0xc1, 0xf2, 0x80, 0x00, 0x21, 0x21,
+	0x40, 0x68, 0x1f, 0xe0, 0x01, 0xde, 0x40, 0xe2, 0x1f, 0x60, 0x5e, 0x90, 0xf6, 0x65, 0xd1, 0x3e,
+	0xa0, 0x16, 0x31, 0x7f, 0x01, 0x02, 0x84, 0xf0, 0x03, 0x42, 0xf7, 0xa3, 0x6e, 0xdd, 0xaa, 0xae,
+	0x9e, 0xf1, 0x8e, 0xdb, 0xbb, 0x6f, 0xee, 0x73, 0xce, 0x3d, 0xbf, 0x73, 0x4f, 0x9d, 0x7b, 0xee,
+	0xb9, 0xe7, 0x5e, 0x23, 0xfb, 0xe8, 0x01, 0x2d, 0x3b, 0xfe, 0xf2, 0x51, 0x7b, 0x9f, 0x84, 0x1e,
+	0x89, 0x08, 0x5d, 0x3e, 0x26, 0x5e, 0xc3, 0x0f, 0x97, 0x25, 0xc3, 0x0e, 0x1c, 0x72, 0x1a, 0x11,
+	0x8f, 0x3a, 0xbe, 0x47, 0xef, 0xd9, 0x81, 0x43, 0x49, 0x78, 0x4c, 0xc2, 0xe5, 0xe0, 0xa8, 0xc9,
+	0x78, 0x34, 0x2d, 0xb0, 0x7c, 0x7c, 0x7f, 0xb9, 0x49, 0x3c, 0x12, 0xda, 0x11, 0x69, 0x94, 0x83,
+	0xd0, 0x8f, 0x7c, 0xfc, 0x40, 0x68, 0x2a, 0xa7, 0x04, 0xbf, 0xad, 0x34, 0x95, 0x83, 0xa3, 0x26,
+	0xe3, 0xd1, 0xb4, 0x40, 0xf9, 0xf8, 0xfe, 0xfc, 0xbd, 0xa6, 0x13, 0x1d, 0xb6, 0xf7, 0xcb, 0x75,
+	0xbf, 0xb5, 0xdc, 0xf4, 0x9b, 0xfe, 0x32, 0x57, 0xb8, 0xdf, 0x3e, 0xe0, 0xbf, 0xf8, 0x0f, 0xfe,
+	0x97, 0x00, 0x9a, 0x7f, 0x33, 0x31, 0xb9, 0x65, 0xd7, 0x0f, 0x1d, 0x8f, 0x84, 0x67, 0x89, 0x9d,
+	0x2d, 0x12, 0xd9, 0x39, 0xe6, 0xcd, 0x2f, 0xf7, 0x1a, 0x15, 0xb6, 0xbd, 0xc8, 0x69, 0x91, 0xae,
+	0x01, 0x5f, 0x7f, 0xd6, 0x00, 0x5a, 0x3f, 0x24, 0x2d, 0x3b, 0x3b, 0xce, 0xba, 0x30, 0xd0, 0xf4,
+	0x9a, 0xef, 0x1d, 0x93, 0x90, 0x4d, 0x10, 0xc8, 0xe3, 0x36, 0xa1, 0x11, 0xae, 0xa0, 0x62, 0xdb,
+	0x69, 0x98, 0xc6, 0xa2, 0xb1, 0x34, 0x5a, 0x79, 0xe3, 0x49, 0xa7, 0x74, 0xe3, 0xbc, 0x53, 0x2a,
+	0x7e, 0xbc, 0xb9, 0x7e, 0xd1, 0x29, 0xdd, 0xe9, 0x85, 0x14, 0x9d, 0x05, 0x84, 0x96, 0x3f, 0xde,
+	0x5c, 0x07, 0x36, 0x18, 0xbf, 0x87, 0xa6, 0x1b, 0x84, 0x3a, 0x21, 0x69, 0xac, 0xee, 0x6e, 0x7e,
+	0x22, 0xf4, 0x9b, 0x05, 0xae, 0xf1, 0x45, 0xa9, 0x71, 0x7a, 0x3d, 0x2b, 0x00, 0xdd, 0x63, 0x70,
+	0x0d, 0x0d, 0xfb, 0xfb, 0x9f, 0x93, 0x7a, 0x44, 0xcd, 0xe2, 0x62, 0x71, 0x69, 0x6c, 0xe5, 0x5e,
+	0x39, 0xf9, 0x78, 0xca, 0x04, 0xfe, 0xc5, 0xe4, 0x64, 0xcb, 0x60, 0x9f, 0x6c, 0xc4, 0x1f, 0xad,
+	0x32, 0x29, 0xd1, 0x86, 0x77, 0x84, 0x16, 0x88, 0xd5, 0x59, 0x7f, 0x55, 0x40, 0x58, 0x9f, 0x3c,
+	0x0d, 0x7c, 0x8f, 0x92, 0xbe, 0xcc, 0x9e, 0xa2, 0xa9, 0x3a, 0xd7, 0x1c, 0x91, 0x86, 0xc4, 0x35,
+	0x0b, 0xcf, 0x63, 0xbd, 0x29, 0xf1, 0xa7, 0xd6, 0x32, 0xea, 0xa0, 0x0b, 0x00, 0xef, 0xa1, 0xa1,
+	0x90, 0xd0, 0xb6, 0x1b, 0x99, 0xc5, 0x45, 0x63, 0x69, 0x6c, 0xe5, 0x6e, 0x4f, 0x28, 0x1e, 0xda,
+	0x2c, 0xf8, 0xca, 0xc7, 0xf7, 0xcb, 0xd5, 0xc8, 0x8e, 0xda, 0xb4, 0x72, 0x53, 0x22, 0x0d, 0x01,
+	0xd7, 0x01, 0x52, 0x97, 0xf5, 0x7f, 0x06, 0x9a, 0xd2, 0xbd, 0x74, 0xec, 0x90, 0x13, 0x1c, 0xa2,
+	0xe1, 0x50, 0x04, 0x0b, 0xf7, 0xd3, 0xd8, 0xca, 0x87, 0xe5, 0xe7, 0x5d, 0x51, 0xe5, 0xae, 0xf8,
+	0xab, 0x8c, 0xb1, 0xcf, 0x25, 0x7f, 0x40, 0x0c, 0x84, 0x8f, 0xd1, 0x48, 0x28, 0xbf, 0x11, 0x0f,
+	0xa4, 0xb1, 0x95, 0xad, 0xfe, 0x80, 0x0a, 0x9d, 0x95, 0xf1, 0xf3, 0x4e, 0x69, 0x24, 0xfe, 0x05,
+	0x0a, 0xcb, 0xfa, 0xf3, 0x02, 0x5a, 0x58, 0x6b, 0xd3, 0xc8, 0x6f, 0x01, 0xa1, 0x7e, 0x3b, 0xac,
+	0x93, 0x35, 0xdf, 0x6d, 0xb7, 0xbc, 0x75, 0x72, 0xe0, 0x78, 0x4e, 0xc4, 0x62, 0x74, 0x11, 0x0d,
+	0x78, 0x76, 0x8b, 0xc8, 0x98, 0x19, 0x97, 0x9e, 0x1c, 0x78, 0x64, 0xb7, 0x08, 0x70, 0x0e, 0x93,
+	0x60, 0x21, 0x62, 0x16, 0xd2, 0x12, 0x7b, 0x67, 0x01, 0x01, 0xce, 0xc1, 0xaf, 0xa1, 0xa1, 0x03,
+	0x3f, 0x6c, 0xd9, 0xe2, 0xeb, 0x8d, 0x26, 0xdf, 0xe3, 0x21, 0xa7, 0x82, 0xe4, 0xe2, 0xb7, 0xd0,
+	0x58, 0x83, 0xd0, 0x7a, 0xe8, 0x04, 0x0c, 0xda, 0x1c, 0xe0, 0xc2, 0xb7, 0xa4, 0xf0, 0xd8, 0x7a,
+	0xc2, 0x02, 0x5d, 0x0e, 0xdf, 0x45, 0x23, 0x41, 0xe8, 0xf8, 0xa1, 0x13, 0x9d, 0x99, 0x83, 0x8b,
+	0xc6, 0xd2, 0x60, 0x65, 0x4a, 0x8e, 0x19, 0xd9, 0x95, 0x74, 0x50, 0x12, 0x4c, 0xfa, 0x73, 0xea,
+	0x7b, 0xbb, 0x76, 0x74, 0x68, 0x0e, 0x71, 0x04, 0x25, 0xfd, 0x41, 0x75, 0xe7, 0x11, 0xa3, 0x83,
+	0x92, 0xb0, 0xfe, 0xc5, 0x40, 0x66, 0xd6, 0x43, 0xb1, 0x7b, 0xf1, 0x43, 0x34, 0x42, 0x23, 0x96,
+	0x73, 0x9a, 0x67, 0xd2, 0x3f, 0xaf, 0xc7, 0xaa, 0xaa, 0x92, 0x7e, 0xd1, 0x29, 0xcd, 0x25, 0x23,
+	0x62, 0x2a, 0xf7, 0x8d, 0x1a, 0xcb, 0x42, 0xee, 0x84, 0xec, 0x1f, 0xfa, 0xfe, 0x91, 0x59, 0xb8,
+	0x6a, 0xc8, 0x7d, 0x2a, 0x14, 0x25, 0x98, 0x22, 0xe4, 0x24, 0x19, 0x62, 0x20, 0xeb, 0x7f, 0x0b,
+	0xd9, 0x89, 0x69, 0x1f, 0xfd, 0x33, 0x34, 0xc2, 0x96, 0x50, 0xc3, 0x8e, 0x6c, 0xb9, 0x08, 0xde,
+	0xb8, 0xdc, 0x82, 0x13, 0xeb, 0x75, 0x9b, 0x44, 0x76, 0x05, 0x4b, 0x57, 0xa0, 0x84, 0x06, 0x4a,
+	0x2b, 0x3e, 0x45, 0x03, 0x34, 0x20, 0x75, 0x39, 0xdf, 0x4f, 0xae, 0x10, 0xed, 0x3d, 0xe6, 0x50,
+	0x0d, 0x48, 0x3d, 0x09, 0x46, 0xf6, 0x0b, 0x38, 0x22, 0xfe, 0xc2, 0x40, 0x43, 0x94, 0xe7, 0x05,
+	0x99, 0x4b, 0x6a, 0xd7, 0x00, 0x9e, 0xc9, 0x3b, 0xe2, 0x37, 0x48, 0x5c, 0xeb, 0xbf, 0x0a, 0xe8,
+	0x4e, 0xaf, 0xa1, 0x6b, 0xbe, 0xd7, 0x10, 0x1f, 0x61, 0x53, 0xae, 0x2b, 0x11, 0x59, 0x6f, 0xe9,
+	0xeb, 0xea, 0xa2, 0x53, 0x7a, 0xf5, 0x99, 0x0a, 0xb4, 0x05, 0xf8, 0x8e, 0x9a, 0xb2, 0x58, 0xa4,
+	0x77, 0xd2, 0x86, 0x5d, 0x74, 0x4a, 0x93, 0x6a, 0x58, 0xda, 0x56, 0x7c, 0x8c, 0xb0, 0x6b, 0xd3,
+	0x68, 0x2f, 0xb4, 0x3d, 0x2a, 0xd4, 0x3a, 0x2d, 0x22, 0x3d, 0xf7, 0xfa, 0xe5, 0x82, 0x82, 0x8d,
+	0xa8, 0xcc, 0x4b, 0x48, 0xbc, 0xd5, 0xa5, 0x0d, 0x72, 0x10, 0x58, 0xce, 0x08, 0x89, 0x4d, 0x55,
+	0x1a, 0xd0, 0x72, 0x38, 0xa3, 0x82, 0xe4, 0xe2, 0xaf, 0xa0, 0xe1, 0x16, 0xa1, 0xd4, 0x6e, 0x12,
+	0xbe, 0xf6, 0x47, 0x93, 0x4d, 0x71, 0x5b, 0x90, 0x21, 0xe6, 0x5b, 0xff, 0x6d, 0xa0, 0xdb, 0xbd,
+	0xbc, 0xb6, 0xe5, 0xd0, 0x08, 0xff, 0x72, 0x57, 0xd8, 0x97, 0x2f, 0x37, 0x43, 0x36, 0x9a, 0x07,
+	0xbd, 0x4a, 0x25, 0x31, 0x45, 0x0b, 0xf9, 0x13, 0x34, 0xe8, 0x44, 0xa4, 0x15, 0xef, 0x96, 0xd0,
+	0xff, 0xb0, 0xab, 0x4c, 0x48, 0xf8, 0xc1, 0x4d, 0x06, 0x04, 0x02, 0xcf, 0xfa, 0x41, 0x01, 0xbd,
+	0xdc, 0x6b, 0x08, 0xcb, 0xe3, 0x94, 0x39, 0x3b, 0x70, 0xdb, 0xa1, 0xed, 0x9a, 0x46, 0xda, 0xd9,
+	0xbb, 0x9c, 0x0a, 0x92, 0xcb, 0x72, 0x27, 0x75, 0xbc, 0x66, 0xdb, 0xb5, 0x43, 0x19, 0x49, 0x6a,
+	0xc2, 0x55, 0x49, 0x07, 0x25, 0x81, 0xcb, 0x08, 0xd1, 0x43, 0x3f, 0x8c, 0x38, 0x06, 0xaf, 0x70,
+	0x46, 0x2b, 0x37, 0x59, 0x46, 0xa8, 0x2a, 0x2a, 0x68, 0x12, 0x6c, 0x23, 0x39, 0x72, 0xbc, 0x86,
+	0xfc, 0xe0, 0x6a, 0xed, 0x7e, 0xe8, 0x78, 0x0d, 0xe0, 0x1c, 0x86, 0xef, 0x3a, 0x34, 0x62, 0x14,
+	0x73, 0x30, 0x8d, 0xbf, 0x25, 0xe9, 0xa0, 0x24, 0x18, 0x7e, 0x9d, 0x25, 0x58, 0x3f, 0x74, 0x08,
+	0x35, 0x87, 0x12, 0xfc, 0x35, 0x45, 0x05, 0x4d, 0xc2, 0xfa, 0xa7, 0xe1, 0xde, 0xf1, 0xc1, 0x12,
+	0x08, 0x7e, 0x05, 0x0d, 0x36, 0x43, 0xbf, 0x1d, 0x48, 0x2f, 0x29, 0x6f, 0xbf, 0xc7, 0x88, 0x20,
+	0x78, 0xf8, 0x57, 0xd1, 0xa0, 0x27, 0x27, 0xcc, 0x22, 0xe8, 0xd3, 0xfe, 0x7f, 0x66, 0xee, 0xad,
+	0x04, 0x5d, 0x38, 0x52, 0x80, 0xe2, 0x37, 0xd1, 0x20, 0xad, 0xfb, 0x01, 0x91, 0x4e, 0x5c, 0x88,
+	0x85, 0xaa, 0x8c, 0x78, 0xd1, 0x29, 0x4d, 0xc4, 0xea, 0x38, 0x01, 0x84, 0x30, 0xfe, 0x6d, 0x03,
+	0x8d, 0xc8, 0xed, 0x82, 0x9a, 0xc3, 0x3c, 0x3c, 0xbf, 0xd1, 0x7f, 0xbb, 0x65, 0xd9, 0x9b, 0x7c,
+	0x33, 0x49, 0xa0, 0xa0, 0xc0, 0xf1, 0x6f, 0x1a, 0x08, 0xd5, 0xd5, 0xde, 0x65, 0x8e, 0x2e, 0x1a,
+	0xfd, 0x5c, 0x2a, 0xda, 0xae, 0x28, 0x02, 0x41, 0xfd, 0x06, 0x0d, 0x15, 0x57, 0xd1, 0x6c, 0x10,
+	0x12, 0xae, 0xfb, 0x63, 0xef, 0xc8, 0xf3, 0x4f, 0xbc, 0x87, 0x0e, 0x71, 0x1b, 0xd4, 0x44, 0x8b,
+	0xc6, 0xd2, 0x48, 0xe5, 0x65, 0x69, 0xff, 0xec, 0x6e, 0x9e, 0x10, 0xe4, 0x8f, 0xc5, 0x21, 0x1a,
+	0x72, 0x9d, 0x96, 0x13, 0x51, 0x73, 0x8c, 0x4f, 0xea, 0x51, 0xbf, 0x26, 0xb5, 0xc5, 0xb5, 0x56,
+	0x10, 0x5b, 0xaf, 0xe2, 0x6f, 0x90, 0x48, 0xf8, 0xd7, 0xd1, 0x68, 0x48, 0x22, 0xe2, 0xf1, 0x72,
+	0x6a, 0x9c, 0xc3, 0x7e, 0xd4, 0x2f, 0x58, 0x88, 0x15, 0x57, 0x26, 0xce, 0x3b, 0xa5, 0x51, 0xf5,
+	0x13, 0x12, 0x48, 0x7c, 0x8c, 0x86, 0x0f, 0x1d, 0x1a, 0xf9, 0xe1, 0x99, 0x39, 0xc1, 0xd1, 0x77,
+	0xfa, 0x85, 0xfe, 0xbe, 0x50, 0x2b, 0x8a, 0x1b, 0xf9, 0x03, 0x62, 0x30, 0xeb, 0xbb, 0x45, 0xb4,
+	0xd0, 0x2b, 0x0a, 0xc5, 0xfe, 0x86, 0xbf, 0x2f, 0x02, 0x4d, 0xec, 0x79, 0xd4, 0x34, 0x78, 0xd0,
+	0x7f, 0xab, 0xff, 0x41, 0xaf, 0xf6, 0xd5, 0xa4, 0x20, 0x52, 0x24, 0x0a, 0x9a, 0x09, 0xf8, 0x0f,
+	0x0d, 0x34, 0x61, 0xd7, 0xeb, 0x24, 0x88, 0x48, 0x43, 0xa4, 0xcc, 0xc2, 0xf5, 0x66, 0x90, 0x59,
+	0x69, 0xd0, 0xc4, 0xaa, 0x8e, 0x0a, 0x69, 0x23, 0xf0, 0xbb, 0xe8, 0x26, 0x73, 0x2a, 0x69, 0xc4,
+	0xab, 0x55, 0x66, 0x72, 0x7c, 0xde, 0x29, 0xdd, 0xac, 0xa6, 0x38, 0x90, 0x91, 0xb4, 0x7e, 0x34,
+	0x84, 0x4a, 0xcf, 0xc8, 0x06, 0x97, 0x38, 0x60, 0xbc, 0x86, 0x86, 0xf8, 0x4c, 0x1b, 0xdc, 0x21,
+	0x23, 0x5a, 0x59, 0xc5, 0xa9, 0x20, 0xb9, 0xac, 0x14, 0x60, 0xf8, 0xac, 0x14, 0x28, 0x72, 0x41,
+	0x55, 0x0a, 0x54, 0x05, 0x19, 0x62, 0x3e, 0x5e, 0x41, 0xa8, 0x41, 0x82, 0x90, 0xb0, 0xec, 0xdf,
+	0x30, 0x87, 0xb9, 0xb4, 0xfa, 0x3e, 0xeb, 0x8a, 0x03, 0x9a, 0x14, 0x7e, 0x88, 0x70, 0xfc, 0xcb,
+	0xf1, 0xbd, 0x4f, 0xed, 0xd0, 0x73, 0xbc, 0xa6, 0x39, 0xc2, 0xcd, 0x9e, 0x63, 0x95, 0xcd, 0x7a,
+	0x17, 0x17, 0x72, 0x46, 0xe0, 0x63, 0x34, 0x24, 0x5a, 0x16, 0xe6, 0x40, 0x7f, 0xb3, 0xdb, 0x27,
+	0xb6, 0xeb, 0x34, 0x38, 0x94, 0x48, 0x06, 0x55, 0x8e, 0x02, 0x12, 0x0d, 0x7f, 0xcf, 0x40, 0xe3,
+	0xb4, 0xbd, 0x1f, 0x4a, 0x69, 0xca, 0x77, 0xd0, 0xb1, 0x95, 0xbd, 0x7e, 0xc1, 0x57, 0x35, 0xdd,
+	0x95, 0xa9, 0xf3, 0x4e, 0x69, 0x5c, 0xa7, 0x40, 0x0a, 0x1b, 0xff, 0xbd, 0x81, 0x4c, 0xbb, 0x21,
+	0x42, 0xdf, 0x76, 0x77, 0x43, 0xc7, 0x8b, 0x48, 0x28, 0x0e, 0x9f, 0x62, 0xab, 0xee, 0x63, 0x5d,
+	0x9e, 0x3d, 0xd3, 0x56, 0x16, 0xe5, 0x97, 0x36, 0x57, 0x7b, 0x58, 0x00, 0x3d, 0x6d, 0xe3, 0x1b,
+	0x94, 0x6c, 0x35, 0x5d, 0xc3, 0x06, 0xf5, 0x9e, 0xd2, 0x2c, 0x36, 0xa8, 0xe4, 0x37, 0x68, 0xa8,
+	0xd6, 0xe3, 0xec, 0xd9, 0x2d, 0x91, 0xc3, 0x25, 0x34, 0x18, 0xd8, 0xd1, 0xa1, 0x48, 0x69, 0xa3,
+	0x95, 0x51, 0xb6, 0xfb, 0xb3, 0xa3, 0x2c, 0x05, 0x41, 0xc7, 0x6f, 0xa2, 0x71, 0xa7, 0xe9, 0xb1,
+	0x75, 0xca, 0xc9, 0xbc, 0x1c, 0x1d, 0x15, 0x1f, 0x6c, 0x53, 0xa3, 0x43, 0x4a, 0xca, 0xfa, 0x08,
+	0xcd, 0xe6, 0x66, 0x60, 0xfc, 0x00, 0x8d, 0xb7, 0xec, 0x53, 0xd6, 0x3c, 0xa1, 0x32, 0x93, 0xb2,
+	0x13, 0xf8, 0x8c, 0x74, 0xf1, 0xf8, 0xb6, 0xc6, 0x83, 0x94, 0xa4, 0x75, 0x6e, 0xa0, 0x99, 0xbc,
+	0xad, 0x8c, 0x15, 0x6e, 0x2d, 0xfb, 0x34, 0x6e, 0x2e, 0x31, 0x85, 0x45, 0xe1, 0x8e, 0x6d, 0x45,
+	0x05, 0x4d, 0x02, 0x03, 0x9a, 0x4b, 0x7e, 0xed, 0x92, 0x90, 0x27, 0xae, 0xc0, 0xae, 0x8b, 0x9e,
+	0x44, 0xb1, 0x32, 0x7f, 0xde, 0x29, 0xcd, 0x6d, 0xe7, 0x4a, 0x40, 0x8f, 0x91, 0x6c, 0xb5, 0x2b,
+	0x4e, 0xd5, 0xf9, 0x0e, 0xa9, 0x9c, 0x45, 0xb2, 0xa6, 0x2b, 0x8a, 0xd5, 0xbe, 0xdd, 0xc5, 0x85,
+	0x9c, 0x11, 0xd6, 0x36, 0x7a, 0xa1, 0xc7, 0xbe, 0xc9, 0x92, 0x50, 0x14, 0xb9, 0x55, 0xc2, 0xf6,
+	0x80, 0x78, 0x9a, 0x2a, 0x09, 0xed, 0xed, 0x6d, 0x49, 0x0e, 0x68, 0x52, 0xd6, 0xe3, 0x6c, 0x42,
+	0xd5, 0xd6, 0x98, 0x60, 0x5c, 0x22, 0xa1, 0xea, 0x2d, 0x90, 0xc2, 0x33, 0x5b, 0x20, 0xff, 0x63,
+	0xa0, 0x85, 0x9e, 0x98, 0xd5, 0xba, 0xed, 0x12, 0xbc, 0x8e, 0xa6, 0xd8, 0xd9, 0x1a, 0x48, 0xe0,
+	0x3a, 0x75, 0x9b, 0x72, 0xc5, 0x02, 0x5e, 0x35, 0xf9, 0xaa, 0x19, 0x3e, 0x74, 0x8d, 0xc0, 0x1f,
+	0x20, 0x2c, 0x0e, 0x9d, 0x29, 0x3d, 0xc2, 0x40, 0x75, 0x7c, 0xac, 0x76, 0x49, 0x40, 0xce, 0x28,
+	0xbc, 0x86, 0xa6, 0x5d, 0x7b, 0x9f, 0xb8, 0x55, 0xe2, 0x92, 0x7a, 0xe4, 0x87, 0x5c, 0x95, 0xe8,
+	0x3e, 0xcd, 0xb2, 0xfe, 0xec, 0x56, 0x96, 0x09, 0xdd, 0xf2, 0xd6, 0x9d, 0xa7, 0x38, 0x5b, 0xd8,
+	0x61, 0xfd, 0x69, 0x11, 0xcd, 0xf7, 0x94, 0xa1, 0xf8, 0xd7, 0xd4, 0xc1, 0x5b, 0x9c, 0x27, 0xbf,
+	0x71, 0x0d, 0xc9, 0x56, 0x36, 0x1b, 0x50, 0x77, 0xa3, 0x01, 0x9f, 0xb1, 0xd3, 0x80, 0xed, 0xc6,
+	0x4d, 0xc5, 0xda, 0x75, 0xa0, 0x33, 0xfd, 0x22, 0xcb, 0xf0, 0x3f, 0x41, 0x20, 0xe2, 0xdf, 0x30,
+	0xd0, 0x50, 0x9d, 0x0f, 0x92, 0xbd, 0xed, 0xeb, 0x98, 0xba, 0x60, 0x24, 0x05, 0x81, 0x14, 0x94,
+	0xc0, 0xd6, 0x0f, 0xba, 0x9a, 0x77, 0xc9, 0x16, 0x89, 0x7f, 0xc7, 0x40, 0x93, 0x7e, 0x40, 0x3c,
+	0xd6, 0x8f, 0xff, 0x9a, 0xd8, 0x2a, 0xe5, 0x47, 0xda, 0x7c, 0x7e, 0x4b, 0xd9, 0xf2, 0x11, 0xba,
+	0x76, 0x43, 0x3f, 0xa0, 0x95, 0x5b, 0xe7, 0x9d, 0xd2, 0xe4, 0x4e, 0x1a, 0x05, 0xb2, 0xb0, 0x96,
+	0x8b, 0x66, 0x36, 0x5a, 0xfb, 0xa4, 0xd1, 0x20, 0x8d, 0xd8, 0x50, 0x7e, 0x80, 0x5d, 0x41, 0xc8,
+	0x0e, 0x9c, 0xf8, 0x86, 0x41, 0x2c, 0x28, 0x95, 0x20, 0xb4, 0xab, 0x05, 0x4d, 0x4a, 0x1d, 0xa2,
+	0x0b, 0xbd, 0x0e, 0xd1, 0x56, 0x0b, 0xcd, 0xb2, 0x26, 0x7c, 0xe8, 0xd9, 0xee, 0xba, 0x5f, 0x6f,
+	0xb7, 0x88, 0x17, 0x09, 0x8f, 0x64, 0xda, 0xaf, 0xc6, 0x25, 0xdb, 0xaf, 0x2f, 0xa3, 0x62, 0x3b,
+	0x74, 0x25, 0xe0, 0x98, 0xba, 0x54, 0x80, 0x2d, 0x60, 0x74, 0xeb, 0x0e, 0x1a, 0x60, 0x5e, 0xc1,
+	0x2f, 0xa2, 0x62, 0x68, 0x9f, 0x70, 0xad, 0xe3, 0x95, 0x61, 0x26, 0x02, 0xf6, 0x09, 0x30, 0x9a,
+	0xb5, 0x8e, 0x5e, 0x48, 0x3b, 0x8e, 0x84, 0xd1, 0x99, 0xa8, 0x3e, 0x4b, 0x71, 0xd3, 0x44, 0xdb,
+	0xcd, 0xf4, 0xe6, 0xc6, 0xbb, 0x23, 0x7f, 0xf2, 0x17, 0xa5, 0x1b, 0x5f, 0xfc, 0xeb, 0xe2, 0x0d,
+	0xeb, 0x3f, 0xee, 0xa1, 0xc9, 0x8c, 0xff, 0xf1, 0x3c, 0x2a, 0xa8, 0xfb, 0x0e, 0x24, 0x4d, 0x2b,
+	0x6c, 0xae, 0x43, 0xc1, 0x69, 0xe0, 0xb7, 0x55, 0x1d, 0x26, 0x4c, 0x2f, 0xa9, 0xb2, 0x92, 0x53,
+	0xd9, 0x61, 0x39, 0x51, 0xc7, 0xa6, 0x23, 0xc5, 0xf9, 0x4c, 0xc8, 0x81, 0xcc, 0x26, 0x62, 0x26,
+	0xe4, 0x00, 0x18, 0xed, 0x79, 0x3b, 0xd8, 0x71, 0x0b, 0x7d, 0xf0, 0x12, 0x2d, 0xf4, 0xa1, 0xa7,
+	0xb6, 0xd0, 0x5f, 0x41, 0x83, 0x91, 0x13, 0xb9, 0xc4, 0x1c, 0x4e, 0xb7, 0x28, 0xf6, 0x18, 0x11,
+	0x04, 0x0f, 0x13, 0x34, 0xdc, 0x20, 0x07, 0x36, 0xbb, 0x4e, 0x19, 0xe1, 0x11, 0xff, 0x8b, 0x57,
+	0x8b, 0x78, 0x71, 0x0a, 0x5b, 0x17, 0x2a, 0x21, 0xd6, 0x8d, 0x5f, 0x45, 0xc3, 0x2d, 0xfb, 0xd4,
+	0x69, 0xb5, 0x5b, 0xbc, 0x4c, 0x32, 0x84, 0xd8, 0xb6, 0x20, 0x41, 0xcc, 0x63, 0x9b, 0x07, 0x39,
+	0xad, 0xbb, 0x6d, 0xea, 0x1c, 0x13, 0xc9, 0x94, 0x07, 0x6d, 0xb5, 0x79, 0x6c, 0x64, 0xf8, 0xd0,
+	0x35, 0x82, 0x83, 0x39, 0x1e, 0x1f, 0x3c, 0xa6, 0x81, 0x09, 0x12, 0xc4, 0xbc, 0x34, 0x98, 0x94,
+	0x1f, 0xef, 0x05, 0x26, 0x07, 0x77, 0x8d, 0xc0, 0x5f, 0x45, 0xa3, 0x2d, 0xfb, 0x74, 0x8b, 0x78,
+	0xcd, 0xe8, 0x90, 0x9f, 0x6c, 0x8b, 0xe2, 0x10, 0xbc, 0x1d, 0x13, 0x21, 0xe1, 0x73, 0x61, 0xc7,
+	0x93, 0xc2, 0x37, 0x35, 0xe1, 0x98, 0x08, 0x09, 0x9f, 0x9d, 0x61, 0x02, 0x3b, 0x62, 0xab, 0xd3,
+	0x9c, 0x4c, 0xb7, 0x33, 0x77, 0x05, 0x19, 0x62, 0x3e, 0x5e, 0x42, 0x23, 0x2d, 0xfb, 0x94, 0x2f,
+	0x06, 0x73, 0x8a, 0xab, 0xe5, 0xd7, 0x3c, 0xdb, 0x92, 0x06, 0x8a, 0xcb, 0x25, 0x1d, 0x4f, 0x48,
+	0x4e, 0x6b, 0x92, 0x92, 0x06, 0x8a, 0xcb, 0xe2, 0xb7, 0xed, 0x39, 0x8f, 0xdb, 0x44, 0x08, 0x63,
+	0xee, 0x19, 0x15, 0xbf, 0x1f, 0x27, 0x2c, 0xd0, 0xe5, 0x78, 0xc1, 0xd6, 0x76, 0x23, 0x27, 0x70,
+	0xc9, 0xce, 0x81, 0x79, 0x8b, 0xfb, 0x5f, 0x14, 0x6c, 0x8a, 0x0a, 0x9a, 0x04, 0xfe, 0x0c, 0x0d,
+	0x10, 0xaf, 0xdd, 0x32, 0x67, 0x16, 0x8b, 0x7d, 0x88, 0x3e, 0xb5, 0x5e, 0x36, 0xbc, 0x76, 0x0b,
+	0xb8, 0x66, 0xfc, 0x36, 0x9a, 0x68, 0xd9, 0xa7, 0x32, 0x97, 0x38, 0x84, 0x9a, 0xb3, 0x7c, 0xde,
+	0xd3, 0xec, 0xb8, 0xbb, 0xad, 0x33, 0x20, 0x2d, 0xc7, 0x07, 0x3a, 0x9e, 0x36, 0x70, 0x4e, 0x1b,
+	0xa8, 0x33, 0x20, 0x2d, 0xc7, 0x9c, 0xcc, 0xae, 0xf3, 0x9c, 0x90, 0x34, 0xcc, 0x17, 0x78, 0xb2,
+	0x92, 0xb7, 0x6e, 0x82, 0x06, 0x8a, 0x8b, 0x1f, 0xc7, 0x39, 0xcd, 0xe4, 0x8b, 0x6f, 0xb7, 0x6f,
+	0xdb, 0xcd, 0x4e, 0xb8, 0x1a, 0x86, 0xf6, 0x59, 0x77, 0x96, 0xc4, 0x1e, 0x1a, 0xb4, 0x5d, 0x77,
+	0xe7, 0xc0, 0x7c, 0x71, 0xb1, 0xd8, 0xdf, 0x1d, 0x4e, 0x65, 0x98, 0x55, 0xa6, 0x1f, 0x04, 0x0c,
+	0xc3, 0xf3, 0x3d, 0x16, 0x0b, 0xf3, 0xd7, 0x86, 0xb7, 0xc3, 0xf4, 0x83, 0x80, 0xe1, 0xf3, 0xf3,
+	0xce, 0x76, 0x0e, 0xcc, 0x97, 0xae, 0x6f, 0x7e, 0x4c, 0x3f, 0x08, 0x18, 0xdc, 0x40, 0x45, 0xcf,
+	0x8f, 0xcc, 0xdb, 0xfd, 0xae, 0x17, 0xf8, 0x6e, 0xf2, 0xc8, 0x8f, 0x80, 0xa9, 0xc7, 0xbf, 0x67,
+	0x20, 0x14, 0x24, 0x91, 0xf8, 0xf2, 0x55, 0xeb, 0xa8, 0x0c, 0x5a, 0x39, 0x89, 0xde, 0x0d, 0x2f,
+	0x0a, 0xcf, 0x92, 0xda, 0x22, 0x61, 0x80, 0x66, 0x00, 0xfe, 0x33, 0x03, 0xcd, 0xe8, 0x07, 0x63,
+	0x65, 0xd9, 0xc2, 0x55, 0x9b, 0x7b, 0x5d, 0x81, 0x5c, 0xf1, 0x7d, 0xb7, 0x62, 0x9e, 0x77, 0x4a,
+	0x33, 0xab, 0x39, 0x80, 0x90, 0x6b, 0x06, 0xfe, 0x6b, 0x03, 0x4d, 0xcb, 0xec, 0xa8, 0x19, 0x57,
+	0xe2, 0x6e, 0xfb, 0xac, 0x8f, 0x6e, 0xcb, 0x42, 0x08, 0xef, 0xa9, 0xb7, 0x1f, 0x5d, 0x7c, 0xe8,
+	0xb6, 0x0a, 0xff, 0x9d, 0x81, 0xc6, 0x1b, 0x24, 0x20, 0x5e, 0x83, 0x78, 0x75, 0x66, 0xe6, 0xe2,
+	0x55, 0x3b, 0x90, 0x59, 0x33, 0xd7, 0x35, 0xed, 0xc2, 0xc2, 0x72, 0x7c, 0x28, 0xd7, 0x59, 0xec,
+	0x86, 0x3a, 0x19, 0xaa, 0x73, 0x20, 0x65, 0x20, 0xfe, 0x7d, 0x03, 0x4d, 0x26, 0x6e, 0x17, 0x1b,
+	0xc4, 0x9d, 0xeb, 0xf9, 0xf0, 0xbc, 0x6c, 0x5e, 0x4d, 0x63, 0x41, 0x16, 0x1c, 0xff, 0x8d, 0xc1,
+	0xaa, 0xad, 0xb8, 0xab, 0x43, 0x4d, 0x8b, 0x7b, 0xf0, 0x9b, 0xfd, 0xf4, 0xa0, 0x52, 0x2e, 0x1c,
+	0x78, 0x37, 0xa9, 0xe4, 0x14, 0xe7, 0xa2, 0x53, 0x9a, 0xd5, 0xfd, 0xa7, 0x18, 0xa0, 0x1b, 0x87,
+	0xbf, 0x6b, 0xa0, 0x71, 0x92, 0x94, 0xdd, 0xd4, 0x7c, 0xe5, 0xaa, 0xae, 0xcb, 0x2d, 0xe2, 0x45,
+	0x1f, 0x47, 0x63, 0x51, 0x48, 0xc1, 0xb2, 0xda, 0x8f, 0x9c, 0xda, 0xad, 0xc0, 0x25, 0xe6, 0xcf,
+	0xf5, 0xaf, 0xf6, 0xdb, 0x10, 0x2a, 0x21, 0xd6, 0xcd, 0x5a, 0x0c, 0x5e, 0xdb, 0x75, 0xed, 0x7d,
+	0x97, 0x98, 0xaf, 0xf2, 0x2a, 0x42, 0xb5, 0x18, 0x1e, 0x49, 0x3a, 0x28, 0x09, 0xfc, 0x6d, 0x34,
+	0x58, 0xf7, 0x3d, 0x1a, 0x99, 0xf7, 0xfa, 0x62, 0x12, 0xdf, 0xff, 0xd6, 0x98, 0x42, 0x10, 0x7a,
+	0xb1, 0x8d, 0x0a, 0xce, 0x81, 0x59, 0xee, 0x77, 0xba, 0x1e, 0xe2, 0xc7, 0x89, 0x03, 0x28, 0x38,
+	0x07, 0xb8, 0x89, 0x06, 0xa2, 0x43, 0xe2, 0x99, 0xcb, 0xfd, 0x06, 0x19, 0xe1, 0x47, 0x81, 0x43,
+	0xe2, 0x01, 0x07, 0x60, 0x40, 0xc4, 0xa5, 0xc4, 0x7c, 0xe3, 0x5a, 0x80, 0x36, 0x5c, 0x4a, 0x80,
+	0x03, 0xe0, 0x27, 0x06, 0x9a, 0x8e, 0x33, 0x40, 0x14, 0xd7, 0x31, 0xe6, 0xfd, 0x7e, 0xa7, 0xd3,
+	0xf5, 0x2c, 0x84, 0x58, 0x6b, 0x0f, 0x92, 0xa7, 0x74, 0x19, 0xfe, 0x45, 0xa7, 0xf4, 0x52, 0x77,
+	0xc6, 0x52, 0x6c, 0xe8, 0x36, 0x9a, 0x75, 0x6d, 0x27, 0x02, 0xfd, 0x60, 0x69, 0xae, 0xf4, 0xdb,
+	0x7b, 0xbc, 0x42, 0x4c, 0x1d, 0x5e, 0x21, 0x0d, 0x89, 0x0f, 0xd0, 0xe2, 0xe9, 0x87, 0xea, 0xe1,
+	0x67, 0xee, 0xe5, 0xa1, 0xf9, 0x1a, 0x5f, 0x2b, 0xbc, 0x61, 0x59, 0xcb, 0x95, 0x80, 0x67, 0xea,
+	0xc0, 0xdf, 0x42, 0x2f, 0x69, 0x32, 0xd9, 0xce, 0x82, 0xf9, 0xf3, 0x1c, 0x42, 0xed, 0x56, 0xb5,
+	0xac, 0x00, 0x3c, 0x6d, 0x34, 0xde, 0x42, 0x73, 0x1a, 0x7b, 0xd3, 0x8b, 0x76, 0xc2, 0x6a, 0x14,
+	0xb2, 0x9b, 0x90, 0x25, 0xae, 0x57, 0x35, 0x7e, 0x6b, 0x1a, 0x0f, 0x7a, 0x8c, 0xc1, 0xef, 0xa7,
+	0xb4, 0xf1, 0x47, 0x13, 0x76, 0xf0, 0x21, 0x39, 0xa3, 0xe6, 0x57, 0x92, 0xae, 0x74, 0x4d, 0xa3,
+	0x43, 0x0f, 0x79, 0xfc, 0x4b, 0xe8, 0x56, 0x86, 0xc3, 0x4e, 0xcf, 0xe6, 0xeb, 0xe2, 0x18, 0xcc,
+	0xce, 0x5b, 0xb5, 0x98, 0x08, 0x79, 0x92, 0xf8, 0x17, 0x10, 0xd6, 0xc8, 0xdb, 0x76, 0xc0, 0xc7,
+	0x7f, 0x55, 0x9c, 0xc8, 0x59, 0xde, 0xaa, 0x49, 0x1a, 0xe4, 0xc8, 0xe1, 0x3f, 0x32, 0x52, 0x33,
+	0x49, 0xfa, 0x4c, 0xd4, 0xbc, 0xcb, 0x17, 0xcc, 0xfb, 0xcf, 0x1f, 0x69, 0x89, 0x32, 0x68, 0xbb,
+	0x44, 0xf3, 0xb0, 0x86, 0x02, 0x3d, 0xd0, 0x71, 0x05, 0xcd, 0xa4, 0x39, 0x6d, 0xc2, 0x27, 0xf6,
+	0x35, 0xd1, 0x46, 0x60, 0xd5, 0x5e, 0x4d, 0x51, 0x21, 0x57, 0x36, 0xa3, 0xe3, 0x11, 0xeb, 0x34,
+	0xb8, 0xce, 0x77, 0x88, 0xf9, 0x66, 0xf2, 0xa4, 0xa2, 0xa6, 0xa8, 0x90, 0x2b, 0x8b, 0x37, 0xd0,
+	0xac, 0x46, 0x4f, 0xae, 0xd8, 0xcc, 0xb7, 0xc4, 0x31, 0x97, 0xed, 0xaa, 0xb5, 0x84, 0x0c, 0xf9,
+	0xd2, 0xf8, 0x61, 0xca, 0x94, 0x2a, 0xe1, 0x4f, 0x86, 0x8e, 0x89, 0xf9, 0xf5, 0xf4, 0x15, 0x5e,
+	0x4d, 0x71, 0x20, 0x57, 0x1e, 0xef, 0xa1, 0x79, 0x8d, 0xfe, 0x69, 0xe8, 0x44, 0x64, 0x97, 0x84,
+	0x2d, 0x87, 0xf2, 0x56, 0xdb, 0xdb, 0xdc, 0xa6, 0x19, 0xd6, 0x0d, 0xa8, 0x65, 0x78, 0xf0, 0x94,
+	0x71, 0x99, 0x49, 0x26, 0xf7, 0xbc, 0xe6, 0x03, 0x6d, 0x92, 0x09, 0x19, 0xf2, 0xa5, 0xf1, 0x3f,
+	0x1a, 0x68, 0xf1, 0x29, 0x6b, 0x90, 0xf5, 0xf2, 0xa8, 0xf9, 0xce, 0x62, 0xf1, 0x6a, 0xaf, 0x08,
+	0xf2, 0xd4, 0xaa, 0x07, 0x23, 0x73, 0xb5, 0x5c, 0x54, 0x78, 0xa6, 0x5d, 0xec, 0xd0, 0xa2, 0xbb,
+	0x1c, 0xc8, 0x01, 0x09, 0x89, 0x57, 0x27, 0xe6, 0xbb, 0x57, 0xcd, 0xb8, 0x4a, 0xd5, 0x9e, 0x1d,
+	0x36, 0x49, 0x24, 0x03, 0x4f, 0x51, 0x21, 0x17, 0x76, 0x9e, 0xf5, 0x79, 0x33, 0xa5, 0x3a, 0x9e,
+	0x42, 0xc5, 0x23, 0x22, 0xdf, 0x6c, 0x02, 0xfb, 0x93, 0x55, 0x20, 0xc7, 0x2c, 0xde, 0xcd, 0xc2,
+	0x55, 0xad, 0xcc, 0xec, 0x0b, 0x20, 0xf4, 0xbe, 0x5b, 0x78, 0x60, 0xcc, 0x7f, 0xdf, 0x40, 0x73,
+	0xf9, 0x87, 0x87, 0x9f, 0x95, 0x45, 0x7f, 0x6c, 0xa0, 0xe9, 0xae, 0x73, 0x42, 0x8e, 0x31, 0x6e,
+	0xda, 0x98, 0x4f, 0xfa, 0x58, 0xf0, 0x8b, 0x9d, 0x80, 0x37, 0x2e, 0x74, 0xcb, 0x7e, 0xd7, 0x40,
+	0x53, 0xd9, 0xfa, 0xfb, 0x67, 0xe8, 0xa5, 0xb9, 0xfc, 0x2a, 0x25, 0xc7, 0xa2, 0x66, 0xda, 0xa2,
+	0x8f, 0xfa, 0x65, 0x51, 0x52, 0x56, 0x24, 0x96, 0x59, 0xdf, 0x2b, 0xa0, 0xb9, 0xfc, 0x26, 0x10,
+	0x6e, 0xa9, 0xf6, 0x76, 0xdf, 0x6f, 0x35, 0xf2, 0x5e, 0x17, 0x7c, 0x61, 0xa0, 0xb1, 0xcf, 0x95,
+	0x5c, 0xfc, 0xc8, 0xb1, 0x9f, 0x57, 0x29, 0xf1, 0xd9, 0x2b, 0x61, 0x50, 0xd0, 0x21, 0xad, 0xbf,
+	0x35, 0xd0, 0x6c, 0xee, 0x79, 0x92, 0x75, 0xcf, 0x6d, 0xd7, 0xf5, 0x4f, 0xc4, 0x35, 0x9c, 0xf6,
+	0x82, 0x64, 0x95, 0x53, 0x41, 0x72, 0x35, 0x9f, 0x15, 0x7e, 0x0a, 0x3e, 0xb3, 0xfe, 0xc1, 0x40,
+	0xb7, 0x9f, 0xb6, 0x1e, 0x7e, 0xda, 0xdf, 0x70, 0x89, 0x3d, 0xa4, 0x17, 0x91, 0x26, 0x5f, 0x05,
+	0x8c, 0x8b, 0x47, 0xf4, 0x82, 0x06, 0x8a, 0x6b, 0xfd, 0xc8, 0x40, 0x93, 0x99, 0x74, 0x7c, 0xb9,
+	0xd7, 0x91, 0x77, 0xf9, 0x7f, 0x3a, 0x88, 0x42, 0x34, 0x73, 0xf5, 0xac, 0xea, 0x4f, 0x25, 0x91,
+	0xbc, 0x66, 0x2c, 0xfe, 0x24, 0xaf, 0x19, 0xdf, 0x41, 0x43, 0x81, 0xef, 0x3a, 0xf5, 0x33, 0x73,
+	0x20, 0xfd, 0xda, 0x79, 0x97, 0x53, 0xd9, 0x6b, 0x67, 0x65, 0xbb, 0x20, 0x81, 0x1c, 0x60, 0xfd,
+	0xa5, 0x81, 0xa6, 0xd8, 0xab, 0x22, 0xa7, 0x4e, 0x94, 0x08, 0x5e, 0x46, 0xa3, 0x9e, 0x7a, 0x51,
+	0x20, 0x26, 0x37, 0x2d, 0x55, 0x8e, 0x26, 0x0f, 0x09, 0x12, 0x19, 0x75, 0x03, 0x5f, 0xe8, 0x79,
+	0x03, 0x7f, 0x1b, 0x0d, 0x04, 0xc9, 0x8d, 0x34, 0x3f, 0x78, 0xf1, 0x4b, 0x68, 0x4e, 0xe5, 0x5c,
+	0x3f, 0x8c, 0xb8, 0xf9, 0x83, 0x92, 0xeb, 0x87, 0x11, 0x70, 0xaa, 0xf5, 0x2b, 0xe8, 0x66, 0xba,
+	0x22, 0x64, 0x78, 0x61, 0xdb, 0xed, 0xba, 0xf1, 0x67, 0x3c, 0xe0, 0x1c, 0xfd, 0x95, 0x74, 0xe1,
+	0x19, 0xaf, 0xa4, 0xff, 0xd9, 0x40, 0xb7, 0xe2, 0x7f, 0x22, 0x70, 0x1d, 0xe2, 0x45, 0x6b, 0xbe,
+	0x77, 0xe0, 0x34, 0xf1, 0x8b, 0xe2, 0x9a, 0x4f, 0xbb, 0xf5, 0x8a, 0xaf, 0xf8, 0xf0, 0x63, 0x34,
+	0x4c, 0x85, 0xd3, 0x64, 0x9c, 0x7e, 0xf0, 0xfc, 0x71, 0x9a, 0xf5, 0xbe, 0xe8, 0x2f, 0xc4, 0xd4,
+	0x18, 0x87, 0x85, 0x6a, 0xdd, 0xae, 0xb4, 0xbd, 0x86, 0xbc, 0xdc, 0x1e, 0x17, 0xa1, 0xba, 0xb6,
+	0x2a, 0x68, 0xa0, 0xb8, 0xd6, 0x7f, 0x1a, 0x68, 0xba, 0xeb, 0x9f, 0x22, 0xf0, 0x6f, 0x19, 0x68,
+	0xbc, 0xae, 0x4d, 0x4f, 0x2e, 0xf8, 0xed, 0xab, 0xff, 0xe3, 0x85, 0xa6, 0x54, 0x1c, 0x5f, 0x74,
+	0x0a, 0xa4, 0x40, 0x71, 0x0d, 0x99, 0xf5, 0xcc, 0xff, 0x1f, 0x65, 0x5e, 0xd9, 0xdd, 0x66, 0xcf,
+	0x94, 0xd6, 0x7a, 0xc8, 0x40, 0xcf, 0xd1, 0x95, 0xa5, 0x27, 0x5f, 0x2e, 0xdc, 0xf8, 0xe1, 0x97,
+	0x0b, 0x37, 0x7e, 0xfc, 0xe5, 0xc2, 0x8d, 0x2f, 0xce, 0x17, 0x8c, 0x27, 0xe7, 0x0b, 0xc6, 0x0f,
+	0xcf, 0x17, 0x8c, 0x1f, 0x9f, 0x2f, 0x18, 0xff, 0x76, 0xbe, 0x60, 0xfc, 0xc1, 0xbf, 0x2f, 0xdc,
+	0xf8, 0x66, 0xe1, 0xf8, 0xfe, 0xff, 0x0f, 0x00, 0xe4, 0x04, 0x9b, 0x8a, 0x93, 0x38, 0x00, 0x00,
 }
 
 func (m *ConversionRequest) Marshal() (dAtA []byte, err error) {
@@ -1479,6 +1756,42 @@ func (m *CustomResourceDefinitionSpec) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i--
 	if m.PreserveUnknownFields {
 		dAtA[i] = 1
@@ -1612,6 +1925,18 @@ func (m *CustomResourceDefinitionVersion) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Generation != nil {
+		{
+			size, err := m.Generation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.DeprecationWarning != nil {
 		i -= len(*m.DeprecationWarning)
 		copy(dAtA[i:], *m.DeprecationWarning)
@@ -1689,7 +2014,7 @@ func (m *CustomResourceDefinitionVersion) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceGeneration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1699,37 +2024,38 @@ func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresourceScale) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceGeneration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresourceScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceGeneration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LabelSelectorPath != nil {
-		i -= len(*m.LabelSelectorPath)
-		copy(dAtA[i:], *m.LabelSelectorPath)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.LabelSelectorPath)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.IgnoredPaths) > 0 {
+		for iNdEx := len(m.IgnoredPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoredPaths[iNdEx])
+			copy(dAtA[i:], m.IgnoredPaths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoredPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	i -= len(m.StatusReplicasPath)
-	copy(dAtA[i:], m.StatusReplicasPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusReplicasPath)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.SpecReplicasPath)
-	copy(dAtA[i:], m.SpecReplicasPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SpecReplicasPath)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1739,20 +2065,23 @@ func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresourceStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceHistory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxRevisions))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1762,44 +2091,35 @@ func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresources) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Scale != nil {
-		{
-			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MaxObjectSizeBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjectSizeBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MaxObjectsPerNamespace != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjectsPerNamespace))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.MaxObjects != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjects))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceRetention) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1809,20 +2129,203 @@ func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceValidation) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceRetention) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OpenAPIV3Schema != nil {
-		{
-			size, err := m.OpenAPIV3Schema.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TTLSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceCustom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceCustom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceCustom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LabelSelectorPath != nil {
+		i -= len(*m.LabelSelectorPath)
+		copy(dAtA[i:], *m.LabelSelectorPath)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.LabelSelectorPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.StatusReplicasPath)
+	copy(dAtA[i:], m.StatusReplicasPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusReplicasPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SpecReplicasPath)
+	copy(dAtA[i:], m.SpecReplicasPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SpecReplicasPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Custom) > 0 {
+		for iNdEx := len(m.Custom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Custom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Scale != nil {
+		{
+			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenAPIV3Schema != nil {
+		{
+			size, err := m.OpenAPIV3Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -1834,6 +2337,39 @@ func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EmbeddedResourceKind) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmbeddedResourceKind) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmbeddedResourceKind) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExternalDocumentation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1897,6 +2433,38 @@ func (m *JSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m JSONSchemaPropertyNames) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m JSONSchemaPropertyNames) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m JSONSchemaPropertyNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m) > 0 {
+		for iNdEx := len(m) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m[iNdEx])
+			copy(dAtA[i:], m[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JSONSchemaProps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1917,10 +2485,24 @@ func (m *JSONSchemaProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.XValidations) > 0 {
-		for iNdEx := len(m.XValidations) - 1; iNdEx >= 0; iNdEx-- {
+	if m.XReference != nil {
+		{
+			size, err := m.XReference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.XEmbeddedResourceKinds) > 0 {
+		for iNdEx := len(m.XEmbeddedResourceKinds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.XValidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.XEmbeddedResourceKinds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1928,21 +2510,195 @@ func (m *JSONSchemaProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0xca
 		}
 	}
-	if m.XMapType != nil {
-		i -= len(*m.XMapType)
-		copy(dAtA[i:], *m.XMapType)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XMapType)))
+	if m.XConditions != nil {
+		i -= len(*m.XConditions)
+		copy(dAtA[i:], *m.XConditions)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XConditions)))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xc2
 	}
-	if m.XListType != nil {
+	if m.XWritePermission != nil {
+		i -= len(*m.XWritePermission)
+		copy(dAtA[i:], *m.XWritePermission)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XWritePermission)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xba
+	}
+	i--
+	if m.XSensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xb0
+	if m.XDeprecated != nil {
+		i -= len(*m.XDeprecated)
+		copy(dAtA[i:], *m.XDeprecated)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XDeprecated)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.XNormalize) > 0 {
+		for iNdEx := len(m.XNormalize) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.XNormalize[iNdEx])
+			copy(dAtA[i:], m.XNormalize[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.XNormalize[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.XValueType != nil {
+		i -= len(*m.XValueType)
+		copy(dAtA[i:], *m.XValueType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XValueType)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PropertyNames != nil {
+		{
+			size, err := m.PropertyNames.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DependentRequired) > 0 {
+		keysForDependentRequired := make([]string, 0, len(m.DependentRequired))
+		for k := range m.DependentRequired {
+			keysForDependentRequired = append(keysForDependentRequired, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForDependentRequired)
+		for iNdEx := len(keysForDependentRequired) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.DependentRequired[string(keysForDependentRequired[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForDependentRequired[iNdEx])
+			copy(dAtA[i:], keysForDependentRequired[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForDependentRequired[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Else != nil {
+		{
+			size, err := m.Else.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Then != nil {
+		{
+			size, err := m.Then.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.If != nil {
+		{
+			size, err := m.If.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Const != nil {
+		{
+			size, err := m.Const.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.XValidations) > 0 {
+		for iNdEx := len(m.XValidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XValidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.XMapType != nil {
+		i -= len(*m.XMapType)
+		copy(dAtA[i:], *m.XMapType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XMapType)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.XListType != nil {
 		i -= len(*m.XListType)
 		copy(dAtA[i:], *m.XListType)
 		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XListType)))
@@ -2564,6 +3320,49 @@ func (m *JSONSchemaPropsOrStringArray) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *ReferenceTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Scope)
+	copy(dAtA[i:], m.Scope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ServiceReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2939,6 +3738,18 @@ func (m *CustomResourceDefinitionSpec) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2994,6 +3805,82 @@ func (m *CustomResourceDefinitionVersion) Size() (n int) {
 		l = len(*m.DeprecationWarning)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Generation != nil {
+		l = m.Generation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomResourceGeneration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.IgnoredPaths) > 0 {
+		for _, s := range m.IgnoredPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CustomResourceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxRevisions))
+	return n
+}
+
+func (m *CustomResourceLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxObjects != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjects))
+	}
+	if m.MaxObjectsPerNamespace != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjectsPerNamespace))
+	}
+	if m.MaxObjectSizeBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjectSizeBytes))
+	}
+	return n
+}
+
+func (m *CustomResourceRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TTLSeconds))
+	return n
+}
+
+func (m *CustomResourceSubresourceCustom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3037,6 +3924,12 @@ func (m *CustomResourceSubresources) Size() (n int) {
 		l = m.Scale.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Custom) > 0 {
+		for _, e := range m.Custom {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3053,6 +3946,19 @@ func (m *CustomResourceValidation) Size() (n int) {
 	return n
 }
 
+func (m *EmbeddedResourceKind) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ExternalDocumentation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3079,6 +3985,21 @@ func (m *JSON) Size() (n int) {
 	return n
 }
 
+func (m JSONSchemaPropertyNames) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m) > 0 {
+		for _, s := range m {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *JSONSchemaProps) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3253,17 +4174,79 @@ func (m *JSONSchemaProps) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *JSONSchemaPropsOrArray) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Const != nil {
+		l = m.Const.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Schema != nil {
-		l = m.Schema.Size()
+	if m.If != nil {
+		l = m.If.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Then != nil {
+		l = m.Then.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Else != nil {
+		l = m.Else.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DependentRequired) > 0 {
+		for k, v := range m.DependentRequired {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.PropertyNames != nil {
+		l = m.PropertyNames.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.XValueType != nil {
+		l = len(*m.XValueType)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.XNormalize) > 0 {
+		for _, s := range m.XNormalize {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.XDeprecated != nil {
+		l = len(*m.XDeprecated)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	if m.XWritePermission != nil {
+		l = len(*m.XWritePermission)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.XConditions != nil {
+		l = len(*m.XConditions)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.XEmbeddedResourceKinds) > 0 {
+		for _, e := range m.XEmbeddedResourceKinds {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.XReference != nil {
+		l = m.XReference.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JSONSchemaPropsOrArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	if len(m.JSONSchemas) > 0 {
@@ -3308,6 +4291,23 @@ func (m *JSONSchemaPropsOrStringArray) Size() (n int) {
 	return n
 }
 
+func (m *ReferenceTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Scope)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ServiceReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3531,6 +4531,9 @@ func (this *CustomResourceDefinitionSpec) String() string {
 		`Versions:` + repeatedStringForVersions + `,`,
 		`Conversion:` + strings.Replace(this.Conversion.String(), "CustomResourceConversion", "CustomResourceConversion", 1) + `,`,
 		`PreserveUnknownFields:` + fmt.Sprintf("%v", this.PreserveUnknownFields) + `,`,
+		`Limits:` + strings.Replace(this.Limits.String(), "CustomResourceLimits", "CustomResourceLimits", 1) + `,`,
+		`Retention:` + strings.Replace(this.Retention.String(), "CustomResourceRetention", "CustomResourceRetention", 1) + `,`,
+		`History:` + strings.Replace(this.History.String(), "CustomResourceHistory", "CustomResourceHistory", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3570,6 +4573,61 @@ func (this *CustomResourceDefinitionVersion) String() string {
 		`AdditionalPrinterColumns:` + repeatedStringForAdditionalPrinterColumns + `,`,
 		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
 		`DeprecationWarning:` + valueToStringGenerated(this.DeprecationWarning) + `,`,
+		`Generation:` + strings.Replace(this.Generation.String(), "CustomResourceGeneration", "CustomResourceGeneration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceGeneration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceGeneration{`,
+		`Paths:` + fmt.Sprintf("%v", this.Paths) + `,`,
+		`IgnoredPaths:` + fmt.Sprintf("%v", this.IgnoredPaths) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceHistory{`,
+		`MaxRevisions:` + fmt.Sprintf("%v", this.MaxRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceLimits{`,
+		`MaxObjects:` + valueToStringGenerated(this.MaxObjects) + `,`,
+		`MaxObjectsPerNamespace:` + valueToStringGenerated(this.MaxObjectsPerNamespace) + `,`,
+		`MaxObjectSizeBytes:` + valueToStringGenerated(this.MaxObjectSizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceRetention) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceRetention{`,
+		`TTLSeconds:` + fmt.Sprintf("%v", this.TTLSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceSubresourceCustom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceSubresourceCustom{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3599,9 +4657,15 @@ func (this *CustomResourceSubresources) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCustom := "[]CustomResourceSubresourceCustom{"
+	for _, f := range this.Custom {
+		repeatedStringForCustom += strings.Replace(strings.Replace(f.String(), "CustomResourceSubresourceCustom", "CustomResourceSubresourceCustom", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCustom += "}"
 	s := strings.Join([]string{`&CustomResourceSubresources{`,
 		`Status:` + strings.Replace(this.Status.String(), "CustomResourceSubresourceStatus", "CustomResourceSubresourceStatus", 1) + `,`,
 		`Scale:` + strings.Replace(this.Scale.String(), "CustomResourceSubresourceScale", "CustomResourceSubresourceScale", 1) + `,`,
+		`Custom:` + repeatedStringForCustom + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3616,6 +4680,17 @@ func (this *CustomResourceValidation) String() string {
 	}, "")
 	return s
 }
+func (this *EmbeddedResourceKind) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmbeddedResourceKind{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExternalDocumentation) String() string {
 	if this == nil {
 		return "nil"
@@ -3666,6 +4741,11 @@ func (this *JSONSchemaProps) String() string {
 		repeatedStringForXValidations += strings.Replace(strings.Replace(f.String(), "ValidationRule", "ValidationRule", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForXValidations += "}"
+	repeatedStringForXEmbeddedResourceKinds := "[]EmbeddedResourceKind{"
+	for _, f := range this.XEmbeddedResourceKinds {
+		repeatedStringForXEmbeddedResourceKinds += strings.Replace(strings.Replace(f.String(), "EmbeddedResourceKind", "EmbeddedResourceKind", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForXEmbeddedResourceKinds += "}"
 	keysForProperties := make([]string, 0, len(this.Properties))
 	for k := range this.Properties {
 		keysForProperties = append(keysForProperties, k)
@@ -3706,6 +4786,16 @@ func (this *JSONSchemaProps) String() string {
 		mapStringForDefinitions += fmt.Sprintf("%v: %v,", k, this.Definitions[k])
 	}
 	mapStringForDefinitions += "}"
+	keysForDependentRequired := make([]string, 0, len(this.DependentRequired))
+	for k := range this.DependentRequired {
+		keysForDependentRequired = append(keysForDependentRequired, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDependentRequired)
+	mapStringForDependentRequired := "JSONSchemaDependentRequired{"
+	for _, k := range keysForDependentRequired {
+		mapStringForDependentRequired += fmt.Sprintf("%v: %v,", k, this.DependentRequired[k])
+	}
+	mapStringForDependentRequired += "}"
 	s := strings.Join([]string{`&JSONSchemaProps{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
@@ -3751,6 +4841,20 @@ func (this *JSONSchemaProps) String() string {
 		`XListType:` + valueToStringGenerated(this.XListType) + `,`,
 		`XMapType:` + valueToStringGenerated(this.XMapType) + `,`,
 		`XValidations:` + repeatedStringForXValidations + `,`,
+		`Const:` + strings.Replace(this.Const.String(), "JSON", "JSON", 1) + `,`,
+		`If:` + strings.Replace(this.If.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`Then:` + strings.Replace(this.Then.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`Else:` + strings.Replace(this.Else.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`DependentRequired:` + mapStringForDependentRequired + `,`,
+		`PropertyNames:` + strings.Replace(this.PropertyNames.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`XValueType:` + valueToStringGenerated(this.XValueType) + `,`,
+		`XNormalize:` + fmt.Sprintf("%v", this.XNormalize) + `,`,
+		`XDeprecated:` + valueToStringGenerated(this.XDeprecated) + `,`,
+		`XSensitive:` + fmt.Sprintf("%v", this.XSensitive) + `,`,
+		`XWritePermission:` + valueToStringGenerated(this.XWritePermission) + `,`,
+		`XConditions:` + valueToStringGenerated(this.XConditions) + `,`,
+		`XEmbeddedResourceKinds:` + repeatedStringForXEmbeddedResourceKinds + `,`,
+		`XReference:` + strings.Replace(this.XReference.String(), "ReferenceTarget", "ReferenceTarget", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3793,6 +4897,19 @@ func (this *JSONSchemaPropsOrStringArray) String() string {
 	}, "")
 	return s
 }
+func (this *ReferenceTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReferenceTarget{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ServiceReference) String() string {
 	if this == nil {
 		return "nil"
@@ -3833,22 +4950,788 @@ func (this *WebhookConversion) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebhookConversion{`,
-		`ClientConfig:` + strings.Replace(this.ClientConfig.String(), "WebhookClientConfig", "WebhookClientConfig", 1) + `,`,
-		`ConversionReviewVersions:` + fmt.Sprintf("%v", this.ConversionReviewVersions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&WebhookConversion{`,
+		`ClientConfig:` + strings.Replace(this.ClientConfig.String(), "WebhookClientConfig", "WebhookClientConfig", 1) + `,`,
+		`ConversionReviewVersions:` + fmt.Sprintf("%v", this.ConversionReviewVersions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAPIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredAPIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, runtime.RawExtension{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedObjects = append(m.ConvertedObjects, runtime.RawExtension{})
+			if err := m.ConvertedObjects[len(m.ConvertedObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ConversionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &ConversionResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceColumnDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceColumnDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ConversionStrategyType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookConversion{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3871,17 +5754,17 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3891,29 +5774,30 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAPIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3923,27 +5807,28 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredAPIVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3970,8 +5855,7 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, runtime.RawExtension{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3996,7 +5880,7 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4019,15 +5903,15 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4055,13 +5939,13 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			m.Type = CustomResourceDefinitionConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedObjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4071,29 +5955,27 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConvertedObjects = append(m.ConvertedObjects, runtime.RawExtension{})
-			if err := m.ConvertedObjects[len(m.ConvertedObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4120,10 +6002,74 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4145,7 +6091,7 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConversionReview) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4168,15 +6114,15 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionReview: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionReview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4203,16 +6149,13 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ConversionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4239,10 +6182,8 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &ConversionResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CustomResourceDefinition{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4267,7 +6208,7 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4290,15 +6231,15 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceColumnDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionNames: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceColumnDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionNames: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plural", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4326,11 +6267,11 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Plural = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Singular", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4358,11 +6299,11 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Singular = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4390,11 +6331,11 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.ShortNames = append(m.ShortNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4422,13 +6363,13 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListKind", wireType)
 			}
-			m.Priority = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4438,14 +6379,27 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4473,7 +6427,7 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			m.Categories = append(m.Categories, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4496,7 +6450,7 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4519,15 +6473,15 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceConversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4555,11 +6509,11 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = ConversionStrategyType(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4586,68 +6540,15 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookConversion{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Names.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4657,28 +6558,27 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4705,13 +6605,14 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, CustomResourceDefinitionVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4738,97 +6639,18 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Conversion == nil {
+				m.Conversion = &CustomResourceConversion{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = CustomResourceDefinitionConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveUnknownFields", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4838,27 +6660,15 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.PreserveUnknownFields = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4885,15 +6695,18 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Limits == nil {
+				m.Limits = &CustomResourceLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4903,29 +6716,33 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Retention == nil {
+				m.Retention = &CustomResourceRetention{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4935,23 +6752,27 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.History == nil {
+				m.History = &CustomResourceHistory{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4974,7 +6795,7 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4997,15 +6818,15 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5032,13 +6853,14 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, CustomResourceDefinitionCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedNames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5065,11 +6887,42 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CustomResourceDefinition{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AcceptedNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredVersions = append(m.StoredVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5091,7 +6944,7 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5114,15 +6967,15 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionNames: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionNames: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plural", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5150,13 +7003,13 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plural = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Singular", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5166,29 +7019,17 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Singular = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Served = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortNames", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5198,61 +7039,17 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ShortNames = append(m.ShortNames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Storage = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5262,111 +7059,33 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ListKind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Schema == nil {
+				m.Schema = &CustomResourceValidation{}
 			}
-			m.Categories = append(m.Categories, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subresources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5376,27 +7095,31 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Subresources == nil {
+				m.Subresources = &CustomResourceSubresources{}
+			}
+			if err := m.Subresources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5423,15 +7146,16 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Names.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
+			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5441,29 +7165,17 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.Deprecated = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecationWarning", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5473,29 +7185,28 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, CustomResourceDefinitionVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DeprecationWarning = &s
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5522,33 +7233,13 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Conversion == nil {
-				m.Conversion = &CustomResourceConversion{}
+			if m.Generation == nil {
+				m.Generation = &CustomResourceGeneration{}
 			}
-			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Generation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveUnknownFields", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PreserveUnknownFields = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5570,7 +7261,7 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceGeneration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5593,17 +7284,17 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceGeneration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceGeneration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5613,62 +7304,27 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, CustomResourceDefinitionCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedNames", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AcceptedNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredPaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5696,7 +7352,7 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredVersions = append(m.StoredVersions, string(dAtA[iNdEx:postIndex]))
+			m.IgnoredPaths = append(m.IgnoredPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5719,7 +7375,7 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5742,17 +7398,17 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRevisions", wireType)
 			}
-			var stringLen uint64
+			m.MaxRevisions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5762,49 +7418,66 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRevisions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Served = bool(v != 0)
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjects", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5814,17 +7487,17 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Storage = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			m.MaxObjects = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjectsPerNamespace", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5833,34 +7506,18 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Schema == nil {
-				m.Schema = &CustomResourceValidation{}
-			}
-			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subresources", wireType)
+			m.MaxObjectsPerNamespace = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjectSizeBytes", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5870,33 +7527,67 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.MaxObjectSizeBytes = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Subresources == nil {
-				m.Subresources = &CustomResourceSubresources{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Subresources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSeconds", wireType)
+			}
+			m.TTLSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5906,31 +7597,66 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TTLSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
-			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceSubresourceCustom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceSubresourceCustom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceSubresourceCustom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5940,15 +7666,27 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Deprecated = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecationWarning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5976,8 +7714,7 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.DeprecationWarning = &s
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6258,13 +7995,133 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 			if m.Status == nil {
 				m.Status = &CustomResourceSubresourceStatus{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scale == nil {
+				m.Scale = &CustomResourceSubresourceScale{}
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Custom = append(m.Custom, CustomResourceSubresourceCustom{})
+			if err := m.Custom[len(m.Custom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenAPIV3Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6291,10 +8148,10 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scale == nil {
-				m.Scale = &CustomResourceSubresourceScale{}
+			if m.OpenAPIV3Schema == nil {
+				m.OpenAPIV3Schema = &JSONSchemaProps{}
 			}
-			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenAPIV3Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6319,7 +8176,7 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
+func (m *EmbeddedResourceKind) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6342,17 +8199,17 @@ func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmbeddedResourceKind: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmbeddedResourceKind: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenAPIV3Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6362,27 +8219,55 @@ func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpenAPIV3Schema == nil {
-				m.OpenAPIV3Schema = &JSONSchemaProps{}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if err := m.OpenAPIV3Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6603,6 +8488,88 @@ func (m *JSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JSONSchemaPropertyNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONSchemaPropertyNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONSchemaPropertyNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			*m = append(*m, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7243,9 +9210,111 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOf = append(m.AllOf, JSONSchemaProps{})
+			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneOf = append(m.OneOf, JSONSchemaProps{})
+			if err := m.OneOf[len(m.OneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyOf = append(m.AnyOf, JSONSchemaProps{})
+			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Not", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7272,14 +9341,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllOf = append(m.AllOf, JSONSchemaProps{})
-			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Not == nil {
+				m.Not = &JSONSchemaProps{}
+			}
+			if err := m.Not.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OneOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7306,14 +9377,109 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OneOf = append(m.OneOf, JSONSchemaProps{})
-			if err := m.OneOf[len(m.OneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Properties == nil {
+				m.Properties = make(map[string]JSONSchemaProps)
+			}
+			var mapkey string
+			mapvalue := &JSONSchemaProps{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JSONSchemaProps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Properties[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 27:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalProperties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7340,14 +9506,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnyOf = append(m.AnyOf, JSONSchemaProps{})
-			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AdditionalProperties == nil {
+				m.AdditionalProperties = &JSONSchemaPropsOrBool{}
+			}
+			if err := m.AdditionalProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Not", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatternProperties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7374,16 +9542,109 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Not == nil {
-				m.Not = &JSONSchemaProps{}
+			if m.PatternProperties == nil {
+				m.PatternProperties = make(map[string]JSONSchemaProps)
 			}
-			if err := m.Not.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &JSONSchemaProps{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JSONSchemaProps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.PatternProperties[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 29:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7410,11 +9671,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Properties == nil {
-				m.Properties = make(map[string]JSONSchemaProps)
+			if m.Dependencies == nil {
+				m.Dependencies = make(JSONSchemaDependencies)
 			}
 			var mapkey string
-			mapvalue := &JSONSchemaProps{}
+			mapvalue := &JSONSchemaPropsOrStringArray{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7488,7 +9749,7 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &JSONSchemaProps{}
+					mapvalue = &JSONSchemaPropsOrStringArray{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7508,11 +9769,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Properties[mapkey] = *mapvalue
+			m.Dependencies[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 30:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7539,16 +9800,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalProperties == nil {
-				m.AdditionalProperties = &JSONSchemaPropsOrBool{}
+			if m.AdditionalItems == nil {
+				m.AdditionalItems = &JSONSchemaPropsOrBool{}
 			}
-			if err := m.AdditionalProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdditionalItems.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatternProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7575,8 +9836,8 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PatternProperties == nil {
-				m.PatternProperties = make(map[string]JSONSchemaProps)
+			if m.Definitions == nil {
+				m.Definitions = make(JSONSchemaDefinitions)
 			}
 			var mapkey string
 			mapvalue := &JSONSchemaProps{}
@@ -7673,11 +9934,296 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.PatternProperties[mapkey] = *mapvalue
+			m.Definitions[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalDocs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalDocs == nil {
+				m.ExternalDocs = &ExternalDocumentation{}
+			}
+			if err := m.ExternalDocs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Example", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Example == nil {
+				m.Example = &JSON{}
+			}
+			if err := m.Example.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nullable = bool(v != 0)
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XPreserveUnknownFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.XPreserveUnknownFields = &b
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XEmbeddedResource = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XIntOrString", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XIntOrString = bool(v != 0)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XListMapKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XListMapKeys = append(m.XListMapKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XListType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XListType = &s
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XMapType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XMapType = &s
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XValidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XValidations = append(m.XValidations, ValidationRule{})
+			if err := m.XValidations[len(m.XValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 32:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Const", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7704,109 +10250,52 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dependencies == nil {
-				m.Dependencies = make(JSONSchemaDependencies)
+			if m.Const == nil {
+				m.Const = &JSON{}
 			}
-			var mapkey string
-			mapvalue := &JSONSchemaPropsOrStringArray{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Const.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &JSONSchemaPropsOrStringArray{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Dependencies[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.If == nil {
+				m.If = &JSONSchemaProps{}
+			}
+			if err := m.If.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Then", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7833,16 +10322,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalItems == nil {
-				m.AdditionalItems = &JSONSchemaPropsOrBool{}
+			if m.Then == nil {
+				m.Then = &JSONSchemaProps{}
 			}
-			if err := m.AdditionalItems.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Then.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Else", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7869,11 +10358,47 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Definitions == nil {
-				m.Definitions = make(JSONSchemaDefinitions)
+			if m.Else == nil {
+				m.Else = &JSONSchemaProps{}
+			}
+			if err := m.Else.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentRequired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DependentRequired == nil {
+				m.DependentRequired = make(JSONSchemaDependentRequired)
 			}
 			var mapkey string
-			mapvalue := &JSONSchemaProps{}
+			mapvalue := &JSONSchemaPropertyNames{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7947,7 +10472,7 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &JSONSchemaProps{}
+					mapvalue = &JSONSchemaPropertyNames{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7967,11 +10492,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Definitions[mapkey] = *mapvalue
+			m.DependentRequired[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 35:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalDocs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyNames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7998,18 +10523,18 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalDocs == nil {
-				m.ExternalDocs = &ExternalDocumentation{}
+			if m.PropertyNames == nil {
+				m.PropertyNames = &JSONSchemaProps{}
 			}
-			if err := m.ExternalDocs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PropertyNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Example", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XValueType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8019,53 +10544,30 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Example == nil {
-				m.Example = &JSON{}
-			}
-			if err := m.Example.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Nullable = bool(v != 0)
-		case 38:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XPreserveUnknownFields", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XValueType = &s
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XNormalize", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8075,18 +10577,29 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.XPreserveUnknownFields = &b
-		case 39:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResource", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XNormalize = append(m.XNormalize, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XDeprecated", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8096,15 +10609,28 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.XEmbeddedResource = bool(v != 0)
-		case 40:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XDeprecated = &s
+			iNdEx = postIndex
+		case 54:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XIntOrString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XSensitive", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -8121,10 +10647,10 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.XIntOrString = bool(v != 0)
-		case 41:
+			m.XSensitive = bool(v != 0)
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XListMapKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XWritePermission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8152,11 +10678,12 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XListMapKeys = append(m.XListMapKeys, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.XWritePermission = &s
 			iNdEx = postIndex
-		case 42:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XListType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XConditions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8185,13 +10712,13 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.XListType = &s
+			m.XConditions = &s
 			iNdEx = postIndex
-		case 43:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XMapType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResourceKinds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8201,28 +10728,29 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.XMapType = &s
+			m.XEmbeddedResourceKinds = append(m.XEmbeddedResourceKinds, EmbeddedResourceKind{})
+			if err := m.XEmbeddedResourceKinds[len(m.XEmbeddedResourceKinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 44:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XValidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XReference", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8249,8 +10777,10 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XValidations = append(m.XValidations, ValidationRule{})
-			if err := m.XValidations[len(m.XValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.XReference == nil {
+				m.XReference = &ReferenceTarget{}
+			}
+			if err := m.XReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8619,6 +11149,184 @@ func (m *JSONSchemaPropsOrStringArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReferenceTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = ReferencePolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ServiceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -384,10 +384,150 @@ func (m *CustomResourceDefinitionVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CustomResourceDefinitionVersion proto.InternalMessageInfo
 
+func (m *CustomResourceGeneration) Reset()      { *m = CustomResourceGeneration{} }
+func (*CustomResourceGeneration) ProtoMessage() {}
+func (*CustomResourceGeneration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{12}
+}
+func (m *CustomResourceGeneration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceGeneration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceGeneration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceGeneration.Merge(m, src)
+}
+func (m *CustomResourceGeneration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceGeneration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceGeneration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceGeneration proto.InternalMessageInfo
+
+func (m *CustomResourceHistory) Reset()      { *m = CustomResourceHistory{} }
+func (*CustomResourceHistory) ProtoMessage() {}
+func (*CustomResourceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{13}
+}
+func (m *CustomResourceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceHistory.Merge(m, src)
+}
+func (m *CustomResourceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceHistory proto.InternalMessageInfo
+
+func (m *CustomResourceLimits) Reset()      { *m = CustomResourceLimits{} }
+func (*CustomResourceLimits) ProtoMessage() {}
+func (*CustomResourceLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{14}
+}
+func (m *CustomResourceLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceLimits.Merge(m, src)
+}
+func (m *CustomResourceLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceLimits proto.InternalMessageInfo
+
+func (m *CustomResourceRetention) Reset()      { *m = CustomResourceRetention{} }
+func (*CustomResourceRetention) ProtoMessage() {}
+func (*CustomResourceRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{15}
+}
+func (m *CustomResourceRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceRetention.Merge(m, src)
+}
+func (m *CustomResourceRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceRetention proto.InternalMessageInfo
+
+func (m *CustomResourceSubresourceCustom) Reset()      { *m = CustomResourceSubresourceCustom{} }
+func (*CustomResourceSubresourceCustom) ProtoMessage() {}
+func (*CustomResourceSubresourceCustom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{16}
+}
+func (m *CustomResourceSubresourceCustom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceSubresourceCustom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomResourceSubresourceCustom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceSubresourceCustom.Merge(m, src)
+}
+func (m *CustomResourceSubresourceCustom) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceSubresourceCustom) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceSubresourceCustom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceSubresourceCustom proto.InternalMessageInfo
+
 func (m *CustomResourceSubresourceScale) Reset()      { *m = CustomResourceSubresourceScale{} }
 func (*CustomResourceSubresourceScale) ProtoMessage() {}
 func (*CustomResourceSubresourceScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{12}
+	return fileDescriptor_98a4cc6918394e53, []int{17}
 }
 func (m *CustomResourceSubresourceScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +555,7 @@ var xxx_messageInfo_CustomResourceSubresourceScale proto.InternalMessageInfo
 func (m *CustomResourceSubresourceStatus) Reset()      { *m = CustomResourceSubresourceStatus{} }
 func (*CustomResourceSubresourceStatus) ProtoMessage() {}
 func (*CustomResourceSubresourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{13}
+	return fileDescriptor_98a4cc6918394e53, []int{18}
 }
 func (m *CustomResourceSubresourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +583,7 @@ var xxx_messageInfo_CustomResourceSubresourceStatus proto.InternalMessageInfo
 func (m *CustomResourceSubresources) Reset()      { *m = CustomResourceSubresources{} }
 func (*CustomResourceSubresources) ProtoMessage() {}
 func (*CustomResourceSubresources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{14}
+	return fileDescriptor_98a4cc6918394e53, []int{19}
 }
 func (m *CustomResourceSubresources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +611,7 @@ var xxx_messageInfo_CustomResourceSubresources proto.InternalMessageInfo
 func (m *CustomResourceValidation) Reset()      { *m = CustomResourceValidation{} }
 func (*CustomResourceValidation) ProtoMessage() {}
 func (*CustomResourceValidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{15}
+	return fileDescriptor_98a4cc6918394e53, []int{20}
 }
 func (m *CustomResourceValidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,10 +636,38 @@ func (m *CustomResourceValidation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CustomResourceValidation proto.InternalMessageInfo
 
+func (m *EmbeddedResourceKind) Reset()      { *m = EmbeddedResourceKind{} }
+func (*EmbeddedResourceKind) ProtoMessage() {}
+func (*EmbeddedResourceKind) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{21}
+}
+func (m *EmbeddedResourceKind) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmbeddedResourceKind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EmbeddedResourceKind) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmbeddedResourceKind.Merge(m, src)
+}
+func (m *EmbeddedResourceKind) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmbeddedResourceKind) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmbeddedResourceKind.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmbeddedResourceKind proto.InternalMessageInfo
+
 func (m *ExternalDocumentation) Reset()      { *m = ExternalDocumentation{} }
 func (*ExternalDocumentation) ProtoMessage() {}
 func (*ExternalDocumentation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{16}
+	return fileDescriptor_98a4cc6918394e53, []int{22}
 }
 func (m *ExternalDocumentation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +695,7 @@ var xxx_messageInfo_ExternalDocumentation proto.InternalMessageInfo
 func (m *JSON) Reset()      { *m = JSON{} }
 func (*JSON) ProtoMessage() {}
 func (*JSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{17}
+	return fileDescriptor_98a4cc6918394e53, []int{23}
 }
 func (m *JSON) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,10 +720,38 @@ func (m *JSON) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JSON proto.InternalMessageInfo
 
+func (m *JSONSchemaPropertyNames) Reset()      { *m = JSONSchemaPropertyNames{} }
+func (*JSONSchemaPropertyNames) ProtoMessage() {}
+func (*JSONSchemaPropertyNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{24}
+}
+func (m *JSONSchemaPropertyNames) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONSchemaPropertyNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JSONSchemaPropertyNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONSchemaPropertyNames.Merge(m, src)
+}
+func (m *JSONSchemaPropertyNames) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONSchemaPropertyNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONSchemaPropertyNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONSchemaPropertyNames proto.InternalMessageInfo
+
 func (m *JSONSchemaProps) Reset()      { *m = JSONSchemaProps{} }
 func (*JSONSchemaProps) ProtoMessage() {}
 func (*JSONSchemaProps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{18}
+	return fileDescriptor_98a4cc6918394e53, []int{25}
 }
 func (m *JSONSchemaProps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +779,7 @@ var xxx_messageInfo_JSONSchemaProps proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrArray) Reset()      { *m = JSONSchemaPropsOrArray{} }
 func (*JSONSchemaPropsOrArray) ProtoMessage() {}
 func (*JSONSchemaPropsOrArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{19}
+	return fileDescriptor_98a4cc6918394e53, []int{26}
 }
 func (m *JSONSchemaPropsOrArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +807,7 @@ var xxx_messageInfo_JSONSchemaPropsOrArray proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrBool) Reset()      { *m = JSONSchemaPropsOrBool{} }
 func (*JSONSchemaPropsOrBool) ProtoMessage() {}
 func (*JSONSchemaPropsOrBool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{20}
+	return fileDescriptor_98a4cc6918394e53, []int{27}
 }
 func (m *JSONSchemaPropsOrBool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +835,7 @@ var xxx_messageInfo_JSONSchemaPropsOrBool proto.InternalMessageInfo
 func (m *JSONSchemaPropsOrStringArray) Reset()      { *m = JSONSchemaPropsOrStringArray{} }
 func (*JSONSchemaPropsOrStringArray) ProtoMessage() {}
 func (*JSONSchemaPropsOrStringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{21}
+	return fileDescriptor_98a4cc6918394e53, []int{28}
 }
 func (m *JSONSchemaPropsOrStringArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,10 +860,38 @@ func (m *JSONSchemaPropsOrStringArray) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JSONSchemaPropsOrStringArray proto.InternalMessageInfo
 
+func (m *ReferenceTarget) Reset()      { *m = ReferenceTarget{} }
+func (*ReferenceTarget) ProtoMessage() {}
+func (*ReferenceTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a4cc6918394e53, []int{29}
+}
+func (m *ReferenceTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReferenceTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceTarget.Merge(m, src)
+}
+func (m *ReferenceTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceTarget proto.InternalMessageInfo
+
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{22}
+	return fileDescriptor_98a4cc6918394e53, []int{30}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +919,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *ValidationRule) Reset()      { *m = ValidationRule{} }
 func (*ValidationRule) ProtoMessage() {}
 func (*ValidationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{23}
+	return fileDescriptor_98a4cc6918394e53, []int{31}
 }
 func (m *ValidationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +947,7 @@ var xxx_messageInfo_ValidationRule proto.InternalMessageInfo
 func (m *WebhookClientConfig) Reset()      { *m = WebhookClientConfig{} }
 func (*WebhookClientConfig) ProtoMessage() {}
 func (*WebhookClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a4cc6918394e53, []int{24}
+	return fileDescriptor_98a4cc6918394e53, []int{32}
 }
 func (m *WebhookClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,20 +985,29 @@ func init() {
 	proto.RegisterType((*CustomResourceDefinitionSpec)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceDefinitionSpec")
 	proto.RegisterType((*CustomResourceDefinitionStatus)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceDefinitionStatus")
 	proto.RegisterType((*CustomResourceDefinitionVersion)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceDefinitionVersion")
+	proto.RegisterType((*CustomResourceGeneration)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceGeneration")
+	proto.RegisterType((*CustomResourceHistory)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceHistory")
+	proto.RegisterType((*CustomResourceLimits)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceLimits")
+	proto.RegisterType((*CustomResourceRetention)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceRetention")
+	proto.RegisterType((*CustomResourceSubresourceCustom)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceSubresourceCustom")
 	proto.RegisterType((*CustomResourceSubresourceScale)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceSubresourceScale")
 	proto.RegisterType((*CustomResourceSubresourceStatus)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceSubresourceStatus")
 	proto.RegisterType((*CustomResourceSubresources)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceSubresources")
 	proto.RegisterType((*CustomResourceValidation)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.CustomResourceValidation")
+	proto.RegisterType((*EmbeddedResourceKind)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.EmbeddedResourceKind")
 	proto.RegisterType((*ExternalDocumentation)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.ExternalDocumentation")
 	proto.RegisterType((*JSON)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSON")
+	proto.RegisterType((*JSONSchemaPropertyNames)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaPropertyNames")
 	proto.RegisterType((*JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps")
 	proto.RegisterMapType((JSONSchemaDefinitions)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps.DefinitionsEntry")
 	proto.RegisterMapType((JSONSchemaDependencies)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps.DependenciesEntry")
+	proto.RegisterMapType((JSONSchemaDependentRequired)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps.DependentRequiredEntry")
 	proto.RegisterMapType((map[string]JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps.PatternPropertiesEntry")
 	proto.RegisterMapType((map[string]JSONSchemaProps)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaProps.PropertiesEntry")
 	proto.RegisterType((*JSONSchemaPropsOrArray)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaPropsOrArray")
 	proto.RegisterType((*JSONSchemaPropsOrBool)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaPropsOrBool")
 	proto.RegisterType((*JSONSchemaPropsOrStringArray)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.JSONSchemaPropsOrStringArray")
+	proto.RegisterType((*ReferenceTarget)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.ReferenceTarget")
 	proto.RegisterType((*ServiceReference)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.ServiceReference")
 	proto.RegisterType((*ValidationRule)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.ValidationRule")
 	proto.RegisterType((*WebhookClientConfig)(nil), "k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1beta1.WebhookClientConfig")
@@ -785,200 +1018,245 @@ func init() {
 }
 
 var fileDescriptor_98a4cc6918394e53 = []byte{
-	// 3075 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x23, 0x47,
-	0x15, 0xdf, 0x91, 0x2d, 0x5b, 0x6e, 0xdb, 0x6b, 0xbb, 0x77, 0xed, 0xcc, 0x3a, 0x1b, 0xcb, 0x56,
-	0xc8, 0xe2, 0x24, 0xbb, 0x72, 0x62, 0x12, 0x12, 0x52, 0x50, 0x94, 0x65, 0x7b, 0x83, 0x93, 0xf5,
-	0x07, 0xad, 0xdd, 0xc4, 0x90, 0xcf, 0xb6, 0xa6, 0x2d, 0x4f, 0x3c, 0x9a, 0x99, 0x9d, 0x9e, 0x91,
-	0xed, 0x0a, 0x50, 0x7c, 0x54, 0x0a, 0x8a, 0x02, 0x42, 0x91, 0x5c, 0x28, 0xe0, 0x10, 0x28, 0x2e,
-	0x1c, 0xe0, 0x00, 0x37, 0xf8, 0x03, 0x72, 0x4c, 0x51, 0x1c, 0x72, 0xa0, 0x04, 0x2b, 0xae, 0x1c,
-	0xa9, 0xa2, 0xca, 0x27, 0xaa, 0x3f, 0xa6, 0xa7, 0x35, 0x92, 0x76, 0xb7, 0xb2, 0x52, 0x96, 0x9b,
-	0xf5, 0xbe, 0x7e, 0xaf, 0x5f, 0xbf, 0x7e, 0xfd, 0xfa, 0x8d, 0xc1, 0xfe, 0xe1, 0xb3, 0xb4, 0x68,
-	0x7b, 0x4b, 0x87, 0xd1, 0x1e, 0x09, 0x5c, 0x12, 0x12, 0xba, 0x54, 0x27, 0xae, 0xe5, 0x05, 0x4b,
-	0x92, 0x81, 0x7d, 0x9b, 0x1c, 0x87, 0xc4, 0xa5, 0xb6, 0xe7, 0xd2, 0x2b, 0xd8, 0xb7, 0x29, 0x09,
-	0xea, 0x24, 0x58, 0xf2, 0x0f, 0xab, 0x8c, 0x47, 0x5b, 0x05, 0x96, 0xea, 0x4f, 0xee, 0x91, 0x10,
-	0x3f, 0xb9, 0x54, 0x25, 0x2e, 0x09, 0x70, 0x48, 0xac, 0xa2, 0x1f, 0x78, 0xa1, 0x07, 0xbf, 0x24,
-	0xcc, 0x15, 0x5b, 0xa4, 0xdf, 0x50, 0xe6, 0x8a, 0xfe, 0x61, 0x95, 0xf1, 0x68, 0xab, 0x40, 0x51,
-	0x9a, 0x9b, 0xbd, 0x52, 0xb5, 0xc3, 0x83, 0x68, 0xaf, 0x58, 0xf1, 0x6a, 0x4b, 0x55, 0xaf, 0xea,
-	0x2d, 0x71, 0xab, 0x7b, 0xd1, 0x3e, 0xff, 0xc5, 0x7f, 0xf0, 0xbf, 0x04, 0xda, 0xec, 0x53, 0x89,
-	0xf3, 0x35, 0x5c, 0x39, 0xb0, 0x5d, 0x12, 0x9c, 0x24, 0x1e, 0xd7, 0x48, 0x88, 0x97, 0xea, 0x6d,
-	0x3e, 0xce, 0x2e, 0x75, 0xd3, 0x0a, 0x22, 0x37, 0xb4, 0x6b, 0xa4, 0x4d, 0xe1, 0xf3, 0x77, 0x52,
-	0xa0, 0x95, 0x03, 0x52, 0xc3, 0x69, 0xbd, 0xc2, 0xa9, 0x01, 0xa6, 0x56, 0x3d, 0xb7, 0x4e, 0x02,
-	0xb6, 0x4a, 0x44, 0x6e, 0x46, 0x84, 0x86, 0xb0, 0x04, 0x06, 0x22, 0xdb, 0x32, 0x8d, 0x79, 0x63,
-	0x71, 0xa4, 0xf4, 0xc4, 0x87, 0x8d, 0xfc, 0x99, 0x66, 0x23, 0x3f, 0x70, 0x63, 0x63, 0xed, 0xb4,
-	0x91, 0x5f, 0xe8, 0x86, 0x14, 0x9e, 0xf8, 0x84, 0x16, 0x6f, 0x6c, 0xac, 0x21, 0xa6, 0x0c, 0x9f,
-	0x07, 0x53, 0x16, 0xa1, 0x76, 0x40, 0xac, 0x95, 0x9d, 0x8d, 0x97, 0x84, 0x7d, 0x33, 0xc3, 0x2d,
-	0x5e, 0x90, 0x16, 0xa7, 0xd6, 0xd2, 0x02, 0xa8, 0x5d, 0x07, 0xee, 0x82, 0x61, 0x6f, 0xef, 0x2d,
-	0x52, 0x09, 0xa9, 0x39, 0x30, 0x3f, 0xb0, 0x38, 0xba, 0x7c, 0xa5, 0x98, 0xec, 0xa0, 0x72, 0x81,
-	0x6f, 0x9b, 0x5c, 0x6c, 0x11, 0xe1, 0xa3, 0xf5, 0x78, 0xe7, 0x4a, 0x13, 0x12, 0x6d, 0x78, 0x5b,
-	0x58, 0x41, 0xb1, 0xb9, 0xc2, 0x6f, 0x32, 0x00, 0xea, 0x8b, 0xa7, 0xbe, 0xe7, 0x52, 0xd2, 0x93,
-	0xd5, 0x53, 0x30, 0x59, 0xe1, 0x96, 0x43, 0x62, 0x49, 0x5c, 0x33, 0xf3, 0x49, 0xbc, 0x37, 0x25,
-	0xfe, 0xe4, 0x6a, 0xca, 0x1c, 0x6a, 0x03, 0x80, 0xd7, 0xc1, 0x50, 0x40, 0x68, 0xe4, 0x84, 0xe6,
-	0xc0, 0xbc, 0xb1, 0x38, 0xba, 0x7c, 0xb9, 0x2b, 0x14, 0xcf, 0x6f, 0x96, 0x7c, 0xc5, 0xfa, 0x93,
-	0xc5, 0x72, 0x88, 0xc3, 0x88, 0x96, 0xce, 0x4a, 0xa4, 0x21, 0xc4, 0x6d, 0x20, 0x69, 0xab, 0xf0,
-	0x83, 0x0c, 0x98, 0xd4, 0xa3, 0x54, 0xb7, 0xc9, 0x11, 0x3c, 0x02, 0xc3, 0x81, 0x48, 0x16, 0x1e,
-	0xa7, 0xd1, 0xe5, 0x9d, 0xe2, 0x3d, 0x1d, 0xab, 0x62, 0x5b, 0x12, 0x96, 0x46, 0xd9, 0x9e, 0xc9,
-	0x1f, 0x28, 0x46, 0x83, 0x6f, 0x83, 0x5c, 0x20, 0x37, 0x8a, 0x67, 0xd3, 0xe8, 0xf2, 0x57, 0x7b,
-	0x88, 0x2c, 0x0c, 0x97, 0xc6, 0x9a, 0x8d, 0x7c, 0x2e, 0xfe, 0x85, 0x14, 0x60, 0xe1, 0xbd, 0x0c,
-	0x98, 0x5b, 0x8d, 0x68, 0xe8, 0xd5, 0x10, 0xa1, 0x5e, 0x14, 0x54, 0xc8, 0xaa, 0xe7, 0x44, 0x35,
-	0x77, 0x8d, 0xec, 0xdb, 0xae, 0x1d, 0xb2, 0x6c, 0x9d, 0x07, 0x83, 0x2e, 0xae, 0x11, 0x99, 0x3d,
-	0x63, 0x32, 0xa6, 0x83, 0x5b, 0xb8, 0x46, 0x10, 0xe7, 0x30, 0x09, 0x96, 0x2c, 0xf2, 0x2c, 0x28,
-	0x89, 0xeb, 0x27, 0x3e, 0x41, 0x9c, 0x03, 0x2f, 0x81, 0xa1, 0x7d, 0x2f, 0xa8, 0x61, 0xb1, 0x8f,
-	0x23, 0xc9, 0xce, 0x5c, 0xe5, 0x54, 0x24, 0xb9, 0xf0, 0x69, 0x30, 0x6a, 0x11, 0x5a, 0x09, 0x6c,
-	0x9f, 0x41, 0x9b, 0x83, 0x5c, 0xf8, 0x9c, 0x14, 0x1e, 0x5d, 0x4b, 0x58, 0x48, 0x97, 0x83, 0x97,
-	0x41, 0xce, 0x0f, 0x6c, 0x2f, 0xb0, 0xc3, 0x13, 0x33, 0x3b, 0x6f, 0x2c, 0x66, 0x4b, 0x93, 0x52,
-	0x27, 0xb7, 0x23, 0xe9, 0x48, 0x49, 0xc0, 0x79, 0x90, 0x7b, 0xa1, 0xbc, 0xbd, 0xb5, 0x83, 0xc3,
-	0x03, 0x73, 0x88, 0x23, 0x0c, 0x32, 0x69, 0xa4, 0xa8, 0x85, 0xbf, 0x67, 0x80, 0x99, 0x8e, 0x4a,
-	0x1c, 0x52, 0x78, 0x15, 0xe4, 0x68, 0xc8, 0x2a, 0x4e, 0xf5, 0x44, 0xc6, 0xe4, 0xb1, 0x18, 0xac,
-	0x2c, 0xe9, 0xa7, 0x8d, 0xfc, 0x4c, 0xa2, 0x11, 0x53, 0x79, 0x3c, 0x94, 0x2e, 0xfc, 0x95, 0x01,
-	0xce, 0x1d, 0x91, 0xbd, 0x03, 0xcf, 0x3b, 0x5c, 0x75, 0x6c, 0xe2, 0x86, 0xab, 0x9e, 0xbb, 0x6f,
-	0x57, 0x65, 0x0e, 0xa0, 0x7b, 0xcc, 0x81, 0x97, 0xdb, 0x2d, 0x97, 0x1e, 0x68, 0x36, 0xf2, 0xe7,
-	0x3a, 0x30, 0x50, 0x27, 0x3f, 0xe0, 0x2e, 0x30, 0x2b, 0xa9, 0x43, 0x22, 0x0b, 0x98, 0x28, 0x5b,
-	0x23, 0xa5, 0x8b, 0xcd, 0x46, 0xde, 0x5c, 0xed, 0x22, 0x83, 0xba, 0x6a, 0x17, 0xbe, 0x37, 0x90,
-	0x0e, 0xaf, 0x96, 0x6e, 0x6f, 0x82, 0x1c, 0x3b, 0xc6, 0x16, 0x0e, 0xb1, 0x3c, 0x88, 0x4f, 0xdc,
-	0xdd, 0xa1, 0x17, 0x35, 0x63, 0x93, 0x84, 0xb8, 0x04, 0xe5, 0x86, 0x80, 0x84, 0x86, 0x94, 0x55,
-	0xf8, 0x4d, 0x30, 0x48, 0x7d, 0x52, 0x91, 0x81, 0x7e, 0xe5, 0x5e, 0x0f, 0x5b, 0x97, 0x85, 0x94,
-	0x7d, 0x52, 0x49, 0xce, 0x02, 0xfb, 0x85, 0x38, 0x2c, 0x7c, 0xc7, 0x00, 0x43, 0x94, 0x17, 0x28,
-	0x59, 0xd4, 0x5e, 0xeb, 0x97, 0x07, 0xa9, 0x2a, 0x28, 0x7e, 0x23, 0x09, 0x5e, 0xf8, 0x4f, 0x06,
-	0x2c, 0x74, 0x53, 0x5d, 0xf5, 0x5c, 0x4b, 0x6c, 0xc7, 0x86, 0x3c, 0xdb, 0x22, 0xd3, 0x9f, 0xd6,
-	0xcf, 0xf6, 0x69, 0x23, 0xff, 0xc8, 0x1d, 0x0d, 0x68, 0x45, 0xe0, 0x0b, 0x6a, 0xdd, 0xa2, 0x50,
-	0x2c, 0xb4, 0x3a, 0x76, 0xda, 0xc8, 0x4f, 0x28, 0xb5, 0x56, 0x5f, 0x61, 0x1d, 0x40, 0x07, 0xd3,
-	0xf0, 0x7a, 0x80, 0x5d, 0x2a, 0xcc, 0xda, 0x35, 0x22, 0xc3, 0xf7, 0xd8, 0xdd, 0xa5, 0x07, 0xd3,
-	0x28, 0xcd, 0x4a, 0x48, 0x78, 0xad, 0xcd, 0x1a, 0xea, 0x80, 0xc0, 0xea, 0x56, 0x40, 0x30, 0x55,
-	0xa5, 0x48, 0xbb, 0x51, 0x18, 0x15, 0x49, 0x2e, 0x7c, 0x14, 0x0c, 0xd7, 0x08, 0xa5, 0xb8, 0x4a,
-	0x78, 0xfd, 0x19, 0x49, 0xae, 0xe8, 0x4d, 0x41, 0x46, 0x31, 0x9f, 0xf5, 0x27, 0x17, 0xbb, 0x45,
-	0xed, 0x9a, 0x4d, 0x43, 0xf8, 0x6a, 0xdb, 0x01, 0x28, 0xde, 0xdd, 0x0a, 0x99, 0x36, 0x4f, 0x7f,
-	0x55, 0xfc, 0x62, 0x8a, 0x96, 0xfc, 0xdf, 0x00, 0x59, 0x3b, 0x24, 0xb5, 0xf8, 0xee, 0x7e, 0xb9,
-	0x4f, 0xb9, 0x57, 0x1a, 0x97, 0x3e, 0x64, 0x37, 0x18, 0x1a, 0x12, 0xa0, 0x85, 0xdf, 0x66, 0xc0,
-	0x43, 0xdd, 0x54, 0xd8, 0x85, 0x42, 0x59, 0xc4, 0x7d, 0x27, 0x0a, 0xb0, 0x23, 0x33, 0x4e, 0x45,
-	0x7c, 0x87, 0x53, 0x91, 0xe4, 0xb2, 0x92, 0x4f, 0x6d, 0xb7, 0x1a, 0x39, 0x38, 0x90, 0xe9, 0xa4,
-	0x56, 0x5d, 0x96, 0x74, 0xa4, 0x24, 0x60, 0x11, 0x00, 0x7a, 0xe0, 0x05, 0x21, 0xc7, 0x90, 0xd5,
-	0xeb, 0x2c, 0x2b, 0x10, 0x65, 0x45, 0x45, 0x9a, 0x04, 0xbb, 0xd1, 0x0e, 0x6d, 0xd7, 0x92, 0xbb,
-	0xae, 0x4e, 0xf1, 0x8b, 0xb6, 0x6b, 0x21, 0xce, 0x61, 0xf8, 0x8e, 0x4d, 0x43, 0x46, 0x91, 0x5b,
-	0xde, 0x12, 0x75, 0x2e, 0xa9, 0x24, 0x18, 0x7e, 0x85, 0x55, 0x7d, 0x2f, 0xb0, 0x09, 0x35, 0x87,
-	0x12, 0xfc, 0x55, 0x45, 0x45, 0x9a, 0x44, 0xe1, 0xdf, 0xb9, 0xee, 0x49, 0xc2, 0x4a, 0x09, 0x7c,
-	0x18, 0x64, 0xab, 0x81, 0x17, 0xf9, 0x32, 0x4a, 0x2a, 0xda, 0xcf, 0x33, 0x22, 0x12, 0x3c, 0x96,
-	0x95, 0xf5, 0x96, 0x36, 0x55, 0x65, 0x65, 0xdc, 0x9c, 0xc6, 0x7c, 0xf8, 0x1d, 0x03, 0x64, 0x5d,
-	0x19, 0x1c, 0x96, 0x72, 0xaf, 0xf6, 0x29, 0x2f, 0x78, 0x78, 0x13, 0x77, 0x45, 0xe4, 0x05, 0x32,
-	0x7c, 0x0a, 0x64, 0x69, 0xc5, 0xf3, 0x89, 0x8c, 0xfa, 0x5c, 0x2c, 0x54, 0x66, 0xc4, 0xd3, 0x46,
-	0x7e, 0x3c, 0x36, 0xc7, 0x09, 0x48, 0x08, 0xc3, 0xef, 0x1b, 0x00, 0xd4, 0xb1, 0x63, 0x5b, 0x98,
-	0xb7, 0x0c, 0x59, 0xee, 0x7e, 0x6f, 0xd3, 0xfa, 0x25, 0x65, 0x5e, 0x6c, 0x5a, 0xf2, 0x1b, 0x69,
-	0xd0, 0xf0, 0x5d, 0x03, 0x8c, 0xd1, 0x68, 0x2f, 0x90, 0x5a, 0x94, 0x37, 0x17, 0xa3, 0xcb, 0x5f,
-	0xeb, 0xa9, 0x2f, 0x65, 0x0d, 0xa0, 0x34, 0xd9, 0x6c, 0xe4, 0xc7, 0x74, 0x0a, 0x6a, 0x71, 0x00,
-	0xfe, 0xc8, 0x00, 0xb9, 0x7a, 0x7c, 0x67, 0x0f, 0xf3, 0x03, 0xff, 0x7a, 0x9f, 0x36, 0x56, 0x66,
-	0x54, 0x72, 0x0a, 0x54, 0x1f, 0xa0, 0x3c, 0x80, 0x7f, 0x36, 0x80, 0x89, 0x2d, 0x51, 0xe0, 0xb1,
-	0xb3, 0x13, 0xd8, 0x6e, 0x48, 0x02, 0xd1, 0x6f, 0x52, 0x33, 0xc7, 0xdd, 0xeb, 0xed, 0x5d, 0x98,
-	0xee, 0x65, 0x4b, 0xf3, 0xd2, 0x3b, 0x73, 0xa5, 0x8b, 0x1b, 0xa8, 0xab, 0x83, 0x3c, 0xd1, 0x92,
-	0x96, 0xc6, 0x1c, 0xe9, 0x43, 0xa2, 0x25, 0xbd, 0x94, 0xac, 0x0e, 0x49, 0x07, 0xa5, 0x41, 0xc3,
-	0x6d, 0x30, 0xed, 0x07, 0x84, 0x03, 0xdc, 0x70, 0x0f, 0x5d, 0xef, 0xc8, 0xbd, 0x6a, 0x13, 0xc7,
-	0xa2, 0x26, 0x98, 0x37, 0x16, 0x73, 0xa5, 0x0b, 0xcd, 0x46, 0x7e, 0x7a, 0xa7, 0x93, 0x00, 0xea,
-	0xac, 0x57, 0x78, 0x77, 0x20, 0xfd, 0x0a, 0x48, 0x77, 0x11, 0xf0, 0x7d, 0xb1, 0x7a, 0x11, 0x1b,
-	0x6a, 0x1a, 0x7c, 0xb7, 0xde, 0xec, 0x53, 0x32, 0xa9, 0x36, 0x20, 0xe9, 0xe4, 0x14, 0x89, 0x22,
-	0xcd, 0x0f, 0xf8, 0x73, 0x03, 0x8c, 0xe3, 0x4a, 0x85, 0xf8, 0x21, 0xb1, 0x44, 0x71, 0xcf, 0x7c,
-	0x0a, 0xf5, 0x6b, 0x5a, 0x7a, 0x35, 0xbe, 0xa2, 0x43, 0xa3, 0x56, 0x4f, 0xe0, 0x73, 0xe0, 0x2c,
-	0x0d, 0xbd, 0x80, 0x58, 0xa9, 0xb6, 0x19, 0x36, 0x1b, 0xf9, 0xb3, 0xe5, 0x16, 0x0e, 0x4a, 0x49,
-	0x16, 0xfe, 0x91, 0x05, 0xf9, 0x3b, 0x1c, 0xb5, 0xbb, 0x78, 0x98, 0x5d, 0x02, 0x43, 0x7c, 0xb9,
-	0x16, 0x8f, 0x4a, 0x4e, 0x6b, 0x05, 0x39, 0x15, 0x49, 0x2e, 0xbb, 0x28, 0x18, 0x3e, 0x6b, 0x5f,
-	0x06, 0xb8, 0xa0, 0xba, 0x28, 0xca, 0x82, 0x8c, 0x62, 0x3e, 0x5c, 0x06, 0xc0, 0x22, 0x7e, 0x40,
-	0xd8, 0x65, 0x65, 0x99, 0xc3, 0x5c, 0x5a, 0x6d, 0xd2, 0x9a, 0xe2, 0x20, 0x4d, 0x0a, 0x5e, 0x05,
-	0x30, 0xfe, 0x65, 0x7b, 0xee, 0xcb, 0x38, 0x70, 0x6d, 0xb7, 0x6a, 0xe6, 0xb8, 0xdb, 0x33, 0xac,
-	0x1b, 0x5b, 0x6b, 0xe3, 0xa2, 0x0e, 0x1a, 0xf0, 0x6d, 0x30, 0x24, 0x86, 0x3e, 0xfc, 0x86, 0xe8,
-	0x63, 0x95, 0x07, 0x3c, 0x46, 0x1c, 0x0a, 0x49, 0xc8, 0xf6, 0xea, 0x9e, 0xbd, 0xdf, 0xd5, 0xfd,
-	0xb6, 0xe5, 0x74, 0xe8, 0xff, 0xbc, 0x9c, 0x16, 0xfe, 0x6b, 0xa4, 0x6b, 0x8e, 0xb6, 0xd4, 0x72,
-	0x05, 0x3b, 0x04, 0xae, 0x81, 0x49, 0xf6, 0x62, 0x42, 0xc4, 0x77, 0xec, 0x0a, 0xa6, 0xfc, 0xc1,
-	0x2e, 0x92, 0x5d, 0xcd, 0x90, 0xca, 0x29, 0x3e, 0x6a, 0xd3, 0x80, 0x2f, 0x00, 0x28, 0x5e, 0x11,
-	0x2d, 0x76, 0x44, 0x43, 0xa4, 0xde, 0x03, 0xe5, 0x36, 0x09, 0xd4, 0x41, 0x0b, 0xae, 0x82, 0x29,
-	0x07, 0xef, 0x11, 0xa7, 0x4c, 0x1c, 0x52, 0x09, 0xbd, 0x80, 0x9b, 0x12, 0x23, 0x8d, 0xe9, 0x66,
-	0x23, 0x3f, 0x75, 0x2d, 0xcd, 0x44, 0xed, 0xf2, 0x85, 0x85, 0xf4, 0xd1, 0xd6, 0x17, 0x2e, 0xde,
-	0x66, 0x1f, 0x64, 0xc0, 0x6c, 0xf7, 0xcc, 0x80, 0xdf, 0x4d, 0x9e, 0x90, 0xe2, 0x85, 0xf0, 0x7a,
-	0xbf, 0xb2, 0x50, 0xbe, 0x21, 0x41, 0xfb, 0xfb, 0x11, 0x7e, 0x8b, 0xb5, 0x6b, 0xd8, 0x89, 0x87,
-	0x56, 0xaf, 0xf5, 0xcd, 0x05, 0x06, 0x52, 0x1a, 0x11, 0x9d, 0x20, 0x76, 0x78, 0xe3, 0x87, 0x1d,
-	0x52, 0xf8, 0x9d, 0x91, 0x9e, 0x22, 0x24, 0x27, 0x18, 0xfe, 0xd8, 0x00, 0x13, 0x9e, 0x4f, 0xdc,
-	0x95, 0x9d, 0x8d, 0x97, 0x3e, 0x27, 0x4e, 0xb2, 0x0c, 0xd5, 0xd6, 0x3d, 0xfa, 0xf9, 0x42, 0x79,
-	0x7b, 0x4b, 0x18, 0xdc, 0x09, 0x3c, 0x9f, 0x96, 0xce, 0x35, 0x1b, 0xf9, 0x89, 0xed, 0x56, 0x28,
-	0x94, 0xc6, 0x2e, 0xd4, 0xc0, 0xf4, 0xfa, 0x71, 0x48, 0x02, 0x17, 0x3b, 0x6b, 0x5e, 0x25, 0xaa,
-	0x11, 0x37, 0x14, 0x8e, 0xa6, 0x26, 0x5e, 0xc6, 0x5d, 0x4e, 0xbc, 0x1e, 0x02, 0x03, 0x51, 0xe0,
-	0xc8, 0x2c, 0x1e, 0x55, 0x13, 0x5d, 0x74, 0x0d, 0x31, 0x7a, 0x61, 0x01, 0x0c, 0x32, 0x3f, 0xe1,
-	0x05, 0x30, 0x10, 0xe0, 0x23, 0x6e, 0x75, 0xac, 0x34, 0xcc, 0x44, 0x10, 0x3e, 0x42, 0x8c, 0x56,
-	0xf8, 0xdb, 0x02, 0x98, 0x48, 0xad, 0x05, 0xce, 0x82, 0x8c, 0x1a, 0x13, 0x03, 0x69, 0x34, 0xb3,
-	0xb1, 0x86, 0x32, 0xb6, 0x05, 0x9f, 0x51, 0xc5, 0x57, 0x80, 0xe6, 0xd5, 0x5d, 0xc2, 0xa9, 0xac,
-	0x3f, 0x4f, 0xcc, 0x31, 0x47, 0xe2, 0xc2, 0xc9, 0x7c, 0x20, 0xfb, 0xf2, 0x94, 0x08, 0x1f, 0xc8,
-	0x3e, 0x62, 0xb4, 0x4f, 0x3a, 0xee, 0x8b, 0xe7, 0x8d, 0xd9, 0xbb, 0x98, 0x37, 0x0e, 0xdd, 0x76,
-	0xde, 0xf8, 0x30, 0xc8, 0x86, 0x76, 0xe8, 0x10, 0x7e, 0x91, 0x69, 0xcf, 0xa8, 0xeb, 0x8c, 0x88,
-	0x04, 0x0f, 0xbe, 0x05, 0x86, 0x2d, 0xb2, 0x8f, 0x23, 0x27, 0xe4, 0x77, 0xd6, 0xe8, 0xf2, 0x6a,
-	0x0f, 0x52, 0x48, 0x0c, 0x83, 0xd7, 0x84, 0x5d, 0x14, 0x03, 0xc0, 0x47, 0xc0, 0x70, 0x0d, 0x1f,
-	0xdb, 0xb5, 0xa8, 0xc6, 0x1b, 0x4c, 0x43, 0x88, 0x6d, 0x0a, 0x12, 0x8a, 0x79, 0xac, 0x32, 0x92,
-	0xe3, 0x8a, 0x13, 0x51, 0xbb, 0x4e, 0x24, 0x53, 0x36, 0x7f, 0xaa, 0x32, 0xae, 0xa7, 0xf8, 0xa8,
-	0x4d, 0x83, 0x83, 0xd9, 0x2e, 0x57, 0x1e, 0xd5, 0xc0, 0x04, 0x09, 0xc5, 0xbc, 0x56, 0x30, 0x29,
-	0x3f, 0xd6, 0x0d, 0x4c, 0x2a, 0xb7, 0x69, 0xc0, 0xc7, 0xc1, 0x48, 0x0d, 0x1f, 0x5f, 0x23, 0x6e,
-	0x35, 0x3c, 0x30, 0xc7, 0xe7, 0x8d, 0xc5, 0x81, 0xd2, 0x78, 0xb3, 0x91, 0x1f, 0xd9, 0x8c, 0x89,
-	0x28, 0xe1, 0x73, 0x61, 0xdb, 0x95, 0xc2, 0x67, 0x35, 0xe1, 0x98, 0x88, 0x12, 0x3e, 0xeb, 0x5e,
-	0x7c, 0x1c, 0xb2, 0xc3, 0x65, 0x4e, 0xb4, 0x3e, 0x73, 0x77, 0x04, 0x19, 0xc5, 0x7c, 0xb8, 0x08,
-	0x72, 0x35, 0x7c, 0xcc, 0x47, 0x12, 0xe6, 0x24, 0x37, 0xcb, 0x07, 0xe3, 0x9b, 0x92, 0x86, 0x14,
-	0x97, 0x4b, 0xda, 0xae, 0x90, 0x9c, 0xd2, 0x24, 0x25, 0x0d, 0x29, 0x2e, 0x4b, 0xe2, 0xc8, 0xb5,
-	0x6f, 0x46, 0x44, 0x08, 0x43, 0x1e, 0x19, 0x95, 0xc4, 0x37, 0x12, 0x16, 0xd2, 0xe5, 0x60, 0x11,
-	0x80, 0x5a, 0xe4, 0x84, 0xb6, 0xef, 0x90, 0xed, 0x7d, 0xf3, 0x1c, 0x8f, 0x3f, 0x6f, 0xfa, 0x37,
-	0x15, 0x15, 0x69, 0x12, 0x90, 0x80, 0x41, 0xe2, 0x46, 0x35, 0xf3, 0x3c, 0xbf, 0xd8, 0x7b, 0x92,
-	0x82, 0xea, 0xe4, 0xac, 0xbb, 0x51, 0x0d, 0x71, 0xf3, 0xf0, 0x19, 0x30, 0x5e, 0xc3, 0xc7, 0xac,
-	0x1c, 0x90, 0x20, 0xb4, 0x09, 0x35, 0xa7, 0xf9, 0xe2, 0xa7, 0x58, 0xb7, 0xbb, 0xa9, 0x33, 0x50,
-	0xab, 0x1c, 0x57, 0xb4, 0x5d, 0x4d, 0x71, 0x46, 0x53, 0xd4, 0x19, 0xa8, 0x55, 0x8e, 0x45, 0x3a,
-	0x20, 0x37, 0x23, 0x3b, 0x20, 0x96, 0xf9, 0x00, 0x6f, 0x90, 0xe5, 0xc7, 0x0a, 0x41, 0x43, 0x8a,
-	0x0b, 0xeb, 0xf1, 0xec, 0xca, 0xe4, 0xc7, 0xf0, 0x46, 0x6f, 0x2b, 0xf9, 0x76, 0xb0, 0x12, 0x04,
-	0xf8, 0x44, 0xdc, 0x34, 0xfa, 0xd4, 0x0a, 0x52, 0x90, 0xc5, 0x8e, 0xb3, 0xbd, 0x6f, 0x5e, 0xe0,
-	0xb1, 0xef, 0xf5, 0x0d, 0xa2, 0xaa, 0xce, 0x0a, 0x03, 0x41, 0x02, 0x8b, 0x81, 0x7a, 0x2e, 0x4b,
-	0x8d, 0xd9, 0xfe, 0x82, 0x6e, 0x33, 0x10, 0x24, 0xb0, 0xf8, 0x4a, 0xdd, 0x93, 0xed, 0x7d, 0xf3,
-	0xc1, 0x3e, 0xaf, 0x94, 0x81, 0x20, 0x81, 0x05, 0x6d, 0x30, 0xe0, 0x7a, 0xa1, 0x79, 0xb1, 0x2f,
-	0xd7, 0x33, 0xbf, 0x70, 0xb6, 0xbc, 0x10, 0x31, 0x0c, 0xf8, 0x33, 0x03, 0x00, 0x3f, 0x49, 0xd1,
-	0x87, 0x7a, 0x32, 0x12, 0x49, 0x41, 0x16, 0x93, 0xdc, 0x5e, 0x77, 0xc3, 0xe0, 0x24, 0x79, 0x1e,
-	0x69, 0x67, 0x40, 0xf3, 0x02, 0xfe, 0xda, 0x00, 0xe7, 0xf5, 0x36, 0x59, 0xb9, 0x37, 0xc7, 0x23,
-	0x72, 0xbd, 0xd7, 0x69, 0x5e, 0xf2, 0x3c, 0xa7, 0x64, 0x36, 0x1b, 0xf9, 0xf3, 0x2b, 0x1d, 0x50,
-	0x51, 0x47, 0x5f, 0xe0, 0xef, 0x0d, 0x30, 0x25, 0xab, 0xa8, 0xe6, 0x61, 0x9e, 0x07, 0x90, 0xf4,
-	0x3a, 0x80, 0x69, 0x1c, 0x11, 0x47, 0xf5, 0x91, 0xbd, 0x8d, 0x8f, 0xda, 0x5d, 0x83, 0x7f, 0x32,
-	0xc0, 0x98, 0x45, 0x7c, 0xe2, 0x5a, 0xc4, 0xad, 0x30, 0x5f, 0xe7, 0x7b, 0x32, 0xb2, 0x48, 0xfb,
-	0xba, 0xa6, 0x41, 0x08, 0x37, 0x8b, 0xd2, 0xcd, 0x31, 0x9d, 0x75, 0xda, 0xc8, 0xcf, 0x24, 0xaa,
-	0x3a, 0x07, 0xb5, 0x78, 0x09, 0xdf, 0x33, 0xc0, 0x44, 0xb2, 0x01, 0xe2, 0x4a, 0x59, 0xe8, 0x63,
-	0x1e, 0xf0, 0xf6, 0x75, 0xa5, 0x15, 0x10, 0xa5, 0x3d, 0x80, 0x7f, 0x30, 0x58, 0xa7, 0x16, 0xbf,
-	0xfb, 0xa8, 0x59, 0xe0, 0xb1, 0x7c, 0xa3, 0xe7, 0xb1, 0x54, 0x08, 0x22, 0x94, 0x97, 0x93, 0x56,
-	0x50, 0x71, 0x4e, 0x1b, 0xf9, 0x69, 0x3d, 0x92, 0x8a, 0x81, 0x74, 0x0f, 0xe1, 0x0f, 0x0d, 0x30,
-	0x46, 0x92, 0x8e, 0x9b, 0x9a, 0x0f, 0xf7, 0x24, 0x88, 0x1d, 0x9b, 0x78, 0xf1, 0x52, 0xd7, 0x58,
-	0x14, 0xb5, 0x60, 0xb3, 0x0e, 0x92, 0x1c, 0xe3, 0x9a, 0xef, 0x10, 0xf3, 0x33, 0x3d, 0xee, 0x20,
-	0xd7, 0x85, 0x5d, 0x14, 0x03, 0xc0, 0xcb, 0x20, 0xe7, 0x46, 0x8e, 0x83, 0xf7, 0x1c, 0x62, 0x3e,
-	0xc2, 0x7b, 0x11, 0x35, 0x92, 0xdd, 0x92, 0x74, 0xa4, 0x24, 0xe0, 0x3e, 0x98, 0x3f, 0x7e, 0x51,
-	0xfd, 0x7b, 0x52, 0xc7, 0xa1, 0xa1, 0x79, 0x89, 0x5b, 0x99, 0x6d, 0x36, 0xf2, 0x33, 0xbb, 0x9d,
-	0xc7, 0x8a, 0x77, 0xb4, 0x01, 0x5f, 0x01, 0x0f, 0x6a, 0x32, 0xeb, 0xb5, 0x3d, 0x62, 0x59, 0xc4,
-	0x8a, 0x1f, 0x6e, 0xe6, 0x67, 0xc5, 0xe0, 0x32, 0x3e, 0xe0, 0xbb, 0x69, 0x01, 0x74, 0x3b, 0x6d,
-	0x78, 0x0d, 0xcc, 0x68, 0xec, 0x0d, 0x37, 0xdc, 0x0e, 0xca, 0x61, 0x60, 0xbb, 0x55, 0x73, 0x91,
-	0xdb, 0x3d, 0x1f, 0x9f, 0xc8, 0x5d, 0x8d, 0x87, 0xba, 0xe8, 0xc0, 0xaf, 0xb4, 0x58, 0xe3, 0x9f,
-	0xd0, 0xb0, 0xff, 0x22, 0x39, 0xa1, 0xe6, 0xa3, 0xbc, 0x3b, 0xe1, 0x9b, 0xbd, 0xab, 0xd1, 0x51,
-	0x17, 0x79, 0xf8, 0x65, 0x70, 0x2e, 0xc5, 0x61, 0x4f, 0x14, 0xf3, 0x31, 0xf1, 0xd6, 0x60, 0xfd,
-	0xec, 0x6e, 0x4c, 0x44, 0x9d, 0x24, 0xe1, 0x17, 0x01, 0xd4, 0xc8, 0x9b, 0xd8, 0xe7, 0xfa, 0x8f,
-	0x8b, 0x67, 0x0f, 0xdb, 0xd1, 0x5d, 0x49, 0x43, 0x1d, 0xe4, 0xe0, 0x2f, 0x8c, 0x96, 0x95, 0x24,
-	0xaf, 0x63, 0x6a, 0x5e, 0xe6, 0xe7, 0x77, 0xf3, 0x1e, 0xb3, 0x50, 0xfb, 0x0e, 0x12, 0x39, 0x44,
-	0x0b, 0xb3, 0x06, 0x85, 0xba, 0xb8, 0x30, 0xcb, 0x5e, 0xe8, 0xa9, 0x0a, 0x0f, 0x27, 0xc1, 0xc0,
-	0x21, 0x91, 0xff, 0x55, 0x81, 0xd8, 0x9f, 0xd0, 0x02, 0xd9, 0x3a, 0x76, 0xa2, 0x78, 0xc8, 0xd0,
-	0xe3, 0xee, 0x00, 0x09, 0xe3, 0xcf, 0x65, 0x9e, 0x35, 0x66, 0xdf, 0x37, 0xc0, 0x4c, 0xe7, 0x8b,
-	0xe7, 0xbe, 0xba, 0xf5, 0x4b, 0x03, 0x4c, 0xb5, 0xdd, 0x31, 0x1d, 0x3c, 0xba, 0xd9, 0xea, 0xd1,
-	0x2b, 0xbd, 0xbe, 0x2c, 0xc4, 0xe1, 0xe0, 0x1d, 0xb2, 0xee, 0xde, 0x4f, 0x0c, 0x30, 0x99, 0x2e,
-	0xdb, 0xf7, 0x33, 0x5e, 0x85, 0xf7, 0x33, 0x60, 0xa6, 0x73, 0x63, 0x0f, 0x03, 0x35, 0xc1, 0xe8,
-	0xcf, 0x24, 0xa8, 0xd3, 0xd4, 0xf8, 0x1d, 0x03, 0x8c, 0xbe, 0xa5, 0xe4, 0xe2, 0xaf, 0xee, 0x3d,
-	0x9f, 0x41, 0xc5, 0xf7, 0x64, 0xc2, 0xa0, 0x48, 0xc7, 0x2d, 0xfc, 0xd1, 0x00, 0xd3, 0x1d, 0x1b,
-	0x00, 0x78, 0x09, 0x0c, 0x61, 0xc7, 0xf1, 0x8e, 0xc4, 0x28, 0x51, 0xfb, 0x46, 0xb0, 0xc2, 0xa9,
-	0x48, 0x72, 0xb5, 0xe8, 0x65, 0x3e, 0xad, 0xe8, 0x15, 0xfe, 0x62, 0x80, 0x8b, 0xb7, 0xcb, 0xc4,
-	0xfb, 0xb2, 0xa5, 0x8b, 0x20, 0x27, 0x9b, 0xf7, 0x13, 0xbe, 0x9d, 0xb2, 0x14, 0xcb, 0xa2, 0xc1,
-	0xff, 0xd1, 0x4c, 0xfc, 0x55, 0xf8, 0xc0, 0x00, 0x93, 0x65, 0x12, 0xd4, 0xed, 0x0a, 0x41, 0x64,
-	0x9f, 0x04, 0xc4, 0xad, 0x10, 0xb8, 0x04, 0x46, 0xf8, 0xe7, 0x6e, 0x1f, 0x57, 0xe2, 0x4f, 0x37,
-	0x53, 0x32, 0xe4, 0x23, 0x5b, 0x31, 0x03, 0x25, 0x32, 0xea, 0x33, 0x4f, 0xa6, 0xeb, 0x67, 0x9e,
-	0x8b, 0x60, 0xd0, 0x4f, 0x06, 0xd1, 0x39, 0xc6, 0xe5, 0xb3, 0x67, 0x4e, 0xe5, 0x5c, 0x2f, 0x08,
-	0xf9, 0x74, 0x2d, 0x2b, 0xb9, 0x5e, 0x10, 0x22, 0x4e, 0x2d, 0xbc, 0x06, 0xce, 0xb6, 0x96, 0x71,
-	0x86, 0x17, 0x44, 0x4e, 0xdb, 0x67, 0x25, 0xc6, 0x43, 0x9c, 0xa3, 0xff, 0xb7, 0x4b, 0xe6, 0x0e,
-	0xff, 0xed, 0xf2, 0x57, 0x03, 0x74, 0xfa, 0x8f, 0x33, 0x78, 0x41, 0xcc, 0x2f, 0xb5, 0xa1, 0x60,
-	0x3c, 0xbb, 0x84, 0x75, 0x30, 0x4c, 0x45, 0xd0, 0xe4, 0xa6, 0x6e, 0xdf, 0xe3, 0xa6, 0xa6, 0xb7,
-	0x40, 0x34, 0x4e, 0x31, 0x35, 0x06, 0x63, 0xfb, 0x5a, 0xc1, 0xa5, 0xc8, 0xb5, 0xe4, 0x48, 0x7b,
-	0x4c, 0xec, 0xeb, 0xea, 0x8a, 0xa0, 0x21, 0xc5, 0x2d, 0x5d, 0xf9, 0xf0, 0xd6, 0xdc, 0x99, 0x8f,
-	0x6e, 0xcd, 0x9d, 0xf9, 0xf8, 0xd6, 0xdc, 0x99, 0x6f, 0x37, 0xe7, 0x8c, 0x0f, 0x9b, 0x73, 0xc6,
-	0x47, 0xcd, 0x39, 0xe3, 0xe3, 0xe6, 0x9c, 0xf1, 0xcf, 0xe6, 0x9c, 0xf1, 0xd3, 0x7f, 0xcd, 0x9d,
-	0xf9, 0xfa, 0xb0, 0xc4, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0xd4, 0x36, 0xef, 0x07,
-	0x2e, 0x00, 0x00,
+	// 3807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x4d, 0x6c, 0x1c, 0x47,
+	0x76, 0x56, 0xcf, 0x70, 0xc8, 0x61, 0x91, 0x92, 0xc8, 0x92, 0x48, 0xb7, 0x68, 0x99, 0x33, 0x6a,
+	0xc7, 0x8e, 0xd6, 0x2b, 0x0d, 0x6d, 0xad, 0xbd, 0x96, 0x8d, 0x04, 0x01, 0x87, 0x94, 0xbc, 0x5a,
+	0x93, 0x22, 0xf7, 0x0d, 0x65, 0x33, 0xf1, 0x7a, 0x77, 0x9b, 0x33, 0x35, 0x64, 0x5b, 0x3d, 0xdd,
+	0xad, 0xae, 0x9e, 0x21, 0xb9, 0x4e, 0x82, 0xcd, 0x2e, 0x36, 0x09, 0x82, 0x24, 0x1b, 0x64, 0x7d,
+	0x48, 0x90, 0x04, 0xc8, 0x0f, 0x72, 0xd9, 0x43, 0x72, 0x48, 0x6e, 0x09, 0x90, 0xab, 0x2f, 0x01,
+	0x16, 0x01, 0x02, 0xec, 0x61, 0x31, 0x88, 0x27, 0x97, 0xe4, 0x1e, 0x20, 0x80, 0x4e, 0x41, 0xfd,
+	0x74, 0x75, 0x75, 0x4f, 0x8f, 0xa4, 0xac, 0x66, 0x2c, 0xec, 0x8d, 0xf3, 0xde, 0xab, 0xf7, 0xbd,
+	0x7a, 0xfd, 0xea, 0xd5, 0xab, 0x57, 0x45, 0xd4, 0xbe, 0x7f, 0x93, 0xd6, 0x1c, 0x7f, 0xed, 0x7e,
+	0xf7, 0x80, 0x84, 0x1e, 0x89, 0x08, 0x5d, 0xeb, 0x11, 0xaf, 0xe5, 0x87, 0x6b, 0x92, 0x61, 0x07,
+	0x0e, 0x39, 0x89, 0x88, 0x47, 0x1d, 0xdf, 0xa3, 0xd7, 0xed, 0xc0, 0xa1, 0x24, 0xec, 0x91, 0x70,
+	0x2d, 0xb8, 0x7f, 0xc8, 0x78, 0x34, 0x2d, 0xb0, 0xd6, 0x7b, 0xed, 0x80, 0x44, 0xf6, 0x6b, 0x6b,
+	0x87, 0xc4, 0x23, 0xa1, 0x1d, 0x91, 0x56, 0x2d, 0x08, 0xfd, 0xc8, 0xc7, 0xbf, 0x2c, 0xd4, 0xd5,
+	0x52, 0xd2, 0xdf, 0x54, 0xea, 0x6a, 0xc1, 0xfd, 0x43, 0xc6, 0xa3, 0x69, 0x81, 0x9a, 0x54, 0xb7,
+	0x72, 0xfd, 0xd0, 0x89, 0x8e, 0xba, 0x07, 0xb5, 0xa6, 0xdf, 0x59, 0x3b, 0xf4, 0x0f, 0xfd, 0x35,
+	0xae, 0xf5, 0xa0, 0xdb, 0xe6, 0xbf, 0xf8, 0x0f, 0xfe, 0x97, 0x40, 0x5b, 0x79, 0x3d, 0x31, 0xbe,
+	0x63, 0x37, 0x8f, 0x1c, 0x8f, 0x84, 0xa7, 0x89, 0xc5, 0x1d, 0x12, 0xd9, 0x6b, 0xbd, 0x21, 0x1b,
+	0x57, 0xd6, 0x46, 0x8d, 0x0a, 0xbb, 0x5e, 0xe4, 0x74, 0xc8, 0xd0, 0x80, 0x2f, 0x3f, 0x6e, 0x00,
+	0x6d, 0x1e, 0x91, 0x8e, 0x9d, 0x1d, 0x67, 0x3d, 0x34, 0xd0, 0xe2, 0x86, 0xef, 0xf5, 0x48, 0xc8,
+	0x66, 0x09, 0xe4, 0x41, 0x97, 0xd0, 0x08, 0xd7, 0x51, 0xb1, 0xeb, 0xb4, 0x4c, 0xa3, 0x6a, 0x5c,
+	0x9d, 0xad, 0xbf, 0xfa, 0x69, 0xbf, 0x72, 0x66, 0xd0, 0xaf, 0x14, 0xef, 0xdd, 0xd9, 0x7c, 0xd8,
+	0xaf, 0x5c, 0x19, 0x85, 0x14, 0x9d, 0x06, 0x84, 0xd6, 0xee, 0xdd, 0xd9, 0x04, 0x36, 0x18, 0xbf,
+	0x83, 0x16, 0x5b, 0x84, 0x3a, 0x21, 0x69, 0xad, 0xef, 0xde, 0x79, 0x4f, 0xe8, 0x37, 0x0b, 0x5c,
+	0xe3, 0x25, 0xa9, 0x71, 0x71, 0x33, 0x2b, 0x00, 0xc3, 0x63, 0xf0, 0x3e, 0x9a, 0xf1, 0x0f, 0x3e,
+	0x22, 0xcd, 0x88, 0x9a, 0xc5, 0x6a, 0xf1, 0xea, 0xdc, 0x8d, 0xeb, 0xb5, 0xe4, 0x0b, 0x2a, 0x13,
+	0xf8, 0x67, 0x93, 0x93, 0xad, 0x81, 0x7d, 0x7c, 0x2b, 0xfe, 0x72, 0xf5, 0xf3, 0x12, 0x6d, 0x66,
+	0x47, 0x68, 0x81, 0x58, 0x9d, 0xf5, 0x37, 0x05, 0x84, 0xf5, 0xc9, 0xd3, 0xc0, 0xf7, 0x28, 0x19,
+	0xcb, 0xec, 0x29, 0x5a, 0x68, 0x72, 0xcd, 0x11, 0x69, 0x49, 0x5c, 0xb3, 0xf0, 0xb3, 0x58, 0x6f,
+	0x4a, 0xfc, 0x85, 0x8d, 0x8c, 0x3a, 0x18, 0x02, 0xc0, 0x7b, 0x68, 0x3a, 0x24, 0xb4, 0xeb, 0x46,
+	0x66, 0xb1, 0x6a, 0x5c, 0x9d, 0xbb, 0x71, 0x6d, 0x24, 0x14, 0x8f, 0x6f, 0x16, 0x7c, 0xb5, 0xde,
+	0x6b, 0xb5, 0x46, 0x64, 0x47, 0x5d, 0x5a, 0x3f, 0x27, 0x91, 0xa6, 0x81, 0xeb, 0x00, 0xa9, 0xcb,
+	0xfa, 0xdd, 0x02, 0x5a, 0xd0, 0xbd, 0xd4, 0x73, 0xc8, 0x31, 0x3e, 0x46, 0x33, 0xa1, 0x08, 0x16,
+	0xee, 0xa7, 0xb9, 0x1b, 0xbb, 0xb5, 0xa7, 0x5a, 0x56, 0xb5, 0xa1, 0x20, 0xac, 0xcf, 0xb1, 0x6f,
+	0x26, 0x7f, 0x40, 0x8c, 0x86, 0x3f, 0x46, 0xe5, 0x50, 0x7e, 0x28, 0x1e, 0x4d, 0x73, 0x37, 0xbe,
+	0x36, 0x46, 0x64, 0xa1, 0xb8, 0x3e, 0x3f, 0xe8, 0x57, 0xca, 0xf1, 0x2f, 0x50, 0x80, 0xd6, 0x0f,
+	0x0b, 0x68, 0x75, 0xa3, 0x4b, 0x23, 0xbf, 0x03, 0x84, 0xfa, 0xdd, 0xb0, 0x49, 0x36, 0x7c, 0xb7,
+	0xdb, 0xf1, 0x36, 0x49, 0xdb, 0xf1, 0x9c, 0x88, 0x45, 0x6b, 0x15, 0x4d, 0x79, 0x76, 0x87, 0xc8,
+	0xe8, 0x99, 0x97, 0x3e, 0x9d, 0xba, 0x6b, 0x77, 0x08, 0x70, 0x0e, 0x93, 0x60, 0xc1, 0x62, 0x16,
+	0xd2, 0x12, 0x7b, 0xa7, 0x01, 0x01, 0xce, 0xc1, 0x2f, 0xa3, 0xe9, 0xb6, 0x1f, 0x76, 0x6c, 0xf1,
+	0x1d, 0x67, 0x93, 0x2f, 0x73, 0x9b, 0x53, 0x41, 0x72, 0xf1, 0x1b, 0x68, 0xae, 0x45, 0x68, 0x33,
+	0x74, 0x02, 0x06, 0x6d, 0x4e, 0x71, 0xe1, 0x0b, 0x52, 0x78, 0x6e, 0x33, 0x61, 0x81, 0x2e, 0x87,
+	0xaf, 0xa1, 0x72, 0x10, 0x3a, 0x7e, 0xe8, 0x44, 0xa7, 0x66, 0xa9, 0x6a, 0x5c, 0x2d, 0xd5, 0x17,
+	0xe4, 0x98, 0xf2, 0xae, 0xa4, 0x83, 0x92, 0xc0, 0x55, 0x54, 0xfe, 0x6a, 0x63, 0xe7, 0xee, 0xae,
+	0x1d, 0x1d, 0x99, 0xd3, 0x1c, 0x61, 0x8a, 0x49, 0x83, 0xa2, 0x5a, 0x3f, 0x2d, 0x20, 0x33, 0xeb,
+	0x95, 0xd8, 0xa5, 0xf8, 0x36, 0x2a, 0xd3, 0x88, 0x65, 0x9c, 0xc3, 0x53, 0xe9, 0x93, 0x57, 0x62,
+	0xb0, 0x86, 0xa4, 0x3f, 0xec, 0x57, 0x96, 0x93, 0x11, 0x31, 0x95, 0xfb, 0x43, 0x8d, 0xc5, 0x7f,
+	0x61, 0xa0, 0x0b, 0xc7, 0xe4, 0xe0, 0xc8, 0xf7, 0xef, 0x6f, 0xb8, 0x0e, 0xf1, 0xa2, 0x0d, 0xdf,
+	0x6b, 0x3b, 0x87, 0x32, 0x06, 0xe0, 0x29, 0x63, 0xe0, 0xfd, 0x61, 0xcd, 0xf5, 0xe7, 0x06, 0xfd,
+	0xca, 0x85, 0x1c, 0x06, 0xe4, 0xd9, 0x81, 0xf7, 0x91, 0xd9, 0xcc, 0x2c, 0x12, 0x99, 0xc0, 0x44,
+	0xda, 0x9a, 0xad, 0x5f, 0x1e, 0xf4, 0x2b, 0xe6, 0xc6, 0x08, 0x19, 0x18, 0x39, 0xda, 0xfa, 0x5e,
+	0x31, 0xeb, 0x5e, 0x2d, 0xdc, 0xbe, 0x85, 0xca, 0x6c, 0x19, 0xb7, 0xec, 0xc8, 0x96, 0x0b, 0xf1,
+	0xd5, 0x27, 0x5b, 0xf4, 0x22, 0x67, 0x6c, 0x93, 0xc8, 0xae, 0x63, 0xf9, 0x41, 0x50, 0x42, 0x03,
+	0xa5, 0x15, 0xff, 0x06, 0x9a, 0xa2, 0x01, 0x69, 0x4a, 0x47, 0x7f, 0xf0, 0xb4, 0x8b, 0x6d, 0xc4,
+	0x44, 0x1a, 0x01, 0x69, 0x26, 0x6b, 0x81, 0xfd, 0x02, 0x0e, 0x8b, 0xbf, 0x6f, 0xa0, 0x69, 0xca,
+	0x13, 0x94, 0x4c, 0x6a, 0x1f, 0x4e, 0xca, 0x82, 0x4c, 0x16, 0x14, 0xbf, 0x41, 0x82, 0x5b, 0xff,
+	0x53, 0x40, 0x57, 0x46, 0x0d, 0xdd, 0xf0, 0xbd, 0x96, 0xf8, 0x1c, 0x77, 0xe4, 0xda, 0x16, 0x91,
+	0xfe, 0x86, 0xbe, 0xb6, 0x1f, 0xf6, 0x2b, 0x2f, 0x3d, 0x56, 0x81, 0x96, 0x04, 0xde, 0x52, 0xf3,
+	0x16, 0x89, 0xe2, 0x4a, 0xda, 0xb0, 0x87, 0xfd, 0xca, 0x79, 0x35, 0x2c, 0x6d, 0x2b, 0xee, 0x21,
+	0xec, 0xda, 0x34, 0xda, 0x0b, 0x6d, 0x8f, 0x0a, 0xb5, 0x4e, 0x87, 0x48, 0xf7, 0xbd, 0xf2, 0x64,
+	0xe1, 0xc1, 0x46, 0xd4, 0x57, 0x24, 0x24, 0xde, 0x1a, 0xd2, 0x06, 0x39, 0x08, 0x2c, 0x6f, 0x85,
+	0xc4, 0xa6, 0x2a, 0x15, 0x69, 0x3b, 0x0a, 0xa3, 0x82, 0xe4, 0xe2, 0x2f, 0xa0, 0x99, 0x0e, 0xa1,
+	0xd4, 0x3e, 0x24, 0x3c, 0xff, 0xcc, 0x26, 0x5b, 0xf4, 0xb6, 0x20, 0x43, 0xcc, 0x67, 0xf5, 0xc9,
+	0xe5, 0x51, 0x5e, 0xdb, 0x72, 0x68, 0x84, 0xbf, 0x3e, 0xb4, 0x00, 0x6a, 0x4f, 0x36, 0x43, 0x36,
+	0x9a, 0x87, 0xbf, 0x4a, 0x7e, 0x31, 0x45, 0x0b, 0xfe, 0x5f, 0x47, 0x25, 0x27, 0x22, 0x9d, 0x78,
+	0xef, 0x7e, 0x7f, 0x42, 0xb1, 0x57, 0x3f, 0x2b, 0x6d, 0x28, 0xdd, 0x61, 0x68, 0x20, 0x40, 0xad,
+	0xbf, 0x2d, 0xa0, 0x17, 0x46, 0x0d, 0x61, 0x1b, 0x0a, 0x65, 0x1e, 0x0f, 0xdc, 0x6e, 0x68, 0xbb,
+	0xa6, 0x91, 0xf6, 0xf8, 0x2e, 0xa7, 0x82, 0xe4, 0xb2, 0x94, 0x4f, 0x1d, 0xef, 0xb0, 0xeb, 0xda,
+	0xa1, 0x0c, 0x27, 0x35, 0xeb, 0x86, 0xa4, 0x83, 0x92, 0xc0, 0x35, 0x84, 0xe8, 0x91, 0x1f, 0x46,
+	0x1c, 0x43, 0x66, 0xaf, 0x73, 0x2c, 0x41, 0x34, 0x14, 0x15, 0x34, 0x09, 0xb6, 0xa3, 0xdd, 0x77,
+	0xbc, 0x96, 0xfc, 0xea, 0x6a, 0x15, 0xbf, 0xeb, 0x78, 0x2d, 0xe0, 0x1c, 0x86, 0xef, 0x3a, 0x34,
+	0x62, 0x14, 0xb3, 0x94, 0xc6, 0xdf, 0x92, 0x74, 0x50, 0x12, 0x0c, 0xbf, 0xc9, 0xb2, 0xbe, 0x1f,
+	0x3a, 0x84, 0x9a, 0xd3, 0x09, 0xfe, 0x86, 0xa2, 0x82, 0x26, 0x61, 0xfd, 0xd7, 0xdc, 0xe8, 0x20,
+	0x61, 0xa9, 0x04, 0xbf, 0x88, 0x4a, 0x87, 0xa1, 0xdf, 0x0d, 0xa4, 0x97, 0x94, 0xb7, 0xdf, 0x61,
+	0x44, 0x10, 0x3c, 0x16, 0x95, 0xbd, 0x54, 0x99, 0xaa, 0xa2, 0x32, 0x2e, 0x4e, 0x63, 0x3e, 0xfe,
+	0x2d, 0x03, 0x95, 0x3c, 0xe9, 0x1c, 0x16, 0x72, 0x5f, 0x9f, 0x50, 0x5c, 0x70, 0xf7, 0x26, 0xe6,
+	0x0a, 0xcf, 0x0b, 0x64, 0xfc, 0x3a, 0x2a, 0xd1, 0xa6, 0x1f, 0x10, 0xe9, 0xf5, 0xd5, 0x58, 0xa8,
+	0xc1, 0x88, 0x0f, 0xfb, 0x95, 0xb3, 0xb1, 0x3a, 0x4e, 0x00, 0x21, 0x8c, 0x7f, 0xc7, 0x40, 0xa8,
+	0x67, 0xbb, 0x4e, 0xcb, 0xe6, 0x25, 0x43, 0xa9, 0x6a, 0x8c, 0x3d, 0xac, 0xdf, 0x53, 0xea, 0xc5,
+	0x47, 0x4b, 0x7e, 0x83, 0x06, 0x8d, 0x7f, 0x60, 0xa0, 0x79, 0xda, 0x3d, 0x08, 0xe5, 0x28, 0xca,
+	0x8b, 0x8b, 0xb9, 0x1b, 0xbf, 0x3a, 0x56, 0x5b, 0x1a, 0x1a, 0x40, 0x7d, 0x61, 0xd0, 0xaf, 0xcc,
+	0xeb, 0x14, 0x48, 0x19, 0x80, 0x7f, 0xdf, 0x40, 0xe5, 0x5e, 0xbc, 0x67, 0xcf, 0xf0, 0x05, 0xff,
+	0x8d, 0x09, 0x7d, 0x58, 0x19, 0x51, 0xc9, 0x2a, 0x50, 0x75, 0x80, 0xb2, 0x00, 0xff, 0x93, 0x81,
+	0x4c, 0xbb, 0x25, 0x12, 0xbc, 0xed, 0xee, 0x86, 0x8e, 0x17, 0x91, 0x50, 0xd4, 0x9b, 0xd4, 0x2c,
+	0x57, 0x8b, 0x63, 0xdf, 0x0b, 0xb3, 0xb5, 0x6c, 0xbd, 0x2a, 0xad, 0x33, 0xd7, 0x47, 0x98, 0x01,
+	0x23, 0x0d, 0xe4, 0x81, 0x96, 0x94, 0x34, 0xe6, 0xec, 0x04, 0x02, 0x2d, 0xa9, 0xa5, 0x64, 0x76,
+	0x50, 0xbf, 0x41, 0x83, 0xc6, 0x3b, 0x68, 0x29, 0x08, 0x09, 0x07, 0xb8, 0xe7, 0xdd, 0xf7, 0xfc,
+	0x63, 0xef, 0xb6, 0x43, 0xdc, 0x16, 0x35, 0x51, 0xd5, 0xb8, 0x5a, 0xae, 0x5f, 0x1a, 0xf4, 0x2b,
+	0x4b, 0xbb, 0x79, 0x02, 0x90, 0x3f, 0x0e, 0x1f, 0xa3, 0x69, 0xd7, 0xe9, 0x38, 0x11, 0x35, 0xe7,
+	0xf8, 0xac, 0x1a, 0x63, 0x9d, 0xd5, 0x16, 0x57, 0x5d, 0x47, 0x2c, 0x8b, 0x8b, 0xbf, 0x41, 0xc2,
+	0xe1, 0xef, 0x19, 0x68, 0x36, 0x24, 0x11, 0xf1, 0xf8, 0xda, 0x9d, 0xe7, 0xe0, 0xef, 0x8d, 0x15,
+	0x1c, 0x62, 0xed, 0xf5, 0xb3, 0x83, 0x7e, 0x65, 0x56, 0xfd, 0x84, 0x04, 0x17, 0x7f, 0x8c, 0x66,
+	0x8e, 0x1c, 0x1a, 0xf9, 0xe1, 0xa9, 0x79, 0x96, 0x9b, 0xb0, 0x37, 0x56, 0x13, 0xbe, 0x22, 0x74,
+	0x8b, 0xe3, 0x9f, 0xfc, 0x01, 0x31, 0xa2, 0xf5, 0x83, 0x62, 0xf6, 0x04, 0x96, 0xad, 0xe0, 0xf0,
+	0x27, 0x22, 0xf2, 0x44, 0x5c, 0x52, 0xd3, 0xe0, 0x2b, 0xe5, 0x5b, 0x13, 0x5a, 0xc8, 0xaa, 0x04,
+	0x4b, 0xaa, 0x68, 0x45, 0xa2, 0xa0, 0xd9, 0x81, 0xff, 0xd4, 0x40, 0x67, 0xed, 0x66, 0x93, 0x04,
+	0x11, 0x69, 0x89, 0x8d, 0xb5, 0xf0, 0x39, 0xec, 0x1d, 0x4b, 0xd2, 0xaa, 0xb3, 0xeb, 0x3a, 0x34,
+	0xa4, 0x2d, 0xc1, 0x6f, 0xa3, 0x73, 0xcc, 0xbd, 0xa4, 0x95, 0x39, 0xb2, 0xe0, 0x41, 0xbf, 0x72,
+	0xae, 0x91, 0xe2, 0x40, 0x46, 0xd2, 0xfa, 0xef, 0x69, 0x54, 0x79, 0x4c, 0x9a, 0x7b, 0x82, 0x43,
+	0xf1, 0xcb, 0x68, 0x9a, 0x4f, 0xb7, 0xc5, 0xbd, 0x52, 0xd6, 0xca, 0x70, 0x4e, 0x05, 0xc9, 0x65,
+	0x9b, 0x34, 0xc3, 0x67, 0xa5, 0x63, 0x91, 0x0b, 0xaa, 0x4d, 0xba, 0x21, 0xc8, 0x10, 0xf3, 0xf1,
+	0x0d, 0x84, 0x5a, 0x24, 0x08, 0x09, 0x2b, 0x14, 0x5a, 0xe6, 0x0c, 0x97, 0x56, 0x1f, 0x69, 0x53,
+	0x71, 0x40, 0x93, 0xc2, 0xb7, 0x11, 0x8e, 0x7f, 0x39, 0xbe, 0xf7, 0xbe, 0x1d, 0x7a, 0x8e, 0x77,
+	0x68, 0x96, 0xb9, 0xd9, 0xcb, 0xac, 0x12, 0xde, 0x1c, 0xe2, 0x42, 0xce, 0x08, 0xfc, 0x31, 0x9a,
+	0x16, 0x0d, 0x37, 0x73, 0x6a, 0x02, 0x89, 0x4f, 0xdb, 0x61, 0x79, 0x9a, 0x68, 0x70, 0x28, 0x90,
+	0x90, 0xc3, 0x3b, 0x6b, 0xe9, 0x59, 0xef, 0xac, 0x8f, 0xdc, 0xca, 0xa6, 0x7f, 0x1e, 0xb6, 0x32,
+	0xd9, 0x37, 0x9d, 0xd4, 0x56, 0xf6, 0x8e, 0x52, 0x2f, 0xb6, 0xb2, 0xe4, 0x37, 0x68, 0xd0, 0xd6,
+	0x83, 0x6c, 0x27, 0x20, 0x91, 0xc3, 0x15, 0x54, 0x0a, 0xec, 0xe8, 0x48, 0x24, 0xbc, 0xd9, 0xfa,
+	0x2c, 0xab, 0x05, 0x59, 0x7b, 0x86, 0x82, 0xa0, 0xe3, 0xd7, 0xd1, 0xbc, 0x73, 0xe8, 0xb1, 0xb5,
+	0xcb, 0xc9, 0xfc, 0x48, 0x33, 0x2b, 0x3e, 0xdd, 0x1d, 0x8d, 0x0e, 0x29, 0x29, 0xeb, 0x6b, 0x68,
+	0x29, 0x37, 0x3f, 0xe3, 0x9b, 0x68, 0xbe, 0x63, 0x9f, 0xb0, 0x5e, 0x05, 0x95, 0x79, 0x96, 0x75,
+	0x92, 0x2e, 0x4a, 0x3f, 0xcf, 0x6f, 0x6b, 0x3c, 0x48, 0x49, 0x5a, 0x03, 0x03, 0x5d, 0xcc, 0xdb,
+	0xf3, 0x58, 0xdd, 0xdf, 0xb1, 0x4f, 0xe2, 0x76, 0x29, 0x53, 0x58, 0x14, 0xee, 0xd8, 0x56, 0x54,
+	0xd0, 0x24, 0x30, 0xa0, 0xe5, 0xe4, 0xd7, 0x2e, 0x09, 0x79, 0x32, 0x0b, 0xec, 0xa6, 0xe8, 0xad,
+	0x15, 0xeb, 0x2b, 0x83, 0x7e, 0x65, 0x79, 0x3b, 0x57, 0x02, 0x46, 0x8c, 0x64, 0x19, 0x40, 0x71,
+	0x1a, 0xce, 0xb7, 0x49, 0xfd, 0x34, 0x92, 0x65, 0x7e, 0x51, 0x64, 0x80, 0xed, 0x21, 0x2e, 0xe4,
+	0x8c, 0xb0, 0xb6, 0xd1, 0x73, 0x23, 0xb6, 0x56, 0x96, 0x98, 0xa2, 0xc8, 0x6d, 0x10, 0xb6, 0x39,
+	0xc4, 0xd3, 0x54, 0x89, 0x69, 0x6f, 0x6f, 0x4b, 0x72, 0x40, 0x93, 0xb2, 0x1e, 0x64, 0x93, 0xac,
+	0xb6, 0xda, 0x04, 0xe3, 0x09, 0x92, 0xec, 0x35, 0x54, 0xfe, 0x88, 0xfa, 0x1e, 0x6f, 0xe5, 0x65,
+	0x4e, 0x81, 0x71, 0x33, 0x0f, 0x94, 0x84, 0xf5, 0xbf, 0x06, 0x5a, 0x1d, 0x89, 0xd9, 0x68, 0xda,
+	0x2e, 0xc1, 0x9b, 0x68, 0x81, 0xb2, 0x56, 0x0d, 0x09, 0x5c, 0xa7, 0x69, 0x53, 0xae, 0x58, 0xc0,
+	0xab, 0xb6, 0x75, 0x23, 0xc3, 0x87, 0xa1, 0x11, 0xf8, 0xab, 0x08, 0x8b, 0xc6, 0x45, 0x4a, 0x8f,
+	0x30, 0x50, 0xb5, 0x20, 0x1a, 0x43, 0x12, 0x90, 0x33, 0x0a, 0x6f, 0xa0, 0x45, 0xd7, 0x3e, 0x20,
+	0x6e, 0x83, 0xb8, 0xa4, 0x19, 0xf9, 0x21, 0x57, 0x25, 0xba, 0xa8, 0x4b, 0xec, 0xc6, 0x61, 0x2b,
+	0xcb, 0x84, 0x61, 0x79, 0xeb, 0xca, 0x23, 0x9c, 0x2d, 0xec, 0xb0, 0x7e, 0x54, 0x44, 0x2b, 0x23,
+	0x65, 0x28, 0xfe, 0x6e, 0xd2, 0xb5, 0x12, 0x4d, 0x89, 0x6f, 0x4c, 0x2a, 0xf9, 0xca, 0xb6, 0x15,
+	0x1a, 0x6e, 0x59, 0xe1, 0xdf, 0x64, 0x27, 0x44, 0xdb, 0x8d, 0xfb, 0xe4, 0x1f, 0x4e, 0xcc, 0x04,
+	0x06, 0x22, 0x12, 0x0e, 0xff, 0x13, 0x04, 0x2c, 0xfe, 0x6d, 0x03, 0x4d, 0x37, 0xf9, 0x20, 0x79,
+	0x71, 0x33, 0x31, 0x27, 0x08, 0x46, 0x52, 0x34, 0x48, 0x41, 0x89, 0x6e, 0xfd, 0xc8, 0x40, 0xe6,
+	0xa8, 0x1d, 0x14, 0xff, 0x81, 0x81, 0xce, 0xfb, 0x01, 0xf1, 0xd8, 0x8d, 0xd3, 0x97, 0xc4, 0x4e,
+	0x2a, 0xbf, 0xd9, 0xdd, 0xa7, 0x34, 0x97, 0xad, 0x29, 0xa1, 0x70, 0x37, 0xf4, 0x03, 0x5a, 0xbf,
+	0x30, 0xe8, 0x57, 0xce, 0xef, 0xa4, 0xa1, 0x20, 0x8b, 0x6d, 0xb9, 0xe8, 0xe2, 0xad, 0xce, 0x01,
+	0x69, 0xb5, 0x48, 0x2b, 0xb6, 0x96, 0x37, 0x45, 0x6e, 0x20, 0x64, 0x07, 0x4e, 0x7c, 0x91, 0x26,
+	0x56, 0x99, 0xca, 0x1a, 0xda, 0x0d, 0x9a, 0x26, 0xa5, 0x1a, 0x33, 0x85, 0x51, 0x8d, 0x19, 0xab,
+	0x83, 0x96, 0xd8, 0x5d, 0x53, 0xe8, 0xd9, 0xee, 0xa6, 0xdf, 0xec, 0x76, 0x88, 0x17, 0x09, 0xb7,
+	0x64, 0xee, 0x16, 0x8c, 0x27, 0xbc, 0x5b, 0x78, 0x01, 0x15, 0xbb, 0xa1, 0x2b, 0x01, 0xe7, 0xd4,
+	0xdd, 0x19, 0x6c, 0x01, 0xa3, 0x5b, 0x57, 0xd0, 0x14, 0xf3, 0x0a, 0xbe, 0x84, 0x8a, 0xa1, 0x7d,
+	0xcc, 0xb5, 0xce, 0xd7, 0x67, 0x98, 0x08, 0xd8, 0xc7, 0xc0, 0x68, 0xd6, 0x26, 0x7a, 0x2e, 0xed,
+	0x38, 0x12, 0x46, 0xa7, 0xa2, 0x4c, 0xad, 0xc4, 0xdd, 0x38, 0x6d, 0x8b, 0xd3, 0x1b, 0x66, 0x6f,
+	0x97, 0xff, 0xe4, 0x2f, 0x2b, 0x67, 0xbe, 0xf3, 0xd3, 0xea, 0x19, 0xeb, 0xbb, 0x6b, 0xe8, 0x7c,
+	0xc6, 0xff, 0x78, 0x05, 0x15, 0xd4, 0xb5, 0x1e, 0x92, 0xa6, 0x15, 0xee, 0x6c, 0x42, 0xc1, 0x69,
+	0xe1, 0x37, 0x55, 0xc1, 0x26, 0x4c, 0xaf, 0xa8, 0xfa, 0x93, 0x53, 0x59, 0x3f, 0x25, 0x51, 0xc7,
+	0xa6, 0x23, 0xc5, 0xf9, 0x4c, 0x48, 0x5b, 0xa6, 0x18, 0x31, 0x13, 0xd2, 0x06, 0x46, 0xfb, 0x59,
+	0xaf, 0x67, 0xe2, 0xfb, 0xa1, 0xd2, 0x13, 0xdc, 0x0f, 0x4d, 0x3f, 0xf2, 0x7e, 0xe8, 0x45, 0x54,
+	0x8a, 0x9c, 0xc8, 0x25, 0xe6, 0x4c, 0xba, 0xed, 0xb5, 0xc7, 0x88, 0x20, 0x78, 0xf8, 0x23, 0x34,
+	0xd3, 0x22, 0x6d, 0x9b, 0xdd, 0x1a, 0x96, 0x79, 0xd8, 0x6f, 0x8c, 0x21, 0xec, 0xc5, 0xe9, 0x6d,
+	0x53, 0xe8, 0x85, 0x18, 0x00, 0xbf, 0x84, 0x66, 0x3a, 0xf6, 0x89, 0xd3, 0xe9, 0x76, 0x78, 0x15,
+	0x65, 0x08, 0xb1, 0x6d, 0x41, 0x82, 0x98, 0xc7, 0xb6, 0x15, 0x72, 0xd2, 0x74, 0xbb, 0xd4, 0xe9,
+	0x11, 0xc9, 0x94, 0x87, 0x75, 0xb5, 0xad, 0xdc, 0xca, 0xf0, 0x61, 0x68, 0x04, 0x07, 0x73, 0x3c,
+	0x3e, 0x78, 0x4e, 0x03, 0x13, 0x24, 0x88, 0x79, 0x69, 0x30, 0x29, 0x3f, 0x3f, 0x0a, 0x4c, 0x0e,
+	0x1e, 0x1a, 0x81, 0xbf, 0x88, 0x66, 0x3b, 0xf6, 0xc9, 0x16, 0xf1, 0x0e, 0xa3, 0x23, 0x7e, 0x2c,
+	0x2e, 0x8a, 0x13, 0xf4, 0x76, 0x4c, 0x84, 0x84, 0xcf, 0x85, 0x1d, 0x4f, 0x0a, 0x9f, 0xd3, 0x84,
+	0x63, 0x22, 0x24, 0x7c, 0x76, 0xe2, 0x09, 0xec, 0x88, 0x2d, 0x51, 0xf3, 0x7c, 0xba, 0x2d, 0xb9,
+	0x2b, 0xc8, 0x10, 0xf3, 0xf1, 0x55, 0x54, 0xee, 0xd8, 0x27, 0x7c, 0x45, 0x98, 0x0b, 0x5c, 0x2d,
+	0xbf, 0xc8, 0xdc, 0x96, 0x34, 0x50, 0x5c, 0x2e, 0xe9, 0x78, 0x42, 0x72, 0x51, 0x93, 0x94, 0x34,
+	0x50, 0x5c, 0x16, 0xc4, 0x5d, 0xcf, 0x79, 0xd0, 0x25, 0x42, 0x18, 0x73, 0xcf, 0xa8, 0x20, 0xbe,
+	0x97, 0xb0, 0x40, 0x97, 0xe3, 0xa5, 0x5c, 0xd7, 0x8d, 0x9c, 0xc0, 0x25, 0x3b, 0x6d, 0xf3, 0x02,
+	0xf7, 0xbf, 0x28, 0xe5, 0x14, 0x15, 0x34, 0x09, 0x4c, 0xd0, 0x14, 0xf1, 0xba, 0x1d, 0xf3, 0x62,
+	0xb5, 0x38, 0xae, 0x10, 0x54, 0x2b, 0xe7, 0x96, 0xd7, 0xed, 0x00, 0x57, 0x8f, 0xdf, 0x44, 0x67,
+	0x3b, 0xf6, 0x89, 0xcc, 0x2a, 0x0e, 0xa1, 0xe6, 0x12, 0x9f, 0xfc, 0x22, 0x3b, 0x21, 0x6f, 0xeb,
+	0x0c, 0x48, 0xcb, 0xf1, 0x81, 0x8e, 0xa7, 0x0d, 0x5c, 0xd6, 0x06, 0xea, 0x0c, 0x48, 0xcb, 0x31,
+	0x4f, 0xb3, 0xab, 0x6b, 0x27, 0x24, 0x2d, 0xf3, 0x39, 0x9e, 0xb6, 0xe4, 0xe5, 0xb2, 0xa0, 0x81,
+	0xe2, 0xe2, 0x5e, 0x9c, 0xdd, 0x4c, 0xbe, 0x0c, 0xef, 0x8d, 0x77, 0xf7, 0xd9, 0x09, 0xd7, 0xc3,
+	0xd0, 0x3e, 0x1d, 0x4e, 0x9a, 0x98, 0xa2, 0x92, 0xed, 0xba, 0x3b, 0x6d, 0xf3, 0x52, 0xb5, 0x38,
+	0x81, 0x5d, 0x4f, 0x65, 0x9d, 0x75, 0x06, 0x02, 0x02, 0x8b, 0x81, 0xfa, 0x1e, 0x0b, 0x8d, 0x95,
+	0xc9, 0x82, 0xee, 0x30, 0x10, 0x10, 0x58, 0x7c, 0xa6, 0xde, 0xe9, 0x4e, 0xdb, 0x7c, 0x7e, 0xc2,
+	0x33, 0x65, 0x20, 0x20, 0xb0, 0xb0, 0x83, 0x8a, 0x9e, 0x1f, 0x99, 0x97, 0x27, 0x52, 0x52, 0xf0,
+	0x0d, 0xe7, 0xae, 0x1f, 0x01, 0xc3, 0xc0, 0x7f, 0x6c, 0x20, 0x14, 0x24, 0x21, 0xfa, 0xc2, 0x58,
+	0x8a, 0xae, 0x0c, 0x64, 0x2d, 0x89, 0xed, 0x5b, 0x5e, 0x14, 0x9e, 0x26, 0x35, 0x48, 0xc2, 0x00,
+	0xcd, 0x0a, 0xfc, 0xd7, 0x06, 0xba, 0xa8, 0x1f, 0xad, 0x95, 0x79, 0xab, 0x63, 0x69, 0x1e, 0x0e,
+	0x85, 0x79, 0xdd, 0xf7, 0xdd, 0xba, 0x39, 0xe8, 0x57, 0x2e, 0xae, 0xe7, 0xa0, 0x42, 0xae, 0x2d,
+	0xf8, 0xef, 0x0c, 0xb4, 0x28, 0xb3, 0xa8, 0x66, 0x61, 0x85, 0x3b, 0x90, 0x8c, 0xdb, 0x81, 0x59,
+	0x1c, 0xe1, 0x47, 0xf5, 0x28, 0x6a, 0x88, 0x0f, 0xc3, 0xa6, 0xe1, 0x7f, 0x34, 0xd0, 0x7c, 0x8b,
+	0x04, 0xc4, 0x6b, 0x11, 0xaf, 0xc9, 0x6c, 0xad, 0x8e, 0xa5, 0xcd, 0x99, 0xb5, 0x75, 0x53, 0x83,
+	0x10, 0x66, 0xd6, 0xe2, 0x03, 0xbe, 0xce, 0x62, 0x2f, 0x38, 0x92, 0xa1, 0x3a, 0x07, 0x52, 0x56,
+	0xe2, 0x1f, 0x1a, 0xe8, 0x7c, 0xf2, 0x01, 0xc4, 0x96, 0x72, 0x65, 0x82, 0x71, 0xc0, 0x4b, 0xee,
+	0xf5, 0x34, 0x20, 0x64, 0x2d, 0xc0, 0x7f, 0x6f, 0xb0, 0x4a, 0x2d, 0xee, 0x15, 0x51, 0xd3, 0xe2,
+	0xbe, 0xfc, 0xe6, 0xd8, 0x7d, 0xa9, 0x10, 0x84, 0x2b, 0xaf, 0x25, 0xa5, 0xa0, 0xe2, 0x3c, 0xec,
+	0x57, 0x96, 0x74, 0x4f, 0x2a, 0x06, 0xe8, 0x16, 0xe2, 0xdf, 0x33, 0xd0, 0x3c, 0x49, 0xea, 0x76,
+	0x6a, 0xbe, 0x38, 0x16, 0x27, 0xe6, 0x1e, 0x05, 0x44, 0x8b, 0x48, 0x63, 0x51, 0x48, 0x61, 0xb3,
+	0x0a, 0x92, 0x9c, 0xd8, 0x9d, 0xc0, 0x25, 0xe6, 0x2f, 0x8c, 0xb9, 0x82, 0xbc, 0x25, 0xf4, 0x42,
+	0x0c, 0xc0, 0x5a, 0x18, 0x5e, 0xd7, 0x75, 0xed, 0x03, 0x97, 0x98, 0x2f, 0xf1, 0x5a, 0x44, 0xb5,
+	0x30, 0xee, 0x4a, 0x3a, 0x28, 0x09, 0xdc, 0x42, 0xa5, 0xa6, 0xef, 0xd1, 0xc8, 0xbc, 0x3e, 0x3e,
+	0xbb, 0xf8, 0x06, 0xba, 0xc1, 0xb4, 0x82, 0x50, 0x8e, 0xdb, 0xa8, 0xe0, 0xb4, 0xcd, 0xda, 0x44,
+	0x12, 0xfc, 0x34, 0x3f, 0xa3, 0xb4, 0xa1, 0xe0, 0xb4, 0xb1, 0x8b, 0xa6, 0xa2, 0x23, 0xe2, 0x99,
+	0x6b, 0x13, 0x41, 0x2a, 0xf3, 0x43, 0xc6, 0x11, 0xf1, 0x80, 0xa3, 0x30, 0x34, 0xe2, 0x52, 0x62,
+	0xbe, 0x3a, 0x39, 0xb4, 0x5b, 0x2e, 0x25, 0xc0, 0x51, 0xf0, 0xbf, 0x1a, 0x68, 0x31, 0xce, 0x14,
+	0x51, 0x5c, 0x1c, 0x99, 0xaf, 0x4d, 0x24, 0x01, 0x6f, 0x66, 0x71, 0xc4, 0x72, 0xbc, 0x99, 0xbc,
+	0x4a, 0xcd, 0xf0, 0x1f, 0xf6, 0x2b, 0xcf, 0x0f, 0xa7, 0x37, 0xc5, 0x86, 0x61, 0xcb, 0x59, 0xcf,
+	0xf8, 0x6c, 0xa0, 0x1f, 0x5e, 0xcd, 0x1b, 0x13, 0xf1, 0x23, 0x2f, 0x40, 0x53, 0xa7, 0x64, 0x48,
+	0xe3, 0xe2, 0x36, 0xaa, 0x9e, 0xbc, 0xab, 0x9e, 0x54, 0xe7, 0x5e, 0x74, 0x9a, 0x2f, 0xf3, 0x95,
+	0xc4, 0xdb, 0xa5, 0xfb, 0xb9, 0x12, 0xf0, 0x58, 0x1d, 0xf8, 0x03, 0xf4, 0xbc, 0x26, 0x93, 0x6d,
+	0x61, 0x98, 0xbf, 0x28, 0x2e, 0x5b, 0x63, 0x1f, 0xef, 0x67, 0x05, 0xe0, 0x51, 0xa3, 0xf1, 0x16,
+	0x5a, 0xd6, 0xd8, 0x77, 0xbc, 0x68, 0x27, 0x6c, 0x44, 0x21, 0xbb, 0x9b, 0xb9, 0xca, 0xf5, 0xaa,
+	0xb6, 0xf3, 0xbe, 0xc6, 0x83, 0x11, 0x63, 0xf0, 0x57, 0x52, 0xda, 0xf8, 0xb3, 0x1f, 0x3b, 0x78,
+	0x97, 0x9c, 0x52, 0xf3, 0x0b, 0x49, 0x4f, 0x7c, 0x5f, 0xa3, 0xc3, 0x08, 0x79, 0xfc, 0x2b, 0xe8,
+	0x42, 0x86, 0xc3, 0x8e, 0xe9, 0xe6, 0x2b, 0xe2, 0xbc, 0xcd, 0xce, 0x74, 0xfb, 0x31, 0x11, 0xf2,
+	0x24, 0xf1, 0x2f, 0x21, 0xac, 0x91, 0xb7, 0xed, 0x80, 0x8f, 0xff, 0xa2, 0x38, 0xfa, 0xb3, 0xac,
+	0xb6, 0x2f, 0x69, 0x90, 0x23, 0x87, 0xff, 0xcc, 0x48, 0xcd, 0x24, 0xe9, 0x6a, 0x51, 0xf3, 0x1a,
+	0x5f, 0x3a, 0xdb, 0x4f, 0x19, 0x6e, 0x89, 0x46, 0xe8, 0xba, 0x44, 0x73, 0xb3, 0x06, 0x05, 0x23,
+	0x4c, 0xc0, 0x75, 0x74, 0x31, 0xcd, 0xe9, 0x12, 0x3e, 0xbb, 0x2f, 0x89, 0xa6, 0x05, 0xab, 0x19,
+	0xf7, 0x15, 0x15, 0x72, 0x65, 0x33, 0x3a, 0xee, 0xb2, 0xbe, 0x86, 0xeb, 0x7c, 0x9b, 0x98, 0xaf,
+	0x27, 0x8f, 0x82, 0xf6, 0x15, 0x15, 0x72, 0x65, 0xf1, 0x2d, 0xb4, 0xa4, 0xd1, 0x93, 0x9b, 0x3f,
+	0xf3, 0x0d, 0x71, 0x9e, 0x66, 0x5b, 0xf0, 0x7e, 0x42, 0x86, 0x7c, 0x69, 0x7c, 0x3b, 0x65, 0x4a,
+	0x83, 0xf0, 0x97, 0x6f, 0x3d, 0x62, 0x7e, 0x39, 0x7d, 0xb3, 0xb8, 0xaf, 0x38, 0x90, 0x2b, 0x8f,
+	0xf7, 0xd0, 0x8a, 0x46, 0x7f, 0x3f, 0x74, 0x22, 0xb2, 0x4b, 0xc2, 0x8e, 0x43, 0x79, 0x63, 0xef,
+	0x4d, 0x6e, 0xd3, 0x45, 0xd6, 0x76, 0xd8, 0xcf, 0xf0, 0xe0, 0x11, 0xe3, 0x32, 0x93, 0x4c, 0xee,
+	0xa0, 0xcd, 0x9b, 0xda, 0x24, 0x13, 0x32, 0xe4, 0x4b, 0xe3, 0x7f, 0x31, 0x50, 0xf5, 0x11, 0x0b,
+	0x91, 0x75, 0x0e, 0xa9, 0xf9, 0x56, 0xb5, 0x38, 0x86, 0x67, 0x0f, 0x79, 0xba, 0xd5, 0x33, 0xa6,
+	0xe5, 0xfd, 0x5c, 0x68, 0x78, 0xac, 0x71, 0xec, 0x10, 0xa4, 0xfb, 0x1d, 0x48, 0x9b, 0x84, 0xc4,
+	0x6b, 0x12, 0xf3, 0xed, 0xb1, 0x24, 0x60, 0xa5, 0x6f, 0xcf, 0x0e, 0x0f, 0x49, 0x24, 0x43, 0x50,
+	0x51, 0x21, 0x17, 0x7b, 0x85, 0x35, 0x99, 0x33, 0x05, 0x3f, 0x5e, 0x40, 0xc5, 0xfb, 0x44, 0x3e,
+	0x8a, 0x06, 0xf6, 0x27, 0x2b, 0x57, 0x7a, 0x2c, 0xf2, 0xcd, 0xc2, 0x58, 0x4c, 0xcd, 0xec, 0x15,
+	0x20, 0x94, 0xbf, 0x5d, 0xb8, 0x69, 0xac, 0x7c, 0x62, 0xa0, 0xe5, 0xfc, 0x73, 0xc8, 0x33, 0x35,
+	0xeb, 0xcf, 0x0d, 0xb4, 0x38, 0x74, 0xe4, 0xc8, 0xb1, 0xe8, 0x41, 0xda, 0xa2, 0x0f, 0xc6, 0x7d,
+	0x76, 0x10, 0xfb, 0x04, 0x6f, 0x98, 0xe8, 0xe6, 0xfd, 0xa1, 0x81, 0x16, 0xb2, 0x55, 0xfc, 0xb3,
+	0xf6, 0xd7, 0x72, 0x7e, 0x35, 0x93, 0x63, 0x96, 0x9b, 0x36, 0xeb, 0xbd, 0xb1, 0x9a, 0x95, 0x94,
+	0x1f, 0x89, 0x79, 0xd6, 0x27, 0x05, 0xb4, 0x9c, 0xdf, 0x86, 0xc2, 0xa1, 0xea, 0xb7, 0x4f, 0xe6,
+	0xae, 0x25, 0xef, 0x5d, 0xc4, 0xf7, 0x0d, 0x34, 0xf7, 0x91, 0x92, 0x8b, 0xdf, 0xf4, 0x8e, 0xfd,
+	0x96, 0x27, 0x3e, 0xd5, 0x25, 0x0c, 0x0a, 0x3a, 0xae, 0xf5, 0x0f, 0x06, 0x5a, 0xca, 0x3d, 0xae,
+	0xb2, 0xc6, 0xbe, 0xed, 0xba, 0xfe, 0xb1, 0xb8, 0x35, 0xd4, 0x5e, 0xc1, 0xac, 0x73, 0x2a, 0x48,
+	0xae, 0xe6, 0xbd, 0xc2, 0xe7, 0xe5, 0x3d, 0xeb, 0x9f, 0x0d, 0x74, 0xf9, 0x51, 0x0b, 0xe5, 0x99,
+	0x7c, 0xd2, 0xab, 0xec, 0x5f, 0x59, 0x44, 0xf4, 0xc9, 0xf7, 0x0c, 0xf3, 0xe2, 0xdf, 0x58, 0x04,
+	0x0d, 0x14, 0xd7, 0xfa, 0x77, 0x03, 0x9d, 0xcf, 0xe4, 0xee, 0x27, 0x7b, 0x16, 0x7c, 0x8d, 0xff,
+	0xc3, 0x91, 0x28, 0x62, 0x33, 0x97, 0xe6, 0xaa, 0x76, 0x55, 0x12, 0xc9, 0xab, 0xdc, 0xe2, 0xff,
+	0xe7, 0x55, 0xee, 0x5b, 0x68, 0x3a, 0xf0, 0x5d, 0xa7, 0x79, 0x6a, 0x4e, 0xa5, 0xdf, 0xfa, 0xef,
+	0x72, 0x2a, 0x7b, 0xeb, 0xaf, 0x6c, 0x17, 0x24, 0x90, 0x03, 0xac, 0xbf, 0x32, 0xd0, 0x02, 0x7b,
+	0x23, 0xe5, 0x34, 0x89, 0x12, 0xc1, 0x6b, 0x68, 0xd6, 0x53, 0x6f, 0x21, 0xc4, 0xe4, 0x16, 0xa5,
+	0xca, 0xd9, 0xe4, 0x09, 0x44, 0x22, 0xa3, 0xde, 0x0e, 0x14, 0x46, 0xbe, 0x1d, 0xb8, 0x8c, 0xa6,
+	0x82, 0xe4, 0x2e, 0x9d, 0x1f, 0xdf, 0xf8, 0xf5, 0x39, 0xa7, 0x72, 0xae, 0x1f, 0x46, 0xdc, 0xfc,
+	0x92, 0xe4, 0xfa, 0x61, 0x04, 0x9c, 0x6a, 0x7d, 0x88, 0xce, 0xa5, 0x0b, 0x49, 0x86, 0x17, 0x76,
+	0xdd, 0xa1, 0xb7, 0x0a, 0x8c, 0x07, 0x9c, 0xa3, 0xff, 0x8f, 0x40, 0xe1, 0x31, 0xff, 0x23, 0xf0,
+	0x6f, 0x06, 0xca, 0xfb, 0x3f, 0x1d, 0x7c, 0x49, 0xdc, 0x45, 0x6a, 0x57, 0x73, 0xf1, 0x3d, 0x24,
+	0xee, 0xa1, 0x19, 0x2a, 0x9c, 0x26, 0x83, 0x75, 0xe7, 0x29, 0x83, 0x35, 0xfb, 0x09, 0x44, 0xfb,
+	0x22, 0xa6, 0xc6, 0x60, 0x2c, 0x5e, 0x9b, 0x76, 0xbd, 0xeb, 0xb5, 0xe4, 0xad, 0xfc, 0xbc, 0x88,
+	0xd7, 0x8d, 0x75, 0x41, 0x03, 0xc5, 0xad, 0x5f, 0xff, 0xf4, 0xb3, 0xd5, 0x33, 0x3f, 0xfe, 0x6c,
+	0xf5, 0xcc, 0x4f, 0x3e, 0x5b, 0x3d, 0xf3, 0x9d, 0xc1, 0xaa, 0xf1, 0xe9, 0x60, 0xd5, 0xf8, 0xf1,
+	0x60, 0xd5, 0xf8, 0xc9, 0x60, 0xd5, 0xf8, 0x8f, 0xc1, 0xaa, 0xf1, 0x47, 0xff, 0xb9, 0x7a, 0xe6,
+	0xd7, 0x66, 0x24, 0xfe, 0xff, 0x0d, 0x00, 0x1f, 0xe3, 0xe2, 0x0c, 0x3d, 0x3b, 0x00, 0x00,
 }
 
 func (m *ConversionRequest) Marshal() (dAtA []byte, err error) {
@@ -1461,6 +1739,42 @@ func (m *CustomResourceDefinitionSpec) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.PreserveUnknownFields != nil {
 		i--
 		if *m.PreserveUnknownFields {
@@ -1639,6 +1953,18 @@ func (m *CustomResourceDefinitionVersion) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Generation != nil {
+		{
+			size, err := m.Generation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.DeprecationWarning != nil {
 		i -= len(*m.DeprecationWarning)
 		copy(dAtA[i:], *m.DeprecationWarning)
@@ -1716,7 +2042,7 @@ func (m *CustomResourceDefinitionVersion) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceGeneration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1726,37 +2052,38 @@ func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresourceScale) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceGeneration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresourceScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceGeneration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LabelSelectorPath != nil {
-		i -= len(*m.LabelSelectorPath)
-		copy(dAtA[i:], *m.LabelSelectorPath)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.LabelSelectorPath)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.IgnoredPaths) > 0 {
+		for iNdEx := len(m.IgnoredPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoredPaths[iNdEx])
+			copy(dAtA[i:], m.IgnoredPaths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoredPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	i -= len(m.StatusReplicasPath)
-	copy(dAtA[i:], m.StatusReplicasPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusReplicasPath)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.SpecReplicasPath)
-	copy(dAtA[i:], m.SpecReplicasPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SpecReplicasPath)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1766,20 +2093,23 @@ func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresourceStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceHistory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxRevisions))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1789,44 +2119,35 @@ func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceSubresources) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceSubresources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Scale != nil {
-		{
-			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MaxObjectSizeBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjectSizeBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.MaxObjectsPerNamespace != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjectsPerNamespace))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.MaxObjects != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxObjects))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
+func (m *CustomResourceRetention) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1836,22 +2157,205 @@ func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CustomResourceValidation) MarshalTo(dAtA []byte) (int, error) {
+func (m *CustomResourceRetention) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CustomResourceRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OpenAPIV3Schema != nil {
-		{
-			size, err := m.OpenAPIV3Schema.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TTLSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceCustom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceCustom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceCustom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LabelSelectorPath != nil {
+		i -= len(*m.LabelSelectorPath)
+		copy(dAtA[i:], *m.LabelSelectorPath)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.LabelSelectorPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.StatusReplicasPath)
+	copy(dAtA[i:], m.StatusReplicasPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusReplicasPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SpecReplicasPath)
+	copy(dAtA[i:], m.SpecReplicasPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SpecReplicasPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceSubresources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceSubresources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceSubresources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Custom) > 0 {
+		for iNdEx := len(m.Custom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Custom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Scale != nil {
+		{
+			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomResourceValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenAPIV3Schema != nil {
+		{
+			size, err := m.OpenAPIV3Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
 			i -= size
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
@@ -1861,6 +2365,39 @@ func (m *CustomResourceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EmbeddedResourceKind) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmbeddedResourceKind) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmbeddedResourceKind) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExternalDocumentation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1924,6 +2461,38 @@ func (m *JSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m JSONSchemaPropertyNames) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m JSONSchemaPropertyNames) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m JSONSchemaPropertyNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m) > 0 {
+		for iNdEx := len(m) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m[iNdEx])
+			copy(dAtA[i:], m[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JSONSchemaProps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1944,10 +2513,24 @@ func (m *JSONSchemaProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.XValidations) > 0 {
-		for iNdEx := len(m.XValidations) - 1; iNdEx >= 0; iNdEx-- {
+	if m.XReference != nil {
+		{
+			size, err := m.XReference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.XEmbeddedResourceKinds) > 0 {
+		for iNdEx := len(m.XEmbeddedResourceKinds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.XValidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.XEmbeddedResourceKinds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1955,19 +2538,193 @@ func (m *JSONSchemaProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0xca
 		}
 	}
-	if m.XMapType != nil {
-		i -= len(*m.XMapType)
-		copy(dAtA[i:], *m.XMapType)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XMapType)))
+	if m.XConditions != nil {
+		i -= len(*m.XConditions)
+		copy(dAtA[i:], *m.XConditions)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XConditions)))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xc2
+	}
+	if m.XWritePermission != nil {
+		i -= len(*m.XWritePermission)
+		copy(dAtA[i:], *m.XWritePermission)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XWritePermission)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xba
+	}
+	i--
+	if m.XSensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xb0
+	if m.XDeprecated != nil {
+		i -= len(*m.XDeprecated)
+		copy(dAtA[i:], *m.XDeprecated)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XDeprecated)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.XNormalize) > 0 {
+		for iNdEx := len(m.XNormalize) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.XNormalize[iNdEx])
+			copy(dAtA[i:], m.XNormalize[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.XNormalize[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.XValueType != nil {
+		i -= len(*m.XValueType)
+		copy(dAtA[i:], *m.XValueType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XValueType)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PropertyNames != nil {
+		{
+			size, err := m.PropertyNames.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DependentRequired) > 0 {
+		keysForDependentRequired := make([]string, 0, len(m.DependentRequired))
+		for k := range m.DependentRequired {
+			keysForDependentRequired = append(keysForDependentRequired, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForDependentRequired)
+		for iNdEx := len(keysForDependentRequired) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.DependentRequired[string(keysForDependentRequired[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForDependentRequired[iNdEx])
+			copy(dAtA[i:], keysForDependentRequired[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForDependentRequired[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Else != nil {
+		{
+			size, err := m.Else.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Then != nil {
+		{
+			size, err := m.Then.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.If != nil {
+		{
+			size, err := m.If.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Const != nil {
+		{
+			size, err := m.Const.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.XValidations) > 0 {
+		for iNdEx := len(m.XValidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XValidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.XMapType != nil {
+		i -= len(*m.XMapType)
+		copy(dAtA[i:], *m.XMapType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.XMapType)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
 	if m.XListType != nil {
 		i -= len(*m.XListType)
@@ -2591,6 +3348,49 @@ func (m *JSONSchemaPropsOrStringArray) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *ReferenceTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Scope)
+	copy(dAtA[i:], m.Scope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ServiceReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2946,6 +3746,18 @@ func (m *CustomResourceDefinitionSpec) Size() (n int) {
 	if m.PreserveUnknownFields != nil {
 		n += 2
 	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3001,6 +3813,82 @@ func (m *CustomResourceDefinitionVersion) Size() (n int) {
 		l = len(*m.DeprecationWarning)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Generation != nil {
+		l = m.Generation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomResourceGeneration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.IgnoredPaths) > 0 {
+		for _, s := range m.IgnoredPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CustomResourceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxRevisions))
+	return n
+}
+
+func (m *CustomResourceLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxObjects != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjects))
+	}
+	if m.MaxObjectsPerNamespace != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjectsPerNamespace))
+	}
+	if m.MaxObjectSizeBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxObjectSizeBytes))
+	}
+	return n
+}
+
+func (m *CustomResourceRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TTLSeconds))
+	return n
+}
+
+func (m *CustomResourceSubresourceCustom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3044,6 +3932,12 @@ func (m *CustomResourceSubresources) Size() (n int) {
 		l = m.Scale.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Custom) > 0 {
+		for _, e := range m.Custom {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3060,6 +3954,19 @@ func (m *CustomResourceValidation) Size() (n int) {
 	return n
 }
 
+func (m *EmbeddedResourceKind) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ExternalDocumentation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3086,6 +3993,21 @@ func (m *JSON) Size() (n int) {
 	return n
 }
 
+func (m JSONSchemaPropertyNames) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m) > 0 {
+		for _, s := range m {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *JSONSchemaProps) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3260,17 +4182,79 @@ func (m *JSONSchemaProps) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *JSONSchemaPropsOrArray) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Const != nil {
+		l = m.Const.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Schema != nil {
-		l = m.Schema.Size()
+	if m.If != nil {
+		l = m.If.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Then != nil {
+		l = m.Then.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Else != nil {
+		l = m.Else.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DependentRequired) > 0 {
+		for k, v := range m.DependentRequired {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.PropertyNames != nil {
+		l = m.PropertyNames.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.XValueType != nil {
+		l = len(*m.XValueType)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.XNormalize) > 0 {
+		for _, s := range m.XNormalize {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.XDeprecated != nil {
+		l = len(*m.XDeprecated)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	if m.XWritePermission != nil {
+		l = len(*m.XWritePermission)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.XConditions != nil {
+		l = len(*m.XConditions)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.XEmbeddedResourceKinds) > 0 {
+		for _, e := range m.XEmbeddedResourceKinds {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.XReference != nil {
+		l = m.XReference.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JSONSchemaPropsOrArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	if len(m.JSONSchemas) > 0 {
@@ -3315,6 +4299,23 @@ func (m *JSONSchemaPropsOrStringArray) Size() (n int) {
 	return n
 }
 
+func (m *ReferenceTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Scope)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ServiceReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3529,6 +4530,9 @@ func (this *CustomResourceDefinitionSpec) String() string {
 		`AdditionalPrinterColumns:` + repeatedStringForAdditionalPrinterColumns + `,`,
 		`Conversion:` + strings.Replace(this.Conversion.String(), "CustomResourceConversion", "CustomResourceConversion", 1) + `,`,
 		`PreserveUnknownFields:` + valueToStringGenerated(this.PreserveUnknownFields) + `,`,
+		`Limits:` + strings.Replace(this.Limits.String(), "CustomResourceLimits", "CustomResourceLimits", 1) + `,`,
+		`Retention:` + strings.Replace(this.Retention.String(), "CustomResourceRetention", "CustomResourceRetention", 1) + `,`,
+		`History:` + strings.Replace(this.History.String(), "CustomResourceHistory", "CustomResourceHistory", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3568,6 +4572,61 @@ func (this *CustomResourceDefinitionVersion) String() string {
 		`AdditionalPrinterColumns:` + repeatedStringForAdditionalPrinterColumns + `,`,
 		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
 		`DeprecationWarning:` + valueToStringGenerated(this.DeprecationWarning) + `,`,
+		`Generation:` + strings.Replace(this.Generation.String(), "CustomResourceGeneration", "CustomResourceGeneration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceGeneration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceGeneration{`,
+		`Paths:` + fmt.Sprintf("%v", this.Paths) + `,`,
+		`IgnoredPaths:` + fmt.Sprintf("%v", this.IgnoredPaths) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceHistory{`,
+		`MaxRevisions:` + fmt.Sprintf("%v", this.MaxRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceLimits{`,
+		`MaxObjects:` + valueToStringGenerated(this.MaxObjects) + `,`,
+		`MaxObjectsPerNamespace:` + valueToStringGenerated(this.MaxObjectsPerNamespace) + `,`,
+		`MaxObjectSizeBytes:` + valueToStringGenerated(this.MaxObjectSizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceRetention) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceRetention{`,
+		`TTLSeconds:` + fmt.Sprintf("%v", this.TTLSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomResourceSubresourceCustom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomResourceSubresourceCustom{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3597,9 +4656,15 @@ func (this *CustomResourceSubresources) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCustom := "[]CustomResourceSubresourceCustom{"
+	for _, f := range this.Custom {
+		repeatedStringForCustom += strings.Replace(strings.Replace(f.String(), "CustomResourceSubresourceCustom", "CustomResourceSubresourceCustom", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCustom += "}"
 	s := strings.Join([]string{`&CustomResourceSubresources{`,
 		`Status:` + strings.Replace(this.Status.String(), "CustomResourceSubresourceStatus", "CustomResourceSubresourceStatus", 1) + `,`,
 		`Scale:` + strings.Replace(this.Scale.String(), "CustomResourceSubresourceScale", "CustomResourceSubresourceScale", 1) + `,`,
+		`Custom:` + repeatedStringForCustom + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3614,6 +4679,17 @@ func (this *CustomResourceValidation) String() string {
 	}, "")
 	return s
 }
+func (this *EmbeddedResourceKind) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmbeddedResourceKind{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExternalDocumentation) String() string {
 	if this == nil {
 		return "nil"
@@ -3664,6 +4740,11 @@ func (this *JSONSchemaProps) String() string {
 		repeatedStringForXValidations += strings.Replace(strings.Replace(f.String(), "ValidationRule", "ValidationRule", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForXValidations += "}"
+	repeatedStringForXEmbeddedResourceKinds := "[]EmbeddedResourceKind{"
+	for _, f := range this.XEmbeddedResourceKinds {
+		repeatedStringForXEmbeddedResourceKinds += strings.Replace(strings.Replace(f.String(), "EmbeddedResourceKind", "EmbeddedResourceKind", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForXEmbeddedResourceKinds += "}"
 	keysForProperties := make([]string, 0, len(this.Properties))
 	for k := range this.Properties {
 		keysForProperties = append(keysForProperties, k)
@@ -3704,6 +4785,16 @@ func (this *JSONSchemaProps) String() string {
 		mapStringForDefinitions += fmt.Sprintf("%v: %v,", k, this.Definitions[k])
 	}
 	mapStringForDefinitions += "}"
+	keysForDependentRequired := make([]string, 0, len(this.DependentRequired))
+	for k := range this.DependentRequired {
+		keysForDependentRequired = append(keysForDependentRequired, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDependentRequired)
+	mapStringForDependentRequired := "JSONSchemaDependentRequired{"
+	for _, k := range keysForDependentRequired {
+		mapStringForDependentRequired += fmt.Sprintf("%v: %v,", k, this.DependentRequired[k])
+	}
+	mapStringForDependentRequired += "}"
 	s := strings.Join([]string{`&JSONSchemaProps{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
@@ -3749,6 +4840,20 @@ func (this *JSONSchemaProps) String() string {
 		`XListType:` + valueToStringGenerated(this.XListType) + `,`,
 		`XMapType:` + valueToStringGenerated(this.XMapType) + `,`,
 		`XValidations:` + repeatedStringForXValidations + `,`,
+		`Const:` + strings.Replace(this.Const.String(), "JSON", "JSON", 1) + `,`,
+		`If:` + strings.Replace(this.If.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`Then:` + strings.Replace(this.Then.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`Else:` + strings.Replace(this.Else.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`DependentRequired:` + mapStringForDependentRequired + `,`,
+		`PropertyNames:` + strings.Replace(this.PropertyNames.String(), "JSONSchemaProps", "JSONSchemaProps", 1) + `,`,
+		`XValueType:` + valueToStringGenerated(this.XValueType) + `,`,
+		`XNormalize:` + fmt.Sprintf("%v", this.XNormalize) + `,`,
+		`XDeprecated:` + valueToStringGenerated(this.XDeprecated) + `,`,
+		`XSensitive:` + fmt.Sprintf("%v", this.XSensitive) + `,`,
+		`XWritePermission:` + valueToStringGenerated(this.XWritePermission) + `,`,
+		`XConditions:` + valueToStringGenerated(this.XConditions) + `,`,
+		`XEmbeddedResourceKinds:` + repeatedStringForXEmbeddedResourceKinds + `,`,
+		`XReference:` + strings.Replace(this.XReference.String(), "ReferenceTarget", "ReferenceTarget", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3791,6 +4896,19 @@ func (this *JSONSchemaPropsOrStringArray) String() string {
 	}, "")
 	return s
 }
+func (this *ReferenceTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReferenceTarget{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ServiceReference) String() string {
 	if this == nil {
 		return "nil"
@@ -3819,23 +4937,442 @@ func (this *WebhookClientConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebhookClientConfig{`,
-		`Service:` + strings.Replace(this.Service.String(), "ServiceReference", "ServiceReference", 1) + `,`,
-		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
-		`URL:` + valueToStringGenerated(this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&WebhookClientConfig{`,
+		`Service:` + strings.Replace(this.Service.String(), "ServiceReference", "ServiceReference", 1) + `,`,
+		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
+		`URL:` + valueToStringGenerated(this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAPIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredAPIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, runtime.RawExtension{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedObjects = append(m.ConvertedObjects, runtime.RawExtension{})
+			if err := m.ConvertedObjects[len(m.ConvertedObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ConversionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &ConversionResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3858,15 +5395,15 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceColumnDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceColumnDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3894,11 +5431,11 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAPIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3926,13 +5463,13 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredAPIVersion = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3942,25 +5479,106 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, runtime.RawExtension{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3983,7 +5601,7 @@ func (m *ConversionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4006,15 +5624,15 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4042,11 +5660,11 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			m.Strategy = ConversionStrategyType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedObjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookClientConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4073,16 +5691,18 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConvertedObjects = append(m.ConvertedObjects, runtime.RawExtension{})
-			if err := m.ConvertedObjects[len(m.ConvertedObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WebhookClientConfig == nil {
+				m.WebhookClientConfig = &WebhookClientConfig{}
+			}
+			if err := m.WebhookClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionReviewVersions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4092,24 +5712,23 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConversionReviewVersions = append(m.ConversionReviewVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4132,7 +5751,7 @@ func (m *ConversionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConversionReview) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4155,15 +5774,15 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConversionReview: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConversionReview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4190,16 +5809,13 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ConversionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4226,10 +5842,40 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &ConversionResponse{}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4254,7 +5900,7 @@ func (m *ConversionReview) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4277,15 +5923,15 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceColumnDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceColumnDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4313,11 +5959,11 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = CustomResourceDefinitionConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4345,13 +5991,13 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4361,27 +6007,28 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4409,30 +6056,11 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			m.Priority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Priority |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4460,7 +6088,7 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4483,7 +6111,7 @@ func (m *CustomResourceColumnDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4506,17 +6134,17 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceConversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4526,27 +6154,28 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = ConversionStrategyType(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4573,16 +6202,64 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WebhookClientConfig == nil {
-				m.WebhookClientConfig = &WebhookClientConfig{}
-			}
-			if err := m.WebhookClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CustomResourceDefinition{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceDefinitionNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceDefinitionNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionReviewVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plural", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4610,63 +6287,77 @@ func (m *CustomResourceConversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionReviewVersions = append(m.ConversionReviewVersions, string(dAtA[iNdEx:postIndex]))
+			m.Plural = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Singular", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Singular = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortNames = append(m.ShortNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4676,30 +6367,29 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListKind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4709,30 +6399,29 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ListKind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4742,24 +6431,23 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Categories = append(m.Categories, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4782,7 +6470,7 @@ func (m *CustomResourceDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4805,15 +6493,15 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4841,11 +6529,11 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = CustomResourceDefinitionConditionType(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4873,11 +6561,11 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4904,13 +6592,13 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Names.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4938,93 +6626,11 @@ func (m *CustomResourceDefinitionCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5051,13 +6657,16 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Validation == nil {
+				m.Validation = &CustomResourceValidation{}
+			}
+			if err := m.Validation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subresources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5084,66 +6693,18 @@ func (m *CustomResourceDefinitionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CustomResourceDefinition{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Subresources == nil {
+				m.Subresources = &CustomResourceSubresources{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Subresources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionNames: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionNames: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plural", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5153,29 +6714,31 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plural = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, CustomResourceDefinitionVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Singular", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5185,29 +6748,31 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Singular = string(dAtA[iNdEx:postIndex])
+			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
+			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5217,29 +6782,54 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortNames = append(m.ShortNames, string(dAtA[iNdEx:postIndex]))
+			if m.Conversion == nil {
+				m.Conversion = &CustomResourceConversion{}
+			}
+			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveUnknownFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.PreserveUnknownFields = &b
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5249,29 +6839,33 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.Limits == nil {
+				m.Limits = &CustomResourceLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5281,29 +6875,33 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ListKind = string(dAtA[iNdEx:postIndex])
+			if m.Retention == nil {
+				m.Retention = &CustomResourceRetention{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5313,23 +6911,27 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Categories = append(m.Categories, string(dAtA[iNdEx:postIndex]))
+			if m.History == nil {
+				m.History = &CustomResourceHistory{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5352,7 +6954,7 @@ func (m *CustomResourceDefinitionNames) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5375,17 +6977,17 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceDefinitionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceDefinitionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5395,29 +6997,31 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, CustomResourceDefinitionCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedNames", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5427,29 +7031,30 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.AcceptedNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredVersions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5459,28 +7064,77 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Names.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StoredVersions = append(m.StoredVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceDefinitionVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceDefinitionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5508,11 +7162,51 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Served = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Storage = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5539,14 +7233,14 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Validation == nil {
-				m.Validation = &CustomResourceValidation{}
+			if m.Schema == nil {
+				m.Schema = &CustomResourceValidation{}
 			}
-			if err := m.Validation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Subresources", wireType)
 			}
@@ -5582,9 +7276,9 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5611,16 +7305,36 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, CustomResourceDefinitionVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
+			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecationWarning", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5630,29 +7344,28 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
-			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DeprecationWarning = &s
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5679,34 +7392,13 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Conversion == nil {
-				m.Conversion = &CustomResourceConversion{}
+			if m.Generation == nil {
+				m.Generation = &CustomResourceGeneration{}
 			}
-			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Generation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveUnknownFields", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.PreserveUnknownFields = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5728,7 +7420,7 @@ func (m *CustomResourceDefinitionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceGeneration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5751,17 +7443,17 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceGeneration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceGeneration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5771,62 +7463,27 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, CustomResourceDefinitionCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedNames", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AcceptedNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredPaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5854,7 +7511,7 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredVersions = append(m.StoredVersions, string(dAtA[iNdEx:postIndex]))
+			m.IgnoredPaths = append(m.IgnoredPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5877,7 +7534,7 @@ func (m *CustomResourceDefinitionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5900,17 +7557,17 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceDefinitionVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceDefinitionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRevisions", wireType)
 			}
-			var stringLen uint64
+			m.MaxRevisions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5920,49 +7577,66 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRevisions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Served", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Served = bool(v != 0)
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjects", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5972,17 +7646,17 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Storage = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			m.MaxObjects = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjectsPerNamespace", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5991,34 +7665,18 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Schema == nil {
-				m.Schema = &CustomResourceValidation{}
-			}
-			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subresources", wireType)
+			m.MaxObjectsPerNamespace = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjectSizeBytes", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6028,33 +7686,67 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.MaxObjectSizeBytes = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Subresources == nil {
-				m.Subresources = &CustomResourceSubresources{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Subresources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPrinterColumns", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSeconds", wireType)
+			}
+			m.TTLSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6064,31 +7756,66 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TTLSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalPrinterColumns = append(m.AdditionalPrinterColumns, CustomResourceColumnDefinition{})
-			if err := m.AdditionalPrinterColumns[len(m.AdditionalPrinterColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceSubresourceCustom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceSubresourceCustom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceSubresourceCustom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6098,15 +7825,27 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Deprecated = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecationWarning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6134,8 +7873,7 @@ func (m *CustomResourceDefinitionVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.DeprecationWarning = &s
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6416,13 +8154,133 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 			if m.Status == nil {
 				m.Status = &CustomResourceSubresourceStatus{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scale == nil {
+				m.Scale = &CustomResourceSubresourceScale{}
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Custom = append(m.Custom, CustomResourceSubresourceCustom{})
+			if err := m.Custom[len(m.Custom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomResourceValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomResourceValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenAPIV3Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6449,10 +8307,10 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scale == nil {
-				m.Scale = &CustomResourceSubresourceScale{}
+			if m.OpenAPIV3Schema == nil {
+				m.OpenAPIV3Schema = &JSONSchemaProps{}
 			}
-			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenAPIV3Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6477,7 +8335,7 @@ func (m *CustomResourceSubresources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
+func (m *EmbeddedResourceKind) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6500,17 +8358,17 @@ func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomResourceValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmbeddedResourceKind: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomResourceValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmbeddedResourceKind: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenAPIV3Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6520,27 +8378,55 @@ func (m *CustomResourceValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpenAPIV3Schema == nil {
-				m.OpenAPIV3Schema = &JSONSchemaProps{}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if err := m.OpenAPIV3Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6761,6 +8647,88 @@ func (m *JSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JSONSchemaPropertyNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONSchemaPropertyNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONSchemaPropertyNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			*m = append(*m, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7401,9 +9369,111 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOf = append(m.AllOf, JSONSchemaProps{})
+			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneOf = append(m.OneOf, JSONSchemaProps{})
+			if err := m.OneOf[len(m.OneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyOf = append(m.AnyOf, JSONSchemaProps{})
+			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Not", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7430,14 +9500,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllOf = append(m.AllOf, JSONSchemaProps{})
-			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Not == nil {
+				m.Not = &JSONSchemaProps{}
+			}
+			if err := m.Not.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OneOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7464,14 +9536,109 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OneOf = append(m.OneOf, JSONSchemaProps{})
-			if err := m.OneOf[len(m.OneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Properties == nil {
+				m.Properties = make(map[string]JSONSchemaProps)
+			}
+			var mapkey string
+			mapvalue := &JSONSchemaProps{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JSONSchemaProps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Properties[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 27:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalProperties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7498,14 +9665,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnyOf = append(m.AnyOf, JSONSchemaProps{})
-			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AdditionalProperties == nil {
+				m.AdditionalProperties = &JSONSchemaPropsOrBool{}
+			}
+			if err := m.AdditionalProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Not", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatternProperties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7532,16 +9701,109 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Not == nil {
-				m.Not = &JSONSchemaProps{}
+			if m.PatternProperties == nil {
+				m.PatternProperties = make(map[string]JSONSchemaProps)
 			}
-			if err := m.Not.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &JSONSchemaProps{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JSONSchemaProps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.PatternProperties[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 29:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7568,11 +9830,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Properties == nil {
-				m.Properties = make(map[string]JSONSchemaProps)
+			if m.Dependencies == nil {
+				m.Dependencies = make(JSONSchemaDependencies)
 			}
 			var mapkey string
-			mapvalue := &JSONSchemaProps{}
+			mapvalue := &JSONSchemaPropsOrStringArray{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7646,7 +9908,7 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &JSONSchemaProps{}
+					mapvalue = &JSONSchemaPropsOrStringArray{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7666,11 +9928,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Properties[mapkey] = *mapvalue
+			m.Dependencies[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 30:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7697,16 +9959,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalProperties == nil {
-				m.AdditionalProperties = &JSONSchemaPropsOrBool{}
+			if m.AdditionalItems == nil {
+				m.AdditionalItems = &JSONSchemaPropsOrBool{}
 			}
-			if err := m.AdditionalProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdditionalItems.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatternProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7733,8 +9995,8 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PatternProperties == nil {
-				m.PatternProperties = make(map[string]JSONSchemaProps)
+			if m.Definitions == nil {
+				m.Definitions = make(JSONSchemaDefinitions)
 			}
 			var mapkey string
 			mapvalue := &JSONSchemaProps{}
@@ -7831,11 +10093,296 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.PatternProperties[mapkey] = *mapvalue
+			m.Definitions[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalDocs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalDocs == nil {
+				m.ExternalDocs = &ExternalDocumentation{}
+			}
+			if err := m.ExternalDocs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Example", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Example == nil {
+				m.Example = &JSON{}
+			}
+			if err := m.Example.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nullable = bool(v != 0)
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XPreserveUnknownFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.XPreserveUnknownFields = &b
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XEmbeddedResource = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XIntOrString", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XIntOrString = bool(v != 0)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XListMapKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XListMapKeys = append(m.XListMapKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XListType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XListType = &s
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XMapType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XMapType = &s
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XValidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XValidations = append(m.XValidations, ValidationRule{})
+			if err := m.XValidations[len(m.XValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 32:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Const", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7862,109 +10409,52 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dependencies == nil {
-				m.Dependencies = make(JSONSchemaDependencies)
+			if m.Const == nil {
+				m.Const = &JSON{}
 			}
-			var mapkey string
-			mapvalue := &JSONSchemaPropsOrStringArray{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Const.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field If", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &JSONSchemaPropsOrStringArray{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Dependencies[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.If == nil {
+				m.If = &JSONSchemaProps{}
+			}
+			if err := m.If.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Then", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7991,16 +10481,16 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalItems == nil {
-				m.AdditionalItems = &JSONSchemaPropsOrBool{}
+			if m.Then == nil {
+				m.Then = &JSONSchemaProps{}
 			}
-			if err := m.AdditionalItems.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Then.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Else", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8027,11 +10517,47 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Definitions == nil {
-				m.Definitions = make(JSONSchemaDefinitions)
+			if m.Else == nil {
+				m.Else = &JSONSchemaProps{}
+			}
+			if err := m.Else.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentRequired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DependentRequired == nil {
+				m.DependentRequired = make(JSONSchemaDependentRequired)
 			}
 			var mapkey string
-			mapvalue := &JSONSchemaProps{}
+			mapvalue := &JSONSchemaPropertyNames{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -8105,7 +10631,7 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &JSONSchemaProps{}
+					mapvalue = &JSONSchemaPropertyNames{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -8125,11 +10651,11 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Definitions[mapkey] = *mapvalue
+			m.DependentRequired[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 35:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalDocs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyNames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8156,18 +10682,18 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalDocs == nil {
-				m.ExternalDocs = &ExternalDocumentation{}
+			if m.PropertyNames == nil {
+				m.PropertyNames = &JSONSchemaProps{}
 			}
-			if err := m.ExternalDocs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PropertyNames.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Example", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XValueType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8177,53 +10703,30 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Example == nil {
-				m.Example = &JSON{}
-			}
-			if err := m.Example.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Nullable = bool(v != 0)
-		case 38:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XPreserveUnknownFields", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XValueType = &s
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XNormalize", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8233,18 +10736,29 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.XPreserveUnknownFields = &b
-		case 39:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResource", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XNormalize = append(m.XNormalize, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XDeprecated", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8254,15 +10768,28 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.XEmbeddedResource = bool(v != 0)
-		case 40:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.XDeprecated = &s
+			iNdEx = postIndex
+		case 54:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XIntOrString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XSensitive", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -8279,10 +10806,10 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.XIntOrString = bool(v != 0)
-		case 41:
+			m.XSensitive = bool(v != 0)
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XListMapKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XWritePermission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8310,11 +10837,12 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XListMapKeys = append(m.XListMapKeys, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.XWritePermission = &s
 			iNdEx = postIndex
-		case 42:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XListType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XConditions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8343,13 +10871,13 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.XListType = &s
+			m.XConditions = &s
 			iNdEx = postIndex
-		case 43:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XMapType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XEmbeddedResourceKinds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8359,28 +10887,29 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.XMapType = &s
+			m.XEmbeddedResourceKinds = append(m.XEmbeddedResourceKinds, EmbeddedResourceKind{})
+			if err := m.XEmbeddedResourceKinds[len(m.XEmbeddedResourceKinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 44:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XValidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XReference", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8407,8 +10936,10 @@ func (m *JSONSchemaProps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XValidations = append(m.XValidations, ValidationRule{})
-			if err := m.XValidations[len(m.XValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.XReference == nil {
+				m.XReference = &ReferenceTarget{}
+			}
+			if err := m.XReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8777,6 +11308,184 @@ func (m *JSONSchemaPropsOrStringArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReferenceTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = ResourceScope(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = ReferencePolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ServiceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -99,6 +99,24 @@ type CustomResourceDefinitionSpec struct {
 	// See https://kubernetes.io/docs/tasks/access-kubernetes-api/custom-resources/custom-resource-definitions/#pruning-versus-preserving-unknown-fields for details.
 	// +optional
 	PreserveUnknownFields *bool `json:"preserveUnknownFields,omitempty" protobuf:"varint,10,opt,name=preserveUnknownFields"`
+
+	// limits restricts the number and the size of the custom resources.
+	// +optional
+	Limits *CustomResourceLimits `json:"limits,omitempty" protobuf:"bytes,11,opt,name=limits"`
+
+	// retention enables keeping deleted custom resources for a TTL. Retained custom resources are listed
+	// by name through the `deleted` subresource and recreated by a POST to the `restore` subresource.
+	// Finalizers and garbage collection are not affected: custom resources are retained once they are
+	// removed from storage.
+	// +optional
+	Retention *CustomResourceRetention `json:"retention,omitempty" protobuf:"bytes,12,opt,name=retention"`
+
+	// history enables keeping the last revisions of the spec of each custom resource, with the
+	// resourceVersion, time and field manager of the write that produced them. Revisions are listed and
+	// compared through the `history` subresource, and a POST to it restores the spec of a revision
+	// through an update of the custom resource.
+	// +optional
+	History *CustomResourceHistory `json:"history,omitempty" protobuf:"bytes,13,opt,name=history"`
 }
 
 // CustomResourceConversion describes how to convert different versions of a CR.
@@ -228,6 +246,10 @@ type CustomResourceDefinitionVersion struct {
 	// If no top-level or per-version columns are specified, a single column displaying the age of the custom resource is used.
 	// +optional
 	AdditionalPrinterColumns []CustomResourceColumnDefinition `json:"additionalPrinterColumns,omitempty" protobuf:"bytes,6,rep,name=additionalPrinterColumns"`
+	// generation specifies which changes of this version of the custom resource increment metadata.generation.
+	// By default, every change outside of metadata increments metadata.generation.
+	// +optional
+	Generation *CustomResourceGeneration `json:"generation,omitempty" protobuf:"bytes,9,opt,name=generation"`
 }
 
 // CustomResourceColumnDefinition specifies a column for server side printing.
@@ -446,6 +468,12 @@ type CustomResourceSubresources struct {
 	// scale indicates the custom resource should serve a `/scale` subresource that returns an `autoscaling/v1` Scale object.
 	// +optional
 	Scale *CustomResourceSubresourceScale `json:"scale,omitempty" protobuf:"bytes,2,opt,name=scale"`
+	// custom lists additional subresources the custom resource should serve, each changing the value at a JSON path.
+	// This allows granting permission to change parts of a custom resource without granting permission to update it.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Custom []CustomResourceSubresourceCustom `json:"custom,omitempty" protobuf:"bytes,3,rep,name=custom"`
 }
 
 // CustomResourceSubresourceStatus defines how to serve the status subresource for CustomResources.
@@ -455,6 +483,77 @@ type CustomResourceSubresources struct {
 // * PUT/POST/PATCH requests to the custom resource ignore changes to the status stanza
 type CustomResourceSubresourceStatus struct{}
 
+// CustomResourceSubresourceCustom defines a custom subresource for CustomResources. The subresource is
+// represented by a JSON path inside of a CustomResource. When set,
+// * exposes a /<name> subresource for the custom resource
+// * GET requests to the /<name> subresource return the custom resource object
+// * PUT/PATCH requests to the /<name> subresource take a custom resource object, and ignore changes to anything except the value at the JSON path
+// Requests to the custom resource primary endpoint are not affected.
+type CustomResourceSubresourceCustom struct {
+	// name is the name of the subresource, e.g. `approval`. It must be a lowercase RFC 1123 label,
+	// and must not be `status`, `scale`, `lint`, `deleted`, `restore` or `history`.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// jsonPath is the JSON path inside of a custom resource of the value changed by the subresource,
+	// e.g. `.spec.approval`. Only JSON paths without the array notation are allowed.
+	// Must not be a JSON path under `.metadata`.
+	JSONPath string `json:"jsonPath" protobuf:"bytes,2,opt,name=jsonPath"`
+}
+
+// CustomResourceGeneration specifies which changes of a custom resource increment metadata.generation.
+// At most one of paths and ignoredPaths may be set.
+type CustomResourceGeneration struct {
+	// paths lists simple JSON paths of the fields holding the desired state, e.g. `.spec`.
+	// Only changes within these fields increment metadata.generation.
+	// Paths must be in the dot notation and must not point into metadata. Fields whose names
+	// contain a dot cannot be addressed.
+	// +optional
+	// +listType=set
+	Paths []string `json:"paths,omitempty" protobuf:"bytes,1,rep,name=paths"`
+	// ignoredPaths lists simple JSON paths of fields whose changes do not increment metadata.generation,
+	// e.g. `.spec.cache`. Changes of all other fields outside of metadata increment metadata.generation.
+	// Paths must be in the dot notation and must not point into metadata. Fields whose names
+	// contain a dot cannot be addressed.
+	// +optional
+	// +listType=set
+	IgnoredPaths []string `json:"ignoredPaths,omitempty" protobuf:"bytes,2,rep,name=ignoredPaths"`
+}
+
+// CustomResourceLimits restricts the number and the size of custom resources. The limits are
+// enforced on create and update. Existing custom resources exceeding them are not affected.
+type CustomResourceLimits struct {
+	// maxObjects is the maximum number of custom resources in the cluster. Creates beyond it are forbidden.
+	// The limit is best-effort: creates are checked against a count maintained from the watch cache, which
+	// lags behind creates and deletes, so concurrent creates can exceed it by the number of creates in flight.
+	// +optional
+	MaxObjects *int64 `json:"maxObjects,omitempty" protobuf:"varint,1,opt,name=maxObjects"`
+	// maxObjectsPerNamespace is the maximum number of custom resources in a namespace. Creates beyond it are forbidden.
+	// Like maxObjects, the limit is best-effort and can be exceeded by concurrent creates. Only allowed for
+	// namespace-scoped resources.
+	// +optional
+	MaxObjectsPerNamespace *int64 `json:"maxObjectsPerNamespace,omitempty" protobuf:"varint,2,opt,name=maxObjectsPerNamespace"`
+	// maxObjectSizeBytes is the maximum size of the JSON serialization of a custom resource, measured on create
+	// and update before the server sets metadata like uid and creationTimestamp. Creates and updates of larger
+	// custom resources are forbidden. It is not the size in storage, which depends on the storage encoding.
+	// +optional
+	MaxObjectSizeBytes *int64 `json:"maxObjectSizeBytes,omitempty" protobuf:"varint,3,opt,name=maxObjectSizeBytes"`
+}
+
+// CustomResourceRetention describes how long deleted custom resources are retained.
+type CustomResourceRetention struct {
+	// ttlSeconds is how long deleted custom resources are retained before they are purged. Must be positive.
+	// When retention is disabled, the retained custom resources are kept until retention is enabled again
+	// or the CustomResourceDefinition is deleted.
+	TTLSeconds int64 `json:"ttlSeconds" protobuf:"varint,1,opt,name=ttlSeconds"`
+}
+
+// CustomResourceHistory describes how many revisions of the spec of each custom resource are kept.
+type CustomResourceHistory struct {
+	// maxRevisions is the maximum number of revisions kept for each custom resource, between 1 and 100.
+	// The oldest revisions are removed first. Revisions are removed with their custom resource; when
+	// history is disabled, they are kept until the CustomResourceDefinition is deleted.
+	MaxRevisions int32 `json:"maxRevisions" protobuf:"varint,1,opt,name=maxRevisions"`
+}
+
 // CustomResourceSubresourceScale defines how to serve the scale subresource for CustomResources.
 type CustomResourceSubresourceScale struct {
 	// specReplicasPath defines the JSON path inside of a custom resource that corresponds to Scale `spec.replicas`.
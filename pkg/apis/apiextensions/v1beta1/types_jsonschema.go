@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1beta1
 
+import "fmt"
+
 // JSONSchemaProps is a JSON-Schema following Specification Draft 4 (http://json-schema.org/).
 type JSONSchemaProps struct {
 	ID          string        `json:"id,omitempty" protobuf:"bytes,1,opt,name=id"`
@@ -87,6 +89,21 @@ type JSONSchemaProps struct {
 	Example              *JSON                      `json:"example,omitempty" protobuf:"bytes,36,opt,name=example"`
 	Nullable             bool                       `json:"nullable,omitempty" protobuf:"bytes,37,opt,name=nullable"`
 
+	// const restricts the value to be equal to the given JSON value.
+	Const *JSON `json:"const,omitempty" protobuf:"bytes,45,opt,name=const"`
+	// if, then and else specify a conditional validation: if the value is valid against the if schema,
+	// it must be valid against the then schema, otherwise against the else schema. then and else
+	// require if to be set.
+	If   *JSONSchemaProps `json:"if,omitempty" protobuf:"bytes,46,opt,name=if"`
+	Then *JSONSchemaProps `json:"then,omitempty" protobuf:"bytes,47,opt,name=then"`
+	Else *JSONSchemaProps `json:"else,omitempty" protobuf:"bytes,48,opt,name=else"`
+	// dependentRequired maps a property name to the properties that are required
+	// if the former is present in an object.
+	DependentRequired JSONSchemaDependentRequired `json:"dependentRequired,omitempty" protobuf:"bytes,49,opt,name=dependentRequired"`
+	// propertyNames is a schema every property name of an object must be valid against.
+	// Property names are strings, i.e. type must be empty or string.
+	PropertyNames *JSONSchemaProps `json:"propertyNames,omitempty" protobuf:"bytes,50,opt,name=propertyNames"`
+
 	// x-kubernetes-preserve-unknown-fields stops the API server
 	// decoding step from pruning fields which are not specified
 	// in the validation schema. This affects fields recursively,
@@ -169,6 +186,84 @@ type JSONSchemaProps struct {
 	// +listType=map
 	// +listMapKey=rule
 	XValidations ValidationRules `json:"x-kubernetes-validations,omitempty" patchStrategy:"merge" patchMergeKey:"rule" protobuf:"bytes,44,rep,name=xKubernetesValidations"`
+
+	// x-kubernetes-value-type marks a string or int-or-string value as a Kubernetes value type.
+	// The value is canonicalized on write, typed in validation rules and rendered in printer columns.
+	// Possible values are:
+	//
+	// 1) `quantity`: the value is a resource quantity, e.g. `500m` or `1Gi`. Validation
+	//      rules see the value as double.
+	// 2) `duration`: the value is a duration, e.g. `1h30m`. Integers are seconds. Validation
+	//      rules see the value as duration.
+	// +optional
+	XValueType *string `json:"x-kubernetes-value-type,omitempty" protobuf:"bytes,51,opt,name=xKubernetesValueType"`
+
+	// x-kubernetes-normalize lists normalizations applied to a string value on write, before
+	// validation. Possible values are:
+	//
+	// 1) `trim`: leading and trailing whitespace is removed.
+	// 2) `collapse-whitespace`: runs of whitespace are replaced by a single space.
+	// 3) `lowercase`: the value is converted to lower case.
+	// 4) `nfc`: the value is converted to Unicode normalization form C.
+	//
+	// Normalizations are applied in the order above, independently of the order they are listed in.
+	// This extension must only be used on strings.
+	// +optional
+	// +listType=set
+	XNormalize []string `json:"x-kubernetes-normalize,omitempty" protobuf:"bytes,52,rep,name=xKubernetesNormalize"`
+
+	// x-kubernetes-deprecated marks the field as deprecated. The value is a non-empty message
+	// returned as a warning to clients setting the field, on create or by changing its value on
+	// update, to a value other than its default, e.g. "spec.foo is deprecated, use spec.bar instead".
+	// +optional
+	XDeprecated *string `json:"x-kubernetes-deprecated,omitempty" protobuf:"bytes,53,opt,name=xKubernetesDeprecated"`
+
+	// x-kubernetes-sensitive marks the value of the field as sensitive, e.g. a token or a
+	// connection string. The value is replaced with a placeholder in validation errors, in
+	// printer columns and in errors of conversion webhooks. If the field is an object or an
+	// array, this applies to all values nested in it.
+	// +optional
+	XSensitive bool `json:"x-kubernetes-sensitive,omitempty" protobuf:"bytes,54,opt,name=xKubernetesSensitive"`
+
+	// x-kubernetes-write-permission names a subresource-style permission required to change the
+	// value of the field on update, e.g. `fields/tier`. Requesters changing the field must be
+	// authorized for the `update` verb on that subresource of the custom resource, e.g.
+	// `foos/fields/tier`. Other fields, and fields nested in the field with their own permission,
+	// are not affected.
+	// +optional
+	XWritePermission *string `json:"x-kubernetes-write-permission,omitempty" protobuf:"bytes,55,opt,name=xKubernetesWritePermission"`
+
+	// x-kubernetes-conditions marks a list as a list of conditions with the shape of the
+	// Kubernetes Condition type, keyed by `type`. On creates and updates, through the main resource
+	// as well as the status and custom subresources, the lastTransitionTime of a condition is set by
+	// the server whenever its status changes, and is kept otherwise. Possible values are:
+	//
+	// 1) `transitionTime`: only lastTransitionTime is set by the server.
+	// 2) `observedGeneration`: additionally, observedGeneration of all conditions is set to
+	//      metadata.generation on updates of the status subresource.
+	//
+	// This extension must only be used on lists with x-kubernetes-list-type `map` and
+	// x-kubernetes-list-map-keys `type`.
+	// +optional
+	XConditions *string `json:"x-kubernetes-conditions,omitempty" protobuf:"bytes,56,opt,name=xKubernetesConditions"`
+
+	// x-kubernetes-embedded-resource-kinds lists the kinds allowed for an embedded resource. It
+	// must only be used together with x-kubernetes-embedded-resource. The embedded object must be
+	// of one of the listed kinds, and is pruned, defaulted and validated against the schema of
+	// that kind, as if it had been submitted on its own. Schemas of custom resources are taken
+	// from their CustomResourceDefinitions, schemas of other kinds from the OpenAPI models of the
+	// server.
+	// +optional
+	// +listType=atomic
+	XEmbeddedResourceKinds []EmbeddedResourceKind `json:"x-kubernetes-embedded-resource-kinds,omitempty" protobuf:"bytes,57,rep,name=xKubernetesEmbeddedResourceKinds"`
+
+	// x-kubernetes-reference marks a string field as holding the name of another object, the
+	// referent, of the given group, resource and scope. Referents of namespaced resources are
+	// looked up in the namespace of the referring object. Depending on the policy, creates and
+	// updates setting the field to the name of a referent which does not exist are warned about
+	// or rejected. The relationship is published in OpenAPI.
+	// +optional
+	XReference *ReferenceTarget `json:"x-kubernetes-reference,omitempty" protobuf:"bytes,58,opt,name=xKubernetesReference"`
 }
 
 // ValidationRules describes a list of validation rules written in the CEL expression language.
@@ -321,9 +416,57 @@ func (_ JSONSchemaPropsOrStringArray) OpenAPISchemaType() []string {
 // the OpenAPI spec of this type.
 func (_ JSONSchemaPropsOrStringArray) OpenAPISchemaFormat() string { return "" }
 
+// JSONSchemaDependentRequired represents a dependentRequired property.
+type JSONSchemaDependentRequired map[string]JSONSchemaPropertyNames
+
+// JSONSchemaPropertyNames represents a list of property names.
+// +protobuf.nullable=true
+// +protobuf.options.(gogoproto.goproto_stringer)=false
+type JSONSchemaPropertyNames []string
+
+func (t JSONSchemaPropertyNames) String() string {
+	return fmt.Sprintf("%v", []string(t))
+}
+
 // JSONSchemaDefinitions contains the models explicitly defined in this spec.
 type JSONSchemaDefinitions map[string]JSONSchemaProps
 
+// EmbeddedResourceKind is a kind allowed for an embedded resource.
+type EmbeddedResourceKind struct {
+	// apiVersion is the group and version of the kind, e.g. `apps/v1`.
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// kind is the kind, e.g. `Deployment`.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+}
+
+// ReferenceTarget is the target of a reference field.
+type ReferenceTarget struct {
+	// group is the API group of the referent. The resource must be served by a
+	// CustomResourceDefinition, so the group contains a dot and is not a Kubernetes group.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// resource is the plural resource name of the referent.
+	Resource string `json:"resource" protobuf:"bytes,2,opt,name=resource"`
+	// scope is the scope of the resource of the referent, `Namespaced` or `Cluster`.
+	Scope ResourceScope `json:"scope" protobuf:"bytes,3,opt,name=scope,casttype=ResourceScope"`
+	// policy is the policy for references to objects which do not exist: `Ignore` (the
+	// default) does not look up the referent, `Warn` returns a warning and `Reject` rejects
+	// the request if the referent does not exist.
+	// +optional
+	Policy ReferencePolicy `json:"policy,omitempty" protobuf:"bytes,4,opt,name=policy,casttype=ReferencePolicy"`
+}
+
+// ReferencePolicy is the policy for references to objects which do not exist.
+type ReferencePolicy string
+
+const (
+	// ReferencePolicyIgnore means that the referent is not looked up.
+	ReferencePolicyIgnore ReferencePolicy = "Ignore"
+	// ReferencePolicyWarn means that a warning is returned if the referent does not exist.
+	ReferencePolicyWarn ReferencePolicy = "Warn"
+	// ReferencePolicyReject means that the request is rejected if the referent does not exist.
+	ReferencePolicyReject ReferencePolicy = "Reject"
+)
+
 // ExternalDocumentation allows referencing an external resource for extended documentation.
 type ExternalDocumentation struct {
 	Description string `json:"description,omitempty" protobuf:"bytes,1,opt,name=description"`
@@ -127,6 +127,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CustomResourceGeneration)(nil), (*apiextensions.CustomResourceGeneration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration(a.(*CustomResourceGeneration), b.(*apiextensions.CustomResourceGeneration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.CustomResourceGeneration)(nil), (*CustomResourceGeneration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_CustomResourceGeneration_To_v1beta1_CustomResourceGeneration(a.(*apiextensions.CustomResourceGeneration), b.(*CustomResourceGeneration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomResourceHistory)(nil), (*apiextensions.CustomResourceHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomResourceHistory_To_apiextensions_CustomResourceHistory(a.(*CustomResourceHistory), b.(*apiextensions.CustomResourceHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.CustomResourceHistory)(nil), (*CustomResourceHistory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_CustomResourceHistory_To_v1beta1_CustomResourceHistory(a.(*apiextensions.CustomResourceHistory), b.(*CustomResourceHistory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomResourceLimits)(nil), (*apiextensions.CustomResourceLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomResourceLimits_To_apiextensions_CustomResourceLimits(a.(*CustomResourceLimits), b.(*apiextensions.CustomResourceLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.CustomResourceLimits)(nil), (*CustomResourceLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_CustomResourceLimits_To_v1beta1_CustomResourceLimits(a.(*apiextensions.CustomResourceLimits), b.(*CustomResourceLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomResourceRetention)(nil), (*apiextensions.CustomResourceRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomResourceRetention_To_apiextensions_CustomResourceRetention(a.(*CustomResourceRetention), b.(*apiextensions.CustomResourceRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.CustomResourceRetention)(nil), (*CustomResourceRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_CustomResourceRetention_To_v1beta1_CustomResourceRetention(a.(*apiextensions.CustomResourceRetention), b.(*CustomResourceRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomResourceSubresourceCustom)(nil), (*apiextensions.CustomResourceSubresourceCustom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom(a.(*CustomResourceSubresourceCustom), b.(*apiextensions.CustomResourceSubresourceCustom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.CustomResourceSubresourceCustom)(nil), (*CustomResourceSubresourceCustom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_CustomResourceSubresourceCustom_To_v1beta1_CustomResourceSubresourceCustom(a.(*apiextensions.CustomResourceSubresourceCustom), b.(*CustomResourceSubresourceCustom), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CustomResourceSubresourceScale)(nil), (*apiextensions.CustomResourceSubresourceScale)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CustomResourceSubresourceScale_To_apiextensions_CustomResourceSubresourceScale(a.(*CustomResourceSubresourceScale), b.(*apiextensions.CustomResourceSubresourceScale), scope)
 	}); err != nil {
@@ -167,6 +217,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EmbeddedResourceKind)(nil), (*apiextensions.EmbeddedResourceKind)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EmbeddedResourceKind_To_apiextensions_EmbeddedResourceKind(a.(*EmbeddedResourceKind), b.(*apiextensions.EmbeddedResourceKind), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.EmbeddedResourceKind)(nil), (*EmbeddedResourceKind)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_EmbeddedResourceKind_To_v1beta1_EmbeddedResourceKind(a.(*apiextensions.EmbeddedResourceKind), b.(*EmbeddedResourceKind), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalDocumentation)(nil), (*apiextensions.ExternalDocumentation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExternalDocumentation_To_apiextensions_ExternalDocumentation(a.(*ExternalDocumentation), b.(*apiextensions.ExternalDocumentation), scope)
 	}); err != nil {
@@ -212,6 +272,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReferenceTarget)(nil), (*apiextensions.ReferenceTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ReferenceTarget_To_apiextensions_ReferenceTarget(a.(*ReferenceTarget), b.(*apiextensions.ReferenceTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apiextensions.ReferenceTarget)(nil), (*ReferenceTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apiextensions_ReferenceTarget_To_v1beta1_ReferenceTarget(a.(*apiextensions.ReferenceTarget), b.(*ReferenceTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServiceReference)(nil), (*apiextensions.ServiceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ServiceReference_To_apiextensions_ServiceReference(a.(*ServiceReference), b.(*apiextensions.ServiceReference), scope)
 	}); err != nil {
@@ -501,6 +571,9 @@ func autoConvert_v1beta1_CustomResourceDefinitionSpec_To_apiextensions_CustomRes
 		out.Conversion = nil
 	}
 	out.PreserveUnknownFields = (*bool)(unsafe.Pointer(in.PreserveUnknownFields))
+	out.Limits = (*apiextensions.CustomResourceLimits)(unsafe.Pointer(in.Limits))
+	out.Retention = (*apiextensions.CustomResourceRetention)(unsafe.Pointer(in.Retention))
+	out.History = (*apiextensions.CustomResourceHistory)(unsafe.Pointer(in.History))
 	return nil
 }
 
@@ -548,6 +621,9 @@ func autoConvert_apiextensions_CustomResourceDefinitionSpec_To_v1beta1_CustomRes
 		out.Conversion = nil
 	}
 	out.PreserveUnknownFields = (*bool)(unsafe.Pointer(in.PreserveUnknownFields))
+	out.Limits = (*CustomResourceLimits)(unsafe.Pointer(in.Limits))
+	out.Retention = (*CustomResourceRetention)(unsafe.Pointer(in.Retention))
+	out.History = (*CustomResourceHistory)(unsafe.Pointer(in.History))
 	return nil
 }
 
@@ -601,6 +677,7 @@ func autoConvert_v1beta1_CustomResourceDefinitionVersion_To_apiextensions_Custom
 	}
 	out.Subresources = (*apiextensions.CustomResourceSubresources)(unsafe.Pointer(in.Subresources))
 	out.AdditionalPrinterColumns = *(*[]apiextensions.CustomResourceColumnDefinition)(unsafe.Pointer(&in.AdditionalPrinterColumns))
+	out.Generation = (*apiextensions.CustomResourceGeneration)(unsafe.Pointer(in.Generation))
 	return nil
 }
 
@@ -626,6 +703,7 @@ func autoConvert_apiextensions_CustomResourceDefinitionVersion_To_v1beta1_Custom
 	}
 	out.Subresources = (*CustomResourceSubresources)(unsafe.Pointer(in.Subresources))
 	out.AdditionalPrinterColumns = *(*[]CustomResourceColumnDefinition)(unsafe.Pointer(&in.AdditionalPrinterColumns))
+	out.Generation = (*CustomResourceGeneration)(unsafe.Pointer(in.Generation))
 	return nil
 }
 
@@ -634,6 +712,114 @@ func Convert_apiextensions_CustomResourceDefinitionVersion_To_v1beta1_CustomReso
 	return autoConvert_apiextensions_CustomResourceDefinitionVersion_To_v1beta1_CustomResourceDefinitionVersion(in, out, s)
 }
 
+func autoConvert_v1beta1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration(in *CustomResourceGeneration, out *apiextensions.CustomResourceGeneration, s conversion.Scope) error {
+	out.Paths = *(*[]string)(unsafe.Pointer(&in.Paths))
+	out.IgnoredPaths = *(*[]string)(unsafe.Pointer(&in.IgnoredPaths))
+	return nil
+}
+
+// Convert_v1beta1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration is an autogenerated conversion function.
+func Convert_v1beta1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration(in *CustomResourceGeneration, out *apiextensions.CustomResourceGeneration, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration(in, out, s)
+}
+
+func autoConvert_apiextensions_CustomResourceGeneration_To_v1beta1_CustomResourceGeneration(in *apiextensions.CustomResourceGeneration, out *CustomResourceGeneration, s conversion.Scope) error {
+	out.Paths = *(*[]string)(unsafe.Pointer(&in.Paths))
+	out.IgnoredPaths = *(*[]string)(unsafe.Pointer(&in.IgnoredPaths))
+	return nil
+}
+
+// Convert_apiextensions_CustomResourceGeneration_To_v1beta1_CustomResourceGeneration is an autogenerated conversion function.
+func Convert_apiextensions_CustomResourceGeneration_To_v1beta1_CustomResourceGeneration(in *apiextensions.CustomResourceGeneration, out *CustomResourceGeneration, s conversion.Scope) error {
+	return autoConvert_apiextensions_CustomResourceGeneration_To_v1beta1_CustomResourceGeneration(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomResourceHistory_To_apiextensions_CustomResourceHistory(in *CustomResourceHistory, out *apiextensions.CustomResourceHistory, s conversion.Scope) error {
+	out.MaxRevisions = in.MaxRevisions
+	return nil
+}
+
+// Convert_v1beta1_CustomResourceHistory_To_apiextensions_CustomResourceHistory is an autogenerated conversion function.
+func Convert_v1beta1_CustomResourceHistory_To_apiextensions_CustomResourceHistory(in *CustomResourceHistory, out *apiextensions.CustomResourceHistory, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomResourceHistory_To_apiextensions_CustomResourceHistory(in, out, s)
+}
+
+func autoConvert_apiextensions_CustomResourceHistory_To_v1beta1_CustomResourceHistory(in *apiextensions.CustomResourceHistory, out *CustomResourceHistory, s conversion.Scope) error {
+	out.MaxRevisions = in.MaxRevisions
+	return nil
+}
+
+// Convert_apiextensions_CustomResourceHistory_To_v1beta1_CustomResourceHistory is an autogenerated conversion function.
+func Convert_apiextensions_CustomResourceHistory_To_v1beta1_CustomResourceHistory(in *apiextensions.CustomResourceHistory, out *CustomResourceHistory, s conversion.Scope) error {
+	return autoConvert_apiextensions_CustomResourceHistory_To_v1beta1_CustomResourceHistory(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomResourceLimits_To_apiextensions_CustomResourceLimits(in *CustomResourceLimits, out *apiextensions.CustomResourceLimits, s conversion.Scope) error {
+	out.MaxObjects = (*int64)(unsafe.Pointer(in.MaxObjects))
+	out.MaxObjectsPerNamespace = (*int64)(unsafe.Pointer(in.MaxObjectsPerNamespace))
+	out.MaxObjectSizeBytes = (*int64)(unsafe.Pointer(in.MaxObjectSizeBytes))
+	return nil
+}
+
+// Convert_v1beta1_CustomResourceLimits_To_apiextensions_CustomResourceLimits is an autogenerated conversion function.
+func Convert_v1beta1_CustomResourceLimits_To_apiextensions_CustomResourceLimits(in *CustomResourceLimits, out *apiextensions.CustomResourceLimits, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomResourceLimits_To_apiextensions_CustomResourceLimits(in, out, s)
+}
+
+func autoConvert_apiextensions_CustomResourceLimits_To_v1beta1_CustomResourceLimits(in *apiextensions.CustomResourceLimits, out *CustomResourceLimits, s conversion.Scope) error {
+	out.MaxObjects = (*int64)(unsafe.Pointer(in.MaxObjects))
+	out.MaxObjectsPerNamespace = (*int64)(unsafe.Pointer(in.MaxObjectsPerNamespace))
+	out.MaxObjectSizeBytes = (*int64)(unsafe.Pointer(in.MaxObjectSizeBytes))
+	return nil
+}
+
+// Convert_apiextensions_CustomResourceLimits_To_v1beta1_CustomResourceLimits is an autogenerated conversion function.
+func Convert_apiextensions_CustomResourceLimits_To_v1beta1_CustomResourceLimits(in *apiextensions.CustomResourceLimits, out *CustomResourceLimits, s conversion.Scope) error {
+	return autoConvert_apiextensions_CustomResourceLimits_To_v1beta1_CustomResourceLimits(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomResourceRetention_To_apiextensions_CustomResourceRetention(in *CustomResourceRetention, out *apiextensions.CustomResourceRetention, s conversion.Scope) error {
+	out.TTLSeconds = in.TTLSeconds
+	return nil
+}
+
+// Convert_v1beta1_CustomResourceRetention_To_apiextensions_CustomResourceRetention is an autogenerated conversion function.
+func Convert_v1beta1_CustomResourceRetention_To_apiextensions_CustomResourceRetention(in *CustomResourceRetention, out *apiextensions.CustomResourceRetention, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomResourceRetention_To_apiextensions_CustomResourceRetention(in, out, s)
+}
+
+func autoConvert_apiextensions_CustomResourceRetention_To_v1beta1_CustomResourceRetention(in *apiextensions.CustomResourceRetention, out *CustomResourceRetention, s conversion.Scope) error {
+	out.TTLSeconds = in.TTLSeconds
+	return nil
+}
+
+// Convert_apiextensions_CustomResourceRetention_To_v1beta1_CustomResourceRetention is an autogenerated conversion function.
+func Convert_apiextensions_CustomResourceRetention_To_v1beta1_CustomResourceRetention(in *apiextensions.CustomResourceRetention, out *CustomResourceRetention, s conversion.Scope) error {
+	return autoConvert_apiextensions_CustomResourceRetention_To_v1beta1_CustomResourceRetention(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom(in *CustomResourceSubresourceCustom, out *apiextensions.CustomResourceSubresourceCustom, s conversion.Scope) error {
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	return nil
+}
+
+// Convert_v1beta1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom is an autogenerated conversion function.
+func Convert_v1beta1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom(in *CustomResourceSubresourceCustom, out *apiextensions.CustomResourceSubresourceCustom, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom(in, out, s)
+}
+
+func autoConvert_apiextensions_CustomResourceSubresourceCustom_To_v1beta1_CustomResourceSubresourceCustom(in *apiextensions.CustomResourceSubresourceCustom, out *CustomResourceSubresourceCustom, s conversion.Scope) error {
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	return nil
+}
+
+// Convert_apiextensions_CustomResourceSubresourceCustom_To_v1beta1_CustomResourceSubresourceCustom is an autogenerated conversion function.
+func Convert_apiextensions_CustomResourceSubresourceCustom_To_v1beta1_CustomResourceSubresourceCustom(in *apiextensions.CustomResourceSubresourceCustom, out *CustomResourceSubresourceCustom, s conversion.Scope) error {
+	return autoConvert_apiextensions_CustomResourceSubresourceCustom_To_v1beta1_CustomResourceSubresourceCustom(in, out, s)
+}
+
 func autoConvert_v1beta1_CustomResourceSubresourceScale_To_apiextensions_CustomResourceSubresourceScale(in *CustomResourceSubresourceScale, out *apiextensions.CustomResourceSubresourceScale, s conversion.Scope) error {
 	out.SpecReplicasPath = in.SpecReplicasPath
 	out.StatusReplicasPath = in.StatusReplicasPath
@@ -679,6 +865,7 @@ func Convert_apiextensions_CustomResourceSubresourceStatus_To_v1beta1_CustomReso
 func autoConvert_v1beta1_CustomResourceSubresources_To_apiextensions_CustomResourceSubresources(in *CustomResourceSubresources, out *apiextensions.CustomResourceSubresources, s conversion.Scope) error {
 	out.Status = (*apiextensions.CustomResourceSubresourceStatus)(unsafe.Pointer(in.Status))
 	out.Scale = (*apiextensions.CustomResourceSubresourceScale)(unsafe.Pointer(in.Scale))
+	out.Custom = *(*[]apiextensions.CustomResourceSubresourceCustom)(unsafe.Pointer(&in.Custom))
 	return nil
 }
 
@@ -690,6 +877,7 @@ func Convert_v1beta1_CustomResourceSubresources_To_apiextensions_CustomResourceS
 func autoConvert_apiextensions_CustomResourceSubresources_To_v1beta1_CustomResourceSubresources(in *apiextensions.CustomResourceSubresources, out *CustomResourceSubresources, s conversion.Scope) error {
 	out.Status = (*CustomResourceSubresourceStatus)(unsafe.Pointer(in.Status))
 	out.Scale = (*CustomResourceSubresourceScale)(unsafe.Pointer(in.Scale))
+	out.Custom = *(*[]CustomResourceSubresourceCustom)(unsafe.Pointer(&in.Custom))
 	return nil
 }
 
@@ -734,6 +922,28 @@ func Convert_apiextensions_CustomResourceValidation_To_v1beta1_CustomResourceVal
 	return autoConvert_apiextensions_CustomResourceValidation_To_v1beta1_CustomResourceValidation(in, out, s)
 }
 
+func autoConvert_v1beta1_EmbeddedResourceKind_To_apiextensions_EmbeddedResourceKind(in *EmbeddedResourceKind, out *apiextensions.EmbeddedResourceKind, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_v1beta1_EmbeddedResourceKind_To_apiextensions_EmbeddedResourceKind is an autogenerated conversion function.
+func Convert_v1beta1_EmbeddedResourceKind_To_apiextensions_EmbeddedResourceKind(in *EmbeddedResourceKind, out *apiextensions.EmbeddedResourceKind, s conversion.Scope) error {
+	return autoConvert_v1beta1_EmbeddedResourceKind_To_apiextensions_EmbeddedResourceKind(in, out, s)
+}
+
+func autoConvert_apiextensions_EmbeddedResourceKind_To_v1beta1_EmbeddedResourceKind(in *apiextensions.EmbeddedResourceKind, out *EmbeddedResourceKind, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_apiextensions_EmbeddedResourceKind_To_v1beta1_EmbeddedResourceKind is an autogenerated conversion function.
+func Convert_apiextensions_EmbeddedResourceKind_To_v1beta1_EmbeddedResourceKind(in *apiextensions.EmbeddedResourceKind, out *EmbeddedResourceKind, s conversion.Scope) error {
+	return autoConvert_apiextensions_EmbeddedResourceKind_To_v1beta1_EmbeddedResourceKind(in, out, s)
+}
+
 func autoConvert_v1beta1_ExternalDocumentation_To_apiextensions_ExternalDocumentation(in *ExternalDocumentation, out *apiextensions.ExternalDocumentation, s conversion.Scope) error {
 	out.Description = in.Description
 	out.URL = in.URL
@@ -940,6 +1150,52 @@ func autoConvert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(in *JS
 		out.Example = nil
 	}
 	out.Nullable = in.Nullable
+	if in.Const != nil {
+		in, out := &in.Const, &out.Const
+		*out = new(apiextensions.JSON)
+		if err := Convert_v1beta1_JSON_To_apiextensions_JSON(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Const = nil
+	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(apiextensions.JSONSchemaProps)
+		if err := Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.If = nil
+	}
+	if in.Then != nil {
+		in, out := &in.Then, &out.Then
+		*out = new(apiextensions.JSONSchemaProps)
+		if err := Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Then = nil
+	}
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		*out = new(apiextensions.JSONSchemaProps)
+		if err := Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Else = nil
+	}
+	out.DependentRequired = *(*apiextensions.JSONSchemaDependentRequired)(unsafe.Pointer(&in.DependentRequired))
+	if in.PropertyNames != nil {
+		in, out := &in.PropertyNames, &out.PropertyNames
+		*out = new(apiextensions.JSONSchemaProps)
+		if err := Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PropertyNames = nil
+	}
 	out.XPreserveUnknownFields = (*bool)(unsafe.Pointer(in.XPreserveUnknownFields))
 	out.XEmbeddedResource = in.XEmbeddedResource
 	out.XIntOrString = in.XIntOrString
@@ -947,6 +1203,14 @@ func autoConvert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(in *JS
 	out.XListType = (*string)(unsafe.Pointer(in.XListType))
 	out.XMapType = (*string)(unsafe.Pointer(in.XMapType))
 	out.XValidations = *(*apiextensions.ValidationRules)(unsafe.Pointer(&in.XValidations))
+	out.XValueType = (*string)(unsafe.Pointer(in.XValueType))
+	out.XNormalize = *(*[]string)(unsafe.Pointer(&in.XNormalize))
+	out.XDeprecated = (*string)(unsafe.Pointer(in.XDeprecated))
+	out.XSensitive = in.XSensitive
+	out.XWritePermission = (*string)(unsafe.Pointer(in.XWritePermission))
+	out.XConditions = (*string)(unsafe.Pointer(in.XConditions))
+	out.XEmbeddedResourceKinds = *(*[]apiextensions.EmbeddedResourceKind)(unsafe.Pointer(&in.XEmbeddedResourceKinds))
+	out.XReference = (*apiextensions.ReferenceTarget)(unsafe.Pointer(in.XReference))
 	return nil
 }
 
@@ -1129,6 +1393,52 @@ func autoConvert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(in *ap
 	} else {
 		out.Example = nil
 	}
+	if in.Const != nil {
+		in, out := &in.Const, &out.Const
+		*out = new(JSON)
+		if err := Convert_apiextensions_JSON_To_v1beta1_JSON(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Const = nil
+	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(JSONSchemaProps)
+		if err := Convert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.If = nil
+	}
+	if in.Then != nil {
+		in, out := &in.Then, &out.Then
+		*out = new(JSONSchemaProps)
+		if err := Convert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Then = nil
+	}
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		*out = new(JSONSchemaProps)
+		if err := Convert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Else = nil
+	}
+	out.DependentRequired = *(*JSONSchemaDependentRequired)(unsafe.Pointer(&in.DependentRequired))
+	if in.PropertyNames != nil {
+		in, out := &in.PropertyNames, &out.PropertyNames
+		*out = new(JSONSchemaProps)
+		if err := Convert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PropertyNames = nil
+	}
 	out.XPreserveUnknownFields = (*bool)(unsafe.Pointer(in.XPreserveUnknownFields))
 	out.XEmbeddedResource = in.XEmbeddedResource
 	out.XIntOrString = in.XIntOrString
@@ -1136,6 +1446,14 @@ func autoConvert_apiextensions_JSONSchemaProps_To_v1beta1_JSONSchemaProps(in *ap
 	out.XListType = (*string)(unsafe.Pointer(in.XListType))
 	out.XMapType = (*string)(unsafe.Pointer(in.XMapType))
 	out.XValidations = *(*ValidationRules)(unsafe.Pointer(&in.XValidations))
+	out.XValueType = (*string)(unsafe.Pointer(in.XValueType))
+	out.XNormalize = *(*[]string)(unsafe.Pointer(&in.XNormalize))
+	out.XDeprecated = (*string)(unsafe.Pointer(in.XDeprecated))
+	out.XSensitive = in.XSensitive
+	out.XWritePermission = (*string)(unsafe.Pointer(in.XWritePermission))
+	out.XConditions = (*string)(unsafe.Pointer(in.XConditions))
+	out.XEmbeddedResourceKinds = *(*[]EmbeddedResourceKind)(unsafe.Pointer(&in.XEmbeddedResourceKinds))
+	out.XReference = (*ReferenceTarget)(unsafe.Pointer(in.XReference))
 	return nil
 }
 
@@ -1273,6 +1591,32 @@ func Convert_apiextensions_JSONSchemaPropsOrStringArray_To_v1beta1_JSONSchemaPro
 	return autoConvert_apiextensions_JSONSchemaPropsOrStringArray_To_v1beta1_JSONSchemaPropsOrStringArray(in, out, s)
 }
 
+func autoConvert_v1beta1_ReferenceTarget_To_apiextensions_ReferenceTarget(in *ReferenceTarget, out *apiextensions.ReferenceTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resource = in.Resource
+	out.Scope = apiextensions.ResourceScope(in.Scope)
+	out.Policy = apiextensions.ReferencePolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1beta1_ReferenceTarget_To_apiextensions_ReferenceTarget is an autogenerated conversion function.
+func Convert_v1beta1_ReferenceTarget_To_apiextensions_ReferenceTarget(in *ReferenceTarget, out *apiextensions.ReferenceTarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReferenceTarget_To_apiextensions_ReferenceTarget(in, out, s)
+}
+
+func autoConvert_apiextensions_ReferenceTarget_To_v1beta1_ReferenceTarget(in *apiextensions.ReferenceTarget, out *ReferenceTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resource = in.Resource
+	out.Scope = ResourceScope(in.Scope)
+	out.Policy = ReferencePolicy(in.Policy)
+	return nil
+}
+
+// Convert_apiextensions_ReferenceTarget_To_v1beta1_ReferenceTarget is an autogenerated conversion function.
+func Convert_apiextensions_ReferenceTarget_To_v1beta1_ReferenceTarget(in *apiextensions.ReferenceTarget, out *ReferenceTarget, s conversion.Scope) error {
+	return autoConvert_apiextensions_ReferenceTarget_To_v1beta1_ReferenceTarget(in, out, s)
+}
+
 func autoConvert_v1beta1_ServiceReference_To_apiextensions_ServiceReference(in *ServiceReference, out *apiextensions.ServiceReference, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.Name = in.Name
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -29,6 +30,8 @@ import (
 	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	genericvalidation "k8s.io/apimachinery/pkg/api/validation"
+	pathvalidation "k8s.io/apimachinery/pkg/api/validation/path"
+	runtimeschema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -45,8 +48,15 @@ var (
 	printerColumnDatatypes                = sets.NewString("integer", "number", "string", "boolean", "date")
 	customResourceColumnDefinitionFormats = sets.NewString("int32", "int64", "float", "double", "byte", "date", "date-time", "password")
 	openapiV3Types                        = sets.NewString("string", "number", "integer", "boolean", "array", "object")
+	valueTypes                            = sets.NewString("quantity", "duration")
+	reservedSubresources                  = sets.NewString("status", "scale", "lint", "deleted", "restore", "history")
+	conditionsTypes                       = sets.NewString("transitionTime", "observedGeneration")
 )
 
+// maxHistoryRevisions bounds the revisions kept for each custom resource, which are all read on
+// every write.
+const maxHistoryRevisions = 100
+
 // ValidateCustomResourceDefinition statically validates
 func ValidateCustomResourceDefinition(obj *apiextensions.CustomResourceDefinition) field.ErrorList {
 	nameValidationFn := func(name string, prefix bool) []string {
@@ -103,6 +113,10 @@ type validationOptions struct {
 	// 1. For x-kubernetes-list-type=map list, key fields are not nullable, and are required or have a default
 	// 2. For x-kubernetes-list-type=map or x-kubernetes-list-type=set list, the whole item must not be nullable.
 	requireMapListKeysMapSetValidation bool
+
+	// schemaDefinitions holds the definitions at the root of the schema being validated, which
+	// are needed to compile validation rules of schemas containing $ref.
+	schemaDefinitions apiextensions.JSONSchemaDefinitions
 }
 
 // ValidateCustomResourceDefinitionUpdate statically validates
@@ -271,6 +285,7 @@ func validateCustomResourceDefinitionSpec(spec *apiextensions.CustomResourceDefi
 		}
 		subresources := getSubresourcesForVersion(spec, version.Name)
 		allErrs = append(allErrs, validateCustomResourceDefinitionVersion(&version, fldPath.Child("versions").Index(i), hasStatusEnabled(subresources), opts)...)
+		allErrs = append(allErrs, validateCustomResourceGeneration(version.Generation, getSchemaForVersion(spec, version.Name), fldPath.Child("versions").Index(i).Child("generation"))...)
 	}
 
 	// The top-level and per-version fields are mutual exclusive
@@ -338,6 +353,9 @@ func validateCustomResourceDefinitionSpec(spec *apiextensions.CustomResourceDefi
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("conversion").Child("strategy"), spec.Conversion.Strategy, "must be None if spec.preserveUnknownFields is true"))
 	}
 	allErrs = append(allErrs, validateCustomResourceConversion(spec.Conversion, opts.requireRecognizedConversionReviewVersion, fldPath.Child("conversion"))...)
+	allErrs = append(allErrs, validateCustomResourceLimits(spec.Limits, spec.Scope, fldPath.Child("limits"))...)
+	allErrs = append(allErrs, validateCustomResourceRetention(spec.Retention, fldPath.Child("retention"))...)
+	allErrs = append(allErrs, validateCustomResourceHistory(spec.History, fldPath.Child("history"))...)
 
 	return allErrs
 }
@@ -479,6 +497,21 @@ func getSubresourcesForVersion(crd *apiextensions.CustomResourceDefinitionSpec,
 	return nil
 }
 
+// getSchemaForVersion returns the validation schema for given version in given CRD spec.
+// NOTE That this function assumes version always exist since it's used by the validation process
+// that iterates through the existing versions.
+func getSchemaForVersion(crd *apiextensions.CustomResourceDefinitionSpec, version string) *apiextensions.CustomResourceValidation {
+	if !hasPerVersionSchema(crd.Versions) {
+		return crd.Validation
+	}
+	for _, v := range crd.Versions {
+		if version == v.Name {
+			return v.Schema
+		}
+	}
+	return nil
+}
+
 // hasAnyStatusEnabled returns true if given CRD spec has at least one Status Subresource set
 // among the top-level and per-version Subresources.
 func hasAnyStatusEnabled(crd *apiextensions.CustomResourceDefinitionSpec) bool {
@@ -703,7 +736,9 @@ func validateCustomResourceDefinitionValidation(customResourceValidation *apiext
 			requireValidPropertyType: opts.requireValidPropertyType,
 		}
 
+		opts.schemaDefinitions = schema.Definitions
 		allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema, fldPath.Child("openAPIV3Schema"), openAPIV3Schema, true, &opts)...)
+		allErrs = append(allErrs, validateSchemaReferences(schema, fldPath.Child("openAPIV3Schema"))...)
 
 		if opts.requireStructuralSchema {
 			if ss, err := structuralschema.NewStructural(schema); err != nil {
@@ -788,6 +823,10 @@ func ValidateCustomResourceDefinitionOpenAPISchema(schema *apiextensions.JSONSch
 	}
 
 	allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema.Not, fldPath.Child("not"), ssv, false, opts)...)
+	allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema.If, fldPath.Child("if"), ssv, false, opts)...)
+	allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema.Then, fldPath.Child("then"), ssv, false, opts)...)
+	allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema.Else, fldPath.Child("else"), ssv, false, opts)...)
+	allErrs = append(allErrs, ValidateCustomResourceDefinitionOpenAPISchema(schema.PropertyNames, fldPath.Child("propertyNames"), ssv, false, opts)...)
 
 	if len(schema.AllOf) != 0 {
 		for i, jsonSchema := range schema.AllOf {
@@ -844,6 +883,42 @@ func ValidateCustomResourceDefinitionOpenAPISchema(schema *apiextensions.JSONSch
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("x-kubernetes-map-type"), *schema.XMapType, []string{"atomic", "granular"}))
 	}
 
+	if schema.XValueType != nil && schema.Type != "string" && !schema.XIntOrString {
+		if len(schema.Type) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must be string or x-kubernetes-int-or-string must be true if x-kubernetes-value-type is specified"))
+		} else {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), schema.Type, "must be string or x-kubernetes-int-or-string must be true if x-kubernetes-value-type is specified"))
+		}
+	}
+
+	if schema.XValueType != nil && !valueTypes.Has(*schema.XValueType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("x-kubernetes-value-type"), *schema.XValueType, valueTypes.List()))
+	}
+
+	if len(schema.XNormalize) > 0 {
+		allErrs = append(allErrs, validateNormalizations(schema, fldPath)...)
+	}
+
+	if schema.XDeprecated != nil && len(strings.TrimSpace(*schema.XDeprecated)) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("x-kubernetes-deprecated"), "must be a non-empty deprecation message"))
+	}
+
+	if schema.XWritePermission != nil {
+		allErrs = append(allErrs, validateWritePermission(*schema.XWritePermission, fldPath.Child("x-kubernetes-write-permission"))...)
+	}
+
+	if schema.XConditions != nil {
+		allErrs = append(allErrs, validateConditions(schema, fldPath)...)
+	}
+
+	if len(schema.XEmbeddedResourceKinds) > 0 {
+		allErrs = append(allErrs, validateEmbeddedResourceKinds(schema, fldPath)...)
+	}
+
+	if schema.XReference != nil {
+		allErrs = append(allErrs, validateReference(schema, fldPath)...)
+	}
+
 	if schema.XListType != nil && schema.Type != "array" {
 		if len(schema.Type) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must be array if x-kubernetes-list-type is specified"))
@@ -926,7 +1001,19 @@ func ValidateCustomResourceDefinitionOpenAPISchema(schema *apiextensions.JSONSch
 			}
 		}
 
-		structural, err := structuralschema.NewStructural(schema)
+		withDefinitions := schema
+		if !isRoot && opts != nil && len(opts.schemaDefinitions) > 0 {
+			// $ref in nested schemas are resolved against the definitions at the root. Only the
+			// referenced ones are passed on because unreferenced definitions are not allowed.
+			clone := *schema
+			clone.Definitions = apiextensions.JSONSchemaDefinitions{}
+			addReferencedDefinitions(schema, opts.schemaDefinitions, clone.Definitions)
+			if len(clone.Definitions) == 0 {
+				clone.Definitions = nil
+			}
+			withDefinitions = &clone
+		}
+		structural, err := structuralschema.NewStructural(withDefinitions)
 		if err == nil {
 			compResults, err := cel.Compile(structural, isRoot)
 			if err != nil {
@@ -952,6 +1039,151 @@ func ValidateCustomResourceDefinitionOpenAPISchema(schema *apiextensions.JSONSch
 	return allErrs
 }
 
+var definitionNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// validateSchemaReferences checks that definitions are only specified at the root of the schema, that each of them
+// is referenced, and that every $ref points to one of them without specifying anything else. $ref is not allowed at the
+// root, as a definition, under a logical junctor or inside resource metadata.
+func validateSchemaReferences(schema *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schema.Ref != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("$ref"), "must not be specified at the root"))
+	}
+
+	referenced := sets.NewString()
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema, fldPath, schema.Definitions, referenced, true, false, false)...)
+
+	names := make([]string, 0, len(schema.Definitions))
+	for name := range schema.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		def := schema.Definitions[name]
+		defPath := fldPath.Child("definitions").Key(name)
+		if !definitionNameRegexp.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(defPath, name, fmt.Sprintf("must be a valid definition name (regex used for validation is '%s')", definitionNameRegexp.String())))
+		}
+		if def.Ref != nil {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("$ref"), "must not be specified for a definition"))
+			continue
+		}
+		allErrs = append(allErrs, validateNestedSchemaReferences(&def, defPath, schema.Definitions, referenced, false, false, false)...)
+	}
+	for _, name := range names {
+		if !referenced.Has(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("definitions").Key(name), name, "must be referenced via $ref"))
+		}
+	}
+
+	return allErrs
+}
+
+// addReferencedDefinitions adds the definitions referenced by schema, directly or indirectly, to referenced.
+// References under logical junctors are not followed as they are not allowed.
+func addReferencedDefinitions(schema *apiextensions.JSONSchemaProps, definitions, referenced apiextensions.JSONSchemaDefinitions) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != nil {
+		name := strings.TrimPrefix(*schema.Ref, structuralschema.DefinitionRefPrefix)
+		if _, ok := referenced[name]; ok {
+			return
+		}
+		def, ok := definitions[name]
+		if !ok {
+			return
+		}
+		referenced[name] = def
+		addReferencedDefinitions(&def, definitions, referenced)
+		return
+	}
+	for _, prop := range schema.Properties {
+		addReferencedDefinitions(&prop, definitions, referenced)
+	}
+	if schema.Items != nil {
+		addReferencedDefinitions(schema.Items.Schema, definitions, referenced)
+	}
+	if schema.AdditionalProperties != nil {
+		addReferencedDefinitions(schema.AdditionalProperties.Schema, definitions, referenced)
+	}
+}
+
+// validateNestedSchemaReferences is the recursive step of validateSchemaReferences. It adds the names of the
+// referenced definitions to referenced.
+func validateNestedSchemaReferences(schema *apiextensions.JSONSchemaProps, fldPath *field.Path, definitions apiextensions.JSONSchemaDefinitions, referenced sets.String, isRoot, insideJunctor, insideMeta bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schema == nil {
+		return allErrs
+	}
+
+	if !isRoot && len(schema.Definitions) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("definitions"), "must only be specified at the root"))
+	}
+
+	if schema.Ref != nil {
+		if isRoot {
+			// checked by the caller
+			return allErrs
+		}
+		ref := *schema.Ref
+		name := strings.TrimPrefix(ref, structuralschema.DefinitionRefPrefix)
+		switch {
+		case insideJunctor:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("$ref"), "must not be used inside of allOf, anyOf, oneOf, not, if, then, else or propertyNames"))
+		case insideMeta:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("$ref"), "must not be used inside of resource meta"))
+		case !strings.HasPrefix(ref, structuralschema.DefinitionRefPrefix) || len(name) == 0:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("$ref"), ref, fmt.Sprintf("must be of the form %s<name>", structuralschema.DefinitionRefPrefix)))
+		default:
+			if _, ok := definitions[name]; !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("$ref"), ref, "must point to an existing definition"))
+			} else {
+				referenced.Insert(name)
+			}
+		}
+		if !reflect.DeepEqual(*schema, apiextensions.JSONSchemaProps{Ref: schema.Ref}) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify anything other than $ref"))
+		}
+		return allErrs
+	}
+
+	if schema.AdditionalProperties != nil {
+		allErrs = append(allErrs, validateNestedSchemaReferences(schema.AdditionalProperties.Schema, fldPath.Child("additionalProperties"), definitions, referenced, false, insideJunctor, insideMeta)...)
+	}
+	for property, jsonSchema := range schema.Properties {
+		subInsideMeta := insideMeta
+		if (isRoot || schema.XEmbeddedResource) && metaFields.Has(property) {
+			subInsideMeta = true
+		}
+		allErrs = append(allErrs, validateNestedSchemaReferences(&jsonSchema, fldPath.Child("properties").Key(property), definitions, referenced, false, insideJunctor, subInsideMeta)...)
+	}
+	if schema.Items != nil {
+		allErrs = append(allErrs, validateNestedSchemaReferences(schema.Items.Schema, fldPath.Child("items"), definitions, referenced, false, insideJunctor, insideMeta)...)
+		for i, jsonSchema := range schema.Items.JSONSchemas {
+			allErrs = append(allErrs, validateNestedSchemaReferences(&jsonSchema, fldPath.Child("items").Index(i), definitions, referenced, false, insideJunctor, insideMeta)...)
+		}
+	}
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema.Not, fldPath.Child("not"), definitions, referenced, false, true, insideMeta)...)
+	for i, jsonSchema := range schema.AllOf {
+		allErrs = append(allErrs, validateNestedSchemaReferences(&jsonSchema, fldPath.Child("allOf").Index(i), definitions, referenced, false, true, insideMeta)...)
+	}
+	for i, jsonSchema := range schema.AnyOf {
+		allErrs = append(allErrs, validateNestedSchemaReferences(&jsonSchema, fldPath.Child("anyOf").Index(i), definitions, referenced, false, true, insideMeta)...)
+	}
+	for i, jsonSchema := range schema.OneOf {
+		allErrs = append(allErrs, validateNestedSchemaReferences(&jsonSchema, fldPath.Child("oneOf").Index(i), definitions, referenced, false, true, insideMeta)...)
+	}
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema.If, fldPath.Child("if"), definitions, referenced, false, true, insideMeta)...)
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema.Then, fldPath.Child("then"), definitions, referenced, false, true, insideMeta)...)
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema.Else, fldPath.Child("else"), definitions, referenced, false, true, insideMeta)...)
+	allErrs = append(allErrs, validateNestedSchemaReferences(schema.PropertyNames, fldPath.Child("propertyNames"), definitions, referenced, false, true, insideMeta)...)
+
+	return allErrs
+}
+
 var newlineMatcher = regexp.MustCompile(`[\n\r]+`) // valid newline chars in CEL grammar
 func hasNewlines(s string) bool {
 	return newlineMatcher.MatchString(s)
@@ -1065,18 +1297,10 @@ func (v *specStandardValidatorV3) validate(schema *apiextensions.JSONSchemaProps
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("patternProperties"), "patternProperties is not supported"))
 	}
 
-	if len(schema.Definitions) != 0 {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("definitions"), "definitions is not supported"))
-	}
-
 	if schema.Dependencies != nil {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dependencies"), "dependencies is not supported"))
 	}
 
-	if schema.Ref != nil {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("$ref"), "$ref is not supported"))
-	}
-
 	if schema.Type == "null" {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), "type cannot be set to null, use nullable as an alternative"))
 	}
@@ -1133,9 +1357,164 @@ func ValidateCustomResourceDefinitionSubresources(subresources *apiextensions.Cu
 		}
 	}
 
+	names := sets.NewString()
+	for i, custom := range subresources.Custom {
+		customPath := fldPath.Child("custom").Index(i)
+		if len(custom.Name) == 0 {
+			allErrs = append(allErrs, field.Required(customPath.Child("name"), ""))
+		} else if reservedSubresources.Has(custom.Name) {
+			allErrs = append(allErrs, field.Invalid(customPath.Child("name"), custom.Name, fmt.Sprintf("must not be one of the reserved subresources %s", strings.Join(reservedSubresources.List(), ", "))))
+		} else if names.Has(custom.Name) {
+			allErrs = append(allErrs, field.Duplicate(customPath.Child("name"), custom.Name))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(custom.Name) {
+				allErrs = append(allErrs, field.Invalid(customPath.Child("name"), custom.Name, msg))
+			}
+		}
+		names.Insert(custom.Name)
+
+		if len(custom.JSONPath) == 0 {
+			allErrs = append(allErrs, field.Required(customPath.Child("jsonPath"), ""))
+		} else if errs := validateSimpleJSONPath(custom.JSONPath, customPath.Child("jsonPath")); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else if custom.JSONPath == "." {
+			allErrs = append(allErrs, field.Invalid(customPath.Child("jsonPath"), custom.JSONPath, "must not be the root of the object"))
+		} else if custom.JSONPath == ".metadata" || strings.HasPrefix(custom.JSONPath, ".metadata.") {
+			allErrs = append(allErrs, field.Invalid(customPath.Child("jsonPath"), custom.JSONPath, "must not be a json path under .metadata"))
+		}
+	}
+
 	return allErrs
 }
 
+// validateCustomResourceLimits statically validates the limits of the custom resources.
+func validateCustomResourceLimits(limits *apiextensions.CustomResourceLimits, scope apiextensions.ResourceScope, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits == nil {
+		return allErrs
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value *int64
+	}{
+		{"maxObjects", limits.MaxObjects},
+		{"maxObjectsPerNamespace", limits.MaxObjectsPerNamespace},
+		{"maxObjectSizeBytes", limits.MaxObjectSizeBytes},
+	} {
+		if limit.value != nil && *limit.value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(limit.name), *limit.value, "must be greater than 0"))
+		}
+	}
+	if limits.MaxObjectsPerNamespace != nil {
+		if scope == apiextensions.ClusterScoped {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxObjectsPerNamespace"), "must not be specified for cluster-scoped resources"))
+		} else if limits.MaxObjects != nil && *limits.MaxObjectsPerNamespace > *limits.MaxObjects {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxObjectsPerNamespace"), *limits.MaxObjectsPerNamespace, "must not be greater than maxObjects"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCustomResourceRetention statically validates the retention of deleted custom resources.
+func validateCustomResourceRetention(retention *apiextensions.CustomResourceRetention, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retention != nil && retention.TTLSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttlSeconds"), retention.TTLSeconds, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// validateCustomResourceHistory statically validates the history of custom resources.
+func validateCustomResourceHistory(history *apiextensions.CustomResourceHistory, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if history != nil && (history.MaxRevisions < 1 || history.MaxRevisions > maxHistoryRevisions) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRevisions"), history.MaxRevisions, fmt.Sprintf("must be between 1 and %d", maxHistoryRevisions)))
+	}
+
+	return allErrs
+}
+
+// validateCustomResourceGeneration statically validates the paths which increment the generation.
+// Paths are split at dots, so they must not point to or through fields of the schema whose names
+// contain a dot.
+func validateCustomResourceGeneration(generation *apiextensions.CustomResourceGeneration, schema *apiextensions.CustomResourceValidation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if generation == nil {
+		return allErrs
+	}
+
+	if len(generation.Paths) > 0 && len(generation.IgnoredPaths) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ignoredPaths"), "must not be specified together with paths"))
+	}
+	var root *apiextensions.JSONSchemaProps
+	if schema != nil {
+		root = schema.OpenAPIV3Schema
+	}
+	allErrs = append(allErrs, validateGenerationPaths(generation.Paths, root, fldPath.Child("paths"))...)
+	allErrs = append(allErrs, validateGenerationPaths(generation.IgnoredPaths, root, fldPath.Child("ignoredPaths"))...)
+
+	return allErrs
+}
+
+func validateGenerationPaths(paths []string, root *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, path := range paths {
+		if errs := validateSimpleJSONPath(path, fldPath.Index(i)); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else if path == "." {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, "must not be the root of the object"))
+		} else if path == ".metadata" || strings.HasPrefix(path, ".metadata.") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, "must not be a json path under .metadata"))
+		} else if name, ok := dottedFieldInPath(strings.Split(path[1:], "."), root); ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, fmt.Sprintf("must not point to or through the field %q whose name contains a dot", name)))
+		} else if seen.Has(path) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), path))
+		}
+		seen.Insert(path)
+	}
+
+	return allErrs
+}
+
+// dottedFieldInPath returns the name of a property of root whose name contains a dot and which the
+// dot-separated segments would select if they were not split at that dot.
+func dottedFieldInPath(segments []string, root *apiextensions.JSONSchemaProps) (string, bool) {
+	s := root
+	for i, seg := range segments {
+		if s != nil && s.Ref != nil && root.Definitions != nil {
+			if def, ok := root.Definitions[strings.TrimPrefix(*s.Ref, structuralschema.DefinitionRefPrefix)]; ok {
+				s = &def
+			}
+		}
+		if s == nil {
+			return "", false
+		}
+		rest := strings.Join(segments[i:], ".")
+		for name := range s.Properties {
+			if strings.Contains(name, ".") && (rest == name || strings.HasPrefix(rest, name+".")) {
+				return name, true
+			}
+		}
+		if p, ok := s.Properties[seg]; ok {
+			s = &p
+		} else if s.AdditionalProperties != nil {
+			s = s.AdditionalProperties.Schema
+		} else {
+			s = nil
+		}
+	}
+	return "", false
+}
+
 func validateSimpleJSONPath(s string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1153,7 +1532,7 @@ func validateSimpleJSONPath(s string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
-var allowedFieldsAtRootSchema = []string{"Description", "Type", "Format", "Title", "Maximum", "ExclusiveMaximum", "Minimum", "ExclusiveMinimum", "MaxLength", "MinLength", "Pattern", "MaxItems", "MinItems", "UniqueItems", "MultipleOf", "Required", "Items", "Properties", "ExternalDocs", "Example", "XPreserveUnknownFields", "XValidations"}
+var allowedFieldsAtRootSchema = []string{"Description", "Type", "Format", "Title", "Maximum", "ExclusiveMaximum", "Minimum", "ExclusiveMinimum", "MaxLength", "MinLength", "Pattern", "MaxItems", "MinItems", "UniqueItems", "MultipleOf", "Required", "Items", "Properties", "ExternalDocs", "Example", "XPreserveUnknownFields", "XValidations", "Definitions"}
 
 func allowedAtRootSchema(field string) bool {
 	for _, v := range allowedFieldsAtRootSchema {
@@ -1243,6 +1622,11 @@ func SchemaHas(s *apiextensions.JSONSchemaProps, pred func(s *apiextensions.JSON
 	if SchemaHas(s.Not, pred) {
 		return true
 	}
+	for _, s := range []*apiextensions.JSONSchemaProps{s.If, s.Then, s.Else, s.PropertyNames} {
+		if SchemaHas(s, pred) {
+			return true
+		}
+	}
 	for _, s := range s.Properties {
 		if SchemaHas(&s, pred) {
 			return true
@@ -1277,6 +1661,208 @@ func SchemaHas(s *apiextensions.JSONSchemaProps, pred func(s *apiextensions.JSON
 	return false
 }
 
+// validateNormalizations checks the x-kubernetes-normalize extension of schema. Enum values must be
+// normalized already because no normalized value could ever match them otherwise.
+func validateNormalizations(schema *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schema.Type != "string" {
+		if len(schema.Type) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must be string if x-kubernetes-normalize is specified"))
+		} else {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), schema.Type, "must be string if x-kubernetes-normalize is specified"))
+		}
+	}
+
+	supported := sets.NewString(structuraldefaulting.Normalizations...)
+	seen := sets.NewString()
+	for i, n := range schema.XNormalize {
+		if !supported.Has(n) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("x-kubernetes-normalize").Index(i), n, structuraldefaulting.Normalizations))
+		} else if seen.Has(n) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("x-kubernetes-normalize").Index(i), n))
+		}
+		seen.Insert(n)
+	}
+
+	for i, e := range schema.Enum {
+		if str, ok := e.(string); ok && structuraldefaulting.NormalizeString(str, schema.XNormalize) != str {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("enum").Index(i), str, "must be normalized according to x-kubernetes-normalize"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWritePermission validates the subresource named by x-kubernetes-write-permission. It must
+// consist of valid path segments separated by slashes, and must not be a subresource served for
+// custom resources.
+func validateWritePermission(subresource string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(subresource) == 0 {
+		return append(allErrs, field.Required(fldPath, "must name a subresource"))
+	}
+	for _, segment := range strings.Split(subresource, "/") {
+		if len(segment) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, subresource, "must not contain empty path segments"))
+			break
+		}
+		for _, msg := range pathvalidation.IsValidPathSegmentName(segment) {
+			allErrs = append(allErrs, field.Invalid(fldPath, subresource, msg))
+		}
+	}
+	if reservedSubresources.Has(strings.Split(subresource, "/")[0]) {
+		allErrs = append(allErrs, field.Invalid(fldPath, subresource, fmt.Sprintf("must not start with one of the reserved subresources %s", strings.Join(reservedSubresources.List(), ", "))))
+	}
+
+	return allErrs
+}
+
+// validateConditions validates a list marked with x-kubernetes-conditions. It must be a list map
+// keyed by type, with items of the shape of metav1.Condition.
+func validateConditions(schema *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !conditionsTypes.Has(*schema.XConditions) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("x-kubernetes-conditions"), *schema.XConditions, conditionsTypes.List()))
+	}
+	if schema.Type != "array" {
+		if len(schema.Type) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must be array if x-kubernetes-conditions is specified"))
+		} else {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), schema.Type, "must be array if x-kubernetes-conditions is specified"))
+		}
+		return allErrs
+	}
+	if schema.XListType == nil || *schema.XListType != "map" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("x-kubernetes-list-type"), schema.XListType, "must be map if x-kubernetes-conditions is specified"))
+	}
+	if len(schema.XListMapKeys) != 1 || schema.XListMapKeys[0] != "type" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("x-kubernetes-list-map-keys"), schema.XListMapKeys, "must be [type] if x-kubernetes-conditions is specified"))
+	}
+	if schema.Items == nil || schema.Items.Schema == nil {
+		return append(allErrs, field.Required(fldPath.Child("items"), "must have the shape of a condition if x-kubernetes-conditions is specified"))
+	}
+
+	items := schema.Items.Schema
+	itemsPath := fldPath.Child("items")
+	if items.Type != "object" {
+		return append(allErrs, field.Invalid(itemsPath.Child("type"), items.Type, "must be object if x-kubernetes-conditions is specified"))
+	}
+	for _, name := range []string{"type", "status", "reason", "message", "lastTransitionTime"} {
+		prop, ok := items.Properties[name]
+		switch {
+		case !ok:
+			allErrs = append(allErrs, field.Required(itemsPath.Child("properties").Key(name), "must be specified for conditions"))
+		case prop.Type != "string":
+			allErrs = append(allErrs, field.Invalid(itemsPath.Child("properties").Key(name).Child("type"), prop.Type, "must be string for conditions"))
+		case name == "lastTransitionTime" && prop.Format != "date-time":
+			allErrs = append(allErrs, field.Invalid(itemsPath.Child("properties").Key(name).Child("format"), prop.Format, "must be date-time for conditions"))
+		}
+	}
+	if prop, ok := items.Properties["observedGeneration"]; ok && prop.Type != "integer" {
+		allErrs = append(allErrs, field.Invalid(itemsPath.Child("properties").Key("observedGeneration").Child("type"), prop.Type, "must be integer for conditions"))
+	} else if !ok && *schema.XConditions == "observedGeneration" {
+		allErrs = append(allErrs, field.Required(itemsPath.Child("properties").Key("observedGeneration"), "must be specified if x-kubernetes-conditions is observedGeneration"))
+	}
+	for _, name := range sets.StringKeySet(items.Properties).List() {
+		switch name {
+		case "type", "status", "reason", "message", "lastTransitionTime", "observedGeneration":
+		default:
+			allErrs = append(allErrs, field.Forbidden(itemsPath.Child("properties").Key(name), "must not be specified for conditions"))
+		}
+	}
+	required := sets.NewString(items.Required...)
+	for _, name := range []string{"type", "status"} {
+		if !required.Has(name) {
+			allErrs = append(allErrs, field.Required(itemsPath.Child("required"), fmt.Sprintf("must contain %q for conditions", name)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEmbeddedResourceKinds validates the kinds listed by x-kubernetes-embedded-resource-kinds.
+// They must be given for embedded resources only, and each must have a version and a kind.
+func validateEmbeddedResourceKinds(schema *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	kindsPath := fldPath.Child("x-kubernetes-embedded-resource-kinds")
+
+	if !schema.XEmbeddedResource {
+		allErrs = append(allErrs, field.Forbidden(kindsPath, "must only be specified if x-kubernetes-embedded-resource is true"))
+	}
+	seen := map[apiextensions.EmbeddedResourceKind]bool{}
+	for i, k := range schema.XEmbeddedResourceKinds {
+		if len(k.APIVersion) == 0 {
+			allErrs = append(allErrs, field.Required(kindsPath.Index(i).Child("apiVersion"), ""))
+		} else if gv, err := runtimeschema.ParseGroupVersion(k.APIVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(kindsPath.Index(i).Child("apiVersion"), k.APIVersion, err.Error()))
+		} else if len(gv.Version) == 0 {
+			allErrs = append(allErrs, field.Invalid(kindsPath.Index(i).Child("apiVersion"), k.APIVersion, "must contain a version"))
+		}
+		if len(k.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(kindsPath.Index(i).Child("kind"), ""))
+		}
+		if seen[k] {
+			allErrs = append(allErrs, field.Duplicate(kindsPath.Index(i), k))
+		}
+		seen[k] = true
+	}
+
+	return allErrs
+}
+
+// validateReference validates x-kubernetes-reference. It must be specified on strings only, and
+// must name the resource and scope of the referent. Referents are looked up in the custom resources
+// served by CustomResourceDefinitions, so the group must be valid for a CustomResourceDefinition and
+// not a Kubernetes group.
+func validateReference(schema *apiextensions.JSONSchemaProps, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	refPath := fldPath.Child("x-kubernetes-reference")
+	ref := schema.XReference
+
+	if schema.Type != "string" {
+		if len(schema.Type) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must be string if x-kubernetes-reference is specified"))
+		} else {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), schema.Type, "must be string if x-kubernetes-reference is specified"))
+		}
+	}
+	if len(ref.Group) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("group"), "must be the group of a CustomResourceDefinition"))
+	} else if msgs := utilvalidation.IsDNS1123Subdomain(ref.Group); len(msgs) > 0 {
+		for _, msg := range msgs {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("group"), ref.Group, msg))
+		}
+	} else if !strings.Contains(ref.Group, ".") {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("group"), ref.Group, "should be a domain with at least one dot, like the group of a CustomResourceDefinition"))
+	} else if apihelpers.IsProtectedCommunityGroup(ref.Group) {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("group"), ref.Group, "must not be a Kubernetes group, only custom resources can be referenced"))
+	}
+	if len(ref.Resource) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("resource"), ""))
+	} else {
+		for _, msg := range utilvalidation.IsDNS1123Label(ref.Resource) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("resource"), ref.Resource, msg))
+		}
+	}
+	switch ref.Scope {
+	case apiextensions.NamespaceScoped, apiextensions.ClusterScoped:
+	case "":
+		allErrs = append(allErrs, field.Required(refPath.Child("scope"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(refPath.Child("scope"), ref.Scope, []string{string(apiextensions.ClusterScoped), string(apiextensions.NamespaceScoped)}))
+	}
+	switch ref.Policy {
+	case "", apiextensions.ReferencePolicyIgnore, apiextensions.ReferencePolicyWarn, apiextensions.ReferencePolicyReject:
+	default:
+		allErrs = append(allErrs, field.NotSupported(refPath.Child("policy"), ref.Policy, []string{string(apiextensions.ReferencePolicyIgnore), string(apiextensions.ReferencePolicyReject), string(apiextensions.ReferencePolicyWarn)}))
+	}
+
+	return allErrs
+}
+
 func specHasKubernetesExtensions(spec *apiextensions.CustomResourceDefinitionSpec) bool {
 	if spec.Validation != nil && schemaHasKubernetesExtensions(spec.Validation.OpenAPIV3Schema) {
 		return true
@@ -1291,7 +1877,7 @@ func specHasKubernetesExtensions(spec *apiextensions.CustomResourceDefinitionSpe
 
 func schemaHasKubernetesExtensions(s *apiextensions.JSONSchemaProps) bool {
 	return SchemaHas(s, func(s *apiextensions.JSONSchemaProps) bool {
-		return s.XEmbeddedResource || s.XPreserveUnknownFields != nil || s.XIntOrString || len(s.XListMapKeys) > 0 || s.XListType != nil || len(s.XValidations) > 0
+		return s.XEmbeddedResource || s.XPreserveUnknownFields != nil || s.XIntOrString || len(s.XListMapKeys) > 0 || s.XListType != nil || len(s.XValidations) > 0 || s.XValueType != nil || len(s.XNormalize) > 0 || s.XDeprecated != nil || s.XSensitive || s.XWritePermission != nil || s.XConditions != nil || len(s.XEmbeddedResourceKinds) > 0 || s.XReference != nil
 	})
 }
 
@@ -58,6 +58,9 @@ func immutable(path ...string) validationMatch {
 func forbidden(path ...string) validationMatch {
 	return validationMatch{path: field.NewPath(path[0], path[1:]...), errorType: field.ErrorTypeForbidden}
 }
+func duplicate(path ...string) validationMatch {
+	return validationMatch{path: field.NewPath(path[0], path[1:]...), errorType: field.ErrorTypeDuplicate}
+}
 
 func (v validationMatch) matches(err *field.Error) bool {
 	return err.Type == v.errorType && err.Field == v.path.String() && strings.Contains(err.Error(), v.contains)
@@ -1627,6 +1630,222 @@ func TestValidateCustomResourceDefinition(t *testing.T) {
 				invalid("spec", "versions[3]", "subresources", "scale", "labelSelectorPath"),
 			},
 		},
+		{
+			name: "custom subresources",
+			resource: &apiextensions.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "plural.group.com"},
+				Spec: apiextensions.CustomResourceDefinitionSpec{
+					Group:   "group.com",
+					Version: "version0",
+					Versions: []apiextensions.CustomResourceDefinitionVersion{
+						{
+							Name:    "version0",
+							Served:  true,
+							Storage: true,
+							Subresources: &apiextensions.CustomResourceSubresources{
+								Custom: []apiextensions.CustomResourceSubresourceCustom{
+									{Name: "approval", JSONPath: ".spec.approval"},
+									{Name: "config", JSONPath: ".spec.config"},
+									{Name: "approval", JSONPath: ".spec.other"},
+									{Name: "status", JSONPath: ".status"},
+									{Name: "Invalid_Name", JSONPath: ".spec.invalid"},
+									{Name: "", JSONPath: ""},
+									{Name: "root", JSONPath: "."},
+									{Name: "labels", JSONPath: ".metadata.labels"},
+									{Name: "array", JSONPath: "spec.array"},
+									{Name: "lint", JSONPath: ".spec.lint"},
+								},
+							},
+						},
+					},
+					Scope: apiextensions.NamespaceScoped,
+					Names: apiextensions.CustomResourceDefinitionNames{
+						Plural:   "plural",
+						Singular: "singular",
+						Kind:     "Plural",
+						ListKind: "PluralList",
+					},
+					PreserveUnknownFields: pointer.BoolPtr(false),
+					Validation: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+				},
+				Status: apiextensions.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"version0"},
+				},
+			},
+			errors: []validationMatch{
+				duplicate("spec", "versions[0]", "subresources", "custom[2]", "name"),
+				invalid("spec", "versions[0]", "subresources", "custom[3]", "name"),
+				invalid("spec", "versions[0]", "subresources", "custom[4]", "name"),
+				required("spec", "versions[0]", "subresources", "custom[5]", "name"),
+				required("spec", "versions[0]", "subresources", "custom[5]", "jsonPath"),
+				invalid("spec", "versions[0]", "subresources", "custom[6]", "jsonPath"),
+				invalid("spec", "versions[0]", "subresources", "custom[7]", "jsonPath"),
+				invalid("spec", "versions[0]", "subresources", "custom[8]", "jsonPath"),
+				invalid("spec", "versions[0]", "subresources", "custom[9]", "name"),
+			},
+		},
+		{
+			name: "invalid limits",
+			resource: &apiextensions.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "plural.group.com"},
+				Spec: apiextensions.CustomResourceDefinitionSpec{
+					Group:   "group.com",
+					Version: "version0",
+					Versions: []apiextensions.CustomResourceDefinitionVersion{
+						{
+							Name:    "version0",
+							Served:  true,
+							Storage: true,
+						},
+					},
+					Scope: apiextensions.NamespaceScoped,
+					Names: apiextensions.CustomResourceDefinitionNames{
+						Plural:   "plural",
+						Singular: "singular",
+						Kind:     "Plural",
+						ListKind: "PluralList",
+					},
+					PreserveUnknownFields: pointer.BoolPtr(false),
+					Validation: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+					Limits: &apiextensions.CustomResourceLimits{
+						MaxObjects:             pointer.Int64Ptr(0),
+						MaxObjectsPerNamespace: pointer.Int64Ptr(10),
+						MaxObjectSizeBytes:     pointer.Int64Ptr(-1),
+					},
+				},
+				Status: apiextensions.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"version0"},
+				},
+			},
+			errors: []validationMatch{
+				invalid("spec", "limits", "maxObjects"),
+				invalid("spec", "limits", "maxObjectsPerNamespace"),
+				invalid("spec", "limits", "maxObjectSizeBytes"),
+			},
+		},
+		{
+			name: "per-namespace limit of cluster-scoped resources",
+			resource: &apiextensions.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "plural.group.com"},
+				Spec: apiextensions.CustomResourceDefinitionSpec{
+					Group:   "group.com",
+					Version: "version0",
+					Versions: []apiextensions.CustomResourceDefinitionVersion{
+						{
+							Name:    "version0",
+							Served:  true,
+							Storage: true,
+						},
+					},
+					Scope: apiextensions.ClusterScoped,
+					Names: apiextensions.CustomResourceDefinitionNames{
+						Plural:   "plural",
+						Singular: "singular",
+						Kind:     "Plural",
+						ListKind: "PluralList",
+					},
+					PreserveUnknownFields: pointer.BoolPtr(false),
+					Validation: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+					Limits: &apiextensions.CustomResourceLimits{
+						MaxObjects:             pointer.Int64Ptr(100),
+						MaxObjectsPerNamespace: pointer.Int64Ptr(10),
+						MaxObjectSizeBytes:     pointer.Int64Ptr(64 * 1024),
+					},
+				},
+				Status: apiextensions.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"version0"},
+				},
+			},
+			errors: []validationMatch{
+				forbidden("spec", "limits", "maxObjectsPerNamespace"),
+			},
+		},
+		{
+			name: "invalid retention",
+			resource: &apiextensions.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "plural.group.com"},
+				Spec: apiextensions.CustomResourceDefinitionSpec{
+					Group:   "group.com",
+					Version: "version0",
+					Versions: []apiextensions.CustomResourceDefinitionVersion{
+						{
+							Name:    "version0",
+							Served:  true,
+							Storage: true,
+						},
+					},
+					Scope: apiextensions.NamespaceScoped,
+					Names: apiextensions.CustomResourceDefinitionNames{
+						Plural:   "plural",
+						Singular: "singular",
+						Kind:     "Plural",
+						ListKind: "PluralList",
+					},
+					PreserveUnknownFields: pointer.BoolPtr(false),
+					Validation: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+					Retention: &apiextensions.CustomResourceRetention{TTLSeconds: 0},
+				},
+				Status: apiextensions.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"version0"},
+				},
+			},
+			errors: []validationMatch{
+				invalid("spec", "retention", "ttlSeconds"),
+			},
+		},
+		{
+			name: "invalid history",
+			resource: &apiextensions.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "plural.group.com"},
+				Spec: apiextensions.CustomResourceDefinitionSpec{
+					Group:   "group.com",
+					Version: "version0",
+					Versions: []apiextensions.CustomResourceDefinitionVersion{
+						{
+							Name:    "version0",
+							Served:  true,
+							Storage: true,
+						},
+					},
+					Scope: apiextensions.NamespaceScoped,
+					Names: apiextensions.CustomResourceDefinitionNames{
+						Plural:   "plural",
+						Singular: "singular",
+						Kind:     "Plural",
+						ListKind: "PluralList",
+					},
+					PreserveUnknownFields: pointer.BoolPtr(false),
+					Validation: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+					History: &apiextensions.CustomResourceHistory{MaxRevisions: 101},
+				},
+				Status: apiextensions.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"version0"},
+				},
+			},
+			errors: []validationMatch{
+				invalid("spec", "history", "maxRevisions"),
+			},
+		},
 		{
 			name: "defaults with enabled feature gate",
 			resource: &apiextensions.CustomResourceDefinition{
@@ -6861,6 +7080,270 @@ func TestValidateCustomResourceDefinitionValidation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "allowed value types",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"limit":    {Type: "string", XValueType: strPtr("quantity")},
+						"replicas": {XIntOrString: true, XValueType: strPtr("quantity")},
+						"timeout":  {Type: "string", XValueType: strPtr("duration")},
+					},
+				},
+			},
+		},
+		{
+			name: "value type on non-string",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"limit": {Type: "integer", XValueType: strPtr("quantity")},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				invalid("spec.validation.openAPIV3Schema.properties[limit].type"),
+			},
+		},
+		{
+			name: "invalid value type",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"limit": {Type: "string", XValueType: strPtr("bytes")},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				unsupported("spec.validation.openAPIV3Schema.properties[limit].x-kubernetes-value-type"),
+			},
+		},
+		{
+			name: "allowed normalizations",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"host": {Type: "string", XNormalize: []string{"trim", "lowercase"}, Enum: []apiextensions.JSON{"example.com"}},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid normalizations",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"host":  {Type: "string", XNormalize: []string{"lowercase", "uppercase", "lowercase"}, Enum: []apiextensions.JSON{"Example.com"}},
+						"count": {Type: "integer", XNormalize: []string{"trim"}},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				unsupported("spec.validation.openAPIV3Schema.properties[host].x-kubernetes-normalize[1]"),
+				duplicate("spec.validation.openAPIV3Schema.properties[host].x-kubernetes-normalize[2]"),
+				invalid("spec.validation.openAPIV3Schema.properties[host].enum[0]"),
+				invalid("spec.validation.openAPIV3Schema.properties[count].type"),
+			},
+		},
+		{
+			name: "deprecated field",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"old":   {Type: "string", XDeprecated: strPtr("use new instead")},
+						"other": {Type: "string", XDeprecated: strPtr(" ")},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				required("spec.validation.openAPIV3Schema.properties[other].x-kubernetes-deprecated"),
+			},
+		},
+		{
+			name: "write permissions",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"tier":   {Type: "string", XWritePermission: strPtr("fields/tier")},
+						"empty":  {Type: "string", XWritePermission: strPtr("")},
+						"slash":  {Type: "string", XWritePermission: strPtr("fields//tier")},
+						"dots":   {Type: "string", XWritePermission: strPtr("fields/..")},
+						"status": {Type: "string", XWritePermission: strPtr("status")},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				required("spec.validation.openAPIV3Schema.properties[empty].x-kubernetes-write-permission"),
+				invalid("spec.validation.openAPIV3Schema.properties[slash].x-kubernetes-write-permission"),
+				invalid("spec.validation.openAPIV3Schema.properties[dots].x-kubernetes-write-permission"),
+				invalid("spec.validation.openAPIV3Schema.properties[status].x-kubernetes-write-permission"),
+			},
+		},
+		{
+			name: "conditions",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"conditions": {
+							Type:         "array",
+							XConditions:  strPtr("observedGeneration"),
+							XListType:    strPtr("map"),
+							XListMapKeys: []string{"type"},
+							Items: &apiextensions.JSONSchemaPropsOrArray{
+								Schema: &apiextensions.JSONSchemaProps{
+									Type:     "object",
+									Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
+									Properties: map[string]apiextensions.JSONSchemaProps{
+										"type":               {Type: "string"},
+										"status":             {Type: "string", Enum: jsonSlice("True", "False", "Unknown")},
+										"reason":             {Type: "string"},
+										"message":            {Type: "string"},
+										"lastTransitionTime": {Type: "string", Format: "date-time"},
+										"observedGeneration": {Type: "integer", Format: "int64"},
+									},
+								},
+							},
+						},
+						"invalid": {
+							Type:         "array",
+							XConditions:  strPtr("observedGeneration"),
+							XListType:    strPtr("map"),
+							XListMapKeys: []string{"name"},
+							Items: &apiextensions.JSONSchemaPropsOrArray{
+								Schema: &apiextensions.JSONSchemaProps{
+									Type:     "object",
+									Required: []string{"name", "type"},
+									Properties: map[string]apiextensions.JSONSchemaProps{
+										"name":               {Type: "string"},
+										"type":               {Type: "string"},
+										"status":             {Type: "boolean"},
+										"reason":             {Type: "string"},
+										"lastTransitionTime": {Type: "string"},
+									},
+								},
+							},
+						},
+						"unsupported": {
+							Type:        "string",
+							XConditions: strPtr("always"),
+						},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-list-map-keys"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].items.properties[status].type"),
+				required("spec.validation.openAPIV3Schema.properties[invalid].items.properties[message]"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].items.properties[lastTransitionTime].format"),
+				required("spec.validation.openAPIV3Schema.properties[invalid].items.properties[observedGeneration]"),
+				forbidden("spec.validation.openAPIV3Schema.properties[invalid].items.properties[name]"),
+				required("spec.validation.openAPIV3Schema.properties[invalid].items.required"),
+				unsupported("spec.validation.openAPIV3Schema.properties[unsupported].x-kubernetes-conditions"),
+				invalid("spec.validation.openAPIV3Schema.properties[unsupported].type"),
+			},
+		},
+		{
+			name: "embedded resource kinds",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"template": {
+							Type:                   "object",
+							XEmbeddedResource:      true,
+							XPreserveUnknownFields: pointer.BoolPtr(true),
+							XEmbeddedResourceKinds: []apiextensions.EmbeddedResourceKind{
+								{APIVersion: "v1", Kind: "ConfigMap"},
+								{APIVersion: "apps/v1", Kind: "Deployment"},
+							},
+						},
+						"invalid": {
+							Type:                   "object",
+							XEmbeddedResource:      true,
+							XPreserveUnknownFields: pointer.BoolPtr(true),
+							XEmbeddedResourceKinds: []apiextensions.EmbeddedResourceKind{
+								{APIVersion: "apps/", Kind: "Deployment"},
+								{APIVersion: "apps/v1/beta", Kind: "Deployment"},
+								{Kind: "Deployment"},
+								{APIVersion: "v1"},
+								{APIVersion: "v1", Kind: "ConfigMap"},
+								{APIVersion: "v1", Kind: "ConfigMap"},
+							},
+						},
+						"notEmbedded": {
+							Type:                   "object",
+							XPreserveUnknownFields: pointer.BoolPtr(true),
+							XEmbeddedResourceKinds: []apiextensions.EmbeddedResourceKind{
+								{APIVersion: "v1", Kind: "ConfigMap"},
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-embedded-resource-kinds[0].apiVersion"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-embedded-resource-kinds[1].apiVersion"),
+				required("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-embedded-resource-kinds[2].apiVersion"),
+				required("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-embedded-resource-kinds[3].kind"),
+				duplicate("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-embedded-resource-kinds[5]"),
+				forbidden("spec.validation.openAPIV3Schema.properties[notEmbedded].x-kubernetes-embedded-resource-kinds"),
+			},
+		},
+		{
+			name: "references",
+			input: apiextensions.CustomResourceValidation{
+				OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"secretStoreRef": {
+							Type:       "string",
+							XReference: &apiextensions.ReferenceTarget{Group: "example.com", Resource: "secretstores", Scope: apiextensions.NamespaceScoped, Policy: apiextensions.ReferencePolicyReject},
+						},
+						"configMap": {
+							Type:       "string",
+							XReference: &apiextensions.ReferenceTarget{Resource: "configmaps", Scope: apiextensions.NamespaceScoped},
+						},
+						"deployment": {
+							Type:       "string",
+							XReference: &apiextensions.ReferenceTarget{Group: "apps", Resource: "deployments", Scope: apiextensions.NamespaceScoped},
+						},
+						"ingress": {
+							Type:       "string",
+							XReference: &apiextensions.ReferenceTarget{Group: "networking.k8s.io", Resource: "ingresses", Scope: apiextensions.NamespaceScoped},
+						},
+						"invalid": {
+							Type:       "integer",
+							XReference: &apiextensions.ReferenceTarget{Group: "Example_com", Resource: "SecretStores", Scope: "Global", Policy: "Fail"},
+						},
+						"missing": {
+							Type:       "string",
+							XReference: &apiextensions.ReferenceTarget{},
+						},
+					},
+				},
+			},
+			expectedErrors: []validationMatch{
+				required("spec.validation.openAPIV3Schema.properties[configMap].x-kubernetes-reference.group"),
+				invalid("spec.validation.openAPIV3Schema.properties[deployment].x-kubernetes-reference.group"),
+				invalid("spec.validation.openAPIV3Schema.properties[ingress].x-kubernetes-reference.group"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].type"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-reference.group"),
+				invalid("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-reference.resource"),
+				unsupported("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-reference.scope"),
+				unsupported("spec.validation.openAPIV3Schema.properties[invalid].x-kubernetes-reference.policy"),
+				required("spec.validation.openAPIV3Schema.properties[missing].x-kubernetes-reference.group"),
+				required("spec.validation.openAPIV3Schema.properties[missing].x-kubernetes-reference.resource"),
+				required("spec.validation.openAPIV3Schema.properties[missing].x-kubernetes-reference.scope"),
+			},
+		},
 		{
 			name: "invalid map with non-required key and no default",
 			input: apiextensions.CustomResourceValidation{
@@ -7827,3 +8310,93 @@ func Test_validateDeprecationWarning(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateCustomResourceGeneration(t *testing.T) {
+	fldPath := field.NewPath("spec", "versions").Index(0).Child("generation")
+	tests := []struct {
+		name       string
+		generation *apiextensions.CustomResourceGeneration
+		schema     *apiextensions.CustomResourceValidation
+		want       field.ErrorList
+	}{
+		{
+			name:       "nil",
+			generation: nil,
+		},
+		{
+			name:       "paths",
+			generation: &apiextensions.CustomResourceGeneration{Paths: []string{".spec.replicas", ".spec.template"}},
+		},
+		{
+			name:       "ignored paths",
+			generation: &apiextensions.CustomResourceGeneration{IgnoredPaths: []string{".spec.cache", ".status"}},
+		},
+		{
+			name:       "paths and ignored paths",
+			generation: &apiextensions.CustomResourceGeneration{Paths: []string{".spec"}, IgnoredPaths: []string{".status"}},
+			want:       field.ErrorList{field.Forbidden(fldPath.Child("ignoredPaths"), "must not be specified together with paths")},
+		},
+		{
+			name:       "invalid paths",
+			generation: &apiextensions.CustomResourceGeneration{Paths: []string{"", "spec", ".", ".metadata", ".metadata.labels", ".spec", ".spec"}},
+			want: field.ErrorList{
+				field.Invalid(fldPath.Child("paths").Index(0), "", "must not be empty"),
+				field.Invalid(fldPath.Child("paths").Index(1), "spec", "must be a simple json path starting with ."),
+				field.Invalid(fldPath.Child("paths").Index(2), ".", "must not be the root of the object"),
+				field.Invalid(fldPath.Child("paths").Index(3), ".metadata", "must not be a json path under .metadata"),
+				field.Invalid(fldPath.Child("paths").Index(4), ".metadata.labels", "must not be a json path under .metadata"),
+				field.Duplicate(fldPath.Child("paths").Index(6), ".spec"),
+			},
+		},
+		{
+			name:       "invalid ignored paths",
+			generation: &apiextensions.CustomResourceGeneration{IgnoredPaths: []string{".metadata.annotations", ".status", ".status"}},
+			want: field.ErrorList{
+				field.Invalid(fldPath.Child("ignoredPaths").Index(0), ".metadata.annotations", "must not be a json path under .metadata"),
+				field.Duplicate(fldPath.Child("ignoredPaths").Index(2), ".status"),
+			},
+		},
+		{
+			name:       "paths through dotted field names",
+			generation: &apiextensions.CustomResourceGeneration{Paths: []string{".spec.a.b", ".spec.a.b.c", ".spec.m.x.y.z", ".spec.r.c.d", ".spec.a"}},
+			schema: &apiextensions.CustomResourceValidation{OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"spec": {
+						Type: "object",
+						Properties: map[string]apiextensions.JSONSchemaProps{
+							"a.b": {Type: "object"},
+							"m": {Type: "object", AdditionalProperties: &apiextensions.JSONSchemaPropsOrBool{Schema: &apiextensions.JSONSchemaProps{
+								Type:       "object",
+								Properties: map[string]apiextensions.JSONSchemaProps{"y.z": {Type: "string"}},
+							}}},
+							"r": {Ref: strPtr("#/definitions/r")},
+						},
+					},
+				},
+				Definitions: apiextensions.JSONSchemaDefinitions{
+					"r": {Type: "object", Properties: map[string]apiextensions.JSONSchemaProps{"c.d": {Type: "string"}}},
+				},
+			}},
+			want: field.ErrorList{
+				field.Invalid(fldPath.Child("paths").Index(0), ".spec.a.b", `must not point to or through the field "a.b" whose name contains a dot`),
+				field.Invalid(fldPath.Child("paths").Index(1), ".spec.a.b.c", `must not point to or through the field "a.b" whose name contains a dot`),
+				field.Invalid(fldPath.Child("paths").Index(2), ".spec.m.x.y.z", `must not point to or through the field "y.z" whose name contains a dot`),
+				field.Invalid(fldPath.Child("paths").Index(3), ".spec.r.c.d", `must not point to or through the field "c.d" whose name contains a dot`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateCustomResourceGeneration(tt.generation, tt.schema, fldPath)
+			if len(got) != len(tt.want) {
+				t.Fatalf("validateCustomResourceGeneration() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Error() != tt.want[i].Error() {
+					t.Errorf("validateCustomResourceGeneration()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
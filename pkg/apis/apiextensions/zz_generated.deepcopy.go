@@ -207,6 +207,21 @@ func (in *CustomResourceDefinitionSpec) DeepCopyInto(out *CustomResourceDefiniti
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(CustomResourceLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(CustomResourceRetention)
+		**out = **in
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(CustomResourceHistory)
+		**out = **in
+	}
 	return
 }
 
@@ -272,6 +287,11 @@ func (in *CustomResourceDefinitionVersion) DeepCopyInto(out *CustomResourceDefin
 		*out = make([]CustomResourceColumnDefinition, len(*in))
 		copy(*out, *in)
 	}
+	if in.Generation != nil {
+		in, out := &in.Generation, &out.Generation
+		*out = new(CustomResourceGeneration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -285,6 +305,111 @@ func (in *CustomResourceDefinitionVersion) DeepCopy() *CustomResourceDefinitionV
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceGeneration) DeepCopyInto(out *CustomResourceGeneration) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoredPaths != nil {
+		in, out := &in.IgnoredPaths, &out.IgnoredPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceGeneration.
+func (in *CustomResourceGeneration) DeepCopy() *CustomResourceGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceHistory) DeepCopyInto(out *CustomResourceHistory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceHistory.
+func (in *CustomResourceHistory) DeepCopy() *CustomResourceHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceLimits) DeepCopyInto(out *CustomResourceLimits) {
+	*out = *in
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxObjectsPerNamespace != nil {
+		in, out := &in.MaxObjectsPerNamespace, &out.MaxObjectsPerNamespace
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxObjectSizeBytes != nil {
+		in, out := &in.MaxObjectSizeBytes, &out.MaxObjectSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceLimits.
+func (in *CustomResourceLimits) DeepCopy() *CustomResourceLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceRetention) DeepCopyInto(out *CustomResourceRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceRetention.
+func (in *CustomResourceRetention) DeepCopy() *CustomResourceRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceSubresourceCustom) DeepCopyInto(out *CustomResourceSubresourceCustom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceSubresourceCustom.
+func (in *CustomResourceSubresourceCustom) DeepCopy() *CustomResourceSubresourceCustom {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceSubresourceCustom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourceSubresourceScale) DeepCopyInto(out *CustomResourceSubresourceScale) {
 	*out = *in
@@ -335,6 +460,11 @@ func (in *CustomResourceSubresources) DeepCopyInto(out *CustomResourceSubresourc
 		*out = new(CustomResourceSubresourceScale)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = make([]CustomResourceSubresourceCustom, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -368,6 +498,22 @@ func (in *CustomResourceValidation) DeepCopy() *CustomResourceValidation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmbeddedResourceKind) DeepCopyInto(out *EmbeddedResourceKind) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmbeddedResourceKind.
+func (in *EmbeddedResourceKind) DeepCopy() *EmbeddedResourceKind {
+	if in == nil {
+		return nil
+	}
+	out := new(EmbeddedResourceKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalDocumentation) DeepCopyInto(out *ExternalDocumentation) {
 	*out = *in
@@ -428,6 +574,36 @@ func (in JSONSchemaDependencies) DeepCopy() JSONSchemaDependencies {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in JSONSchemaDependentRequired) DeepCopyInto(out *JSONSchemaDependentRequired) {
+	{
+		in := &in
+		*out = make(JSONSchemaDependentRequired, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONSchemaDependentRequired.
+func (in JSONSchemaDependentRequired) DeepCopy() JSONSchemaDependentRequired {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONSchemaDependentRequired)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONSchemaProps) DeepCopyInto(out *JSONSchemaProps) {
 	clone := in.DeepCopy()
@@ -507,6 +683,22 @@ func (in *JSONSchemaPropsOrStringArray) DeepCopy() *JSONSchemaPropsOrStringArray
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceTarget) DeepCopyInto(out *ReferenceTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceTarget.
+func (in *ReferenceTarget) DeepCopy() *ReferenceTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
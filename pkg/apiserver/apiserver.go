@@ -25,6 +25,8 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/embedded"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	externalinformers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
 	"k8s.io/apiextensions-apiserver/pkg/controller/apiapproval"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/controller/nonstructuralschema"
 	openapicontroller "k8s.io/apiextensions-apiserver/pkg/controller/openapi"
 	openapiv3controller "k8s.io/apiextensions-apiserver/pkg/controller/openapiv3"
+	"k8s.io/apiextensions-apiserver/pkg/controller/retention"
 	"k8s.io/apiextensions-apiserver/pkg/controller/status"
 	"k8s.io/apiextensions-apiserver/pkg/registry/customresourcedefinition"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,6 +52,7 @@ import (
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/apiserver/pkg/util/webhook"
+	"k8s.io/client-go/dynamic"
 )
 
 var (
@@ -87,6 +91,21 @@ type ExtraConfig struct {
 	ServiceResolver webhook.ServiceResolver
 	// AuthResolverWrapper is used in CR webhook converters
 	AuthResolverWrapper webhook.AuthenticationInfoResolverWrapper
+
+	// Formats are additional string formats supported in the schemas of the custom resources served
+	// by this server, on top of the OpenAPI and Kubernetes specific formats. Their names must be new.
+	// Like the Kubernetes specific formats, they are only enforced on changed values when custom
+	// resources are updated. The defaults in CRD schemas are validated without them.
+	Formats []apiservervalidation.Format
+
+	// EmbeddedResourceSchemaResolver resolves the schemas of built-in kinds allowed by
+	// x-kubernetes-embedded-resource-kinds. If nil, the kinds of the static OpenAPI spec of
+	// this server are resolved. Custom resources are always resolved from their CRDs.
+	EmbeddedResourceSchemaResolver embedded.Resolver
+
+	// CRDStorageOverrides are per-CRD storage settings overriding those of CRDRESTOptionsGetter.
+	// They are optional.
+	CRDStorageOverrides *CRDStorageOverrides
 }
 
 type Config struct {
@@ -143,6 +162,13 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		return nil, err
 	}
 
+	formats := apiservervalidation.NewFormatRegistry()
+	for _, f := range c.ExtraConfig.Formats {
+		if err := formats.Register(f); err != nil {
+			return nil, err
+		}
+	}
+
 	s := &CustomResourceDefinitions{
 		GenericAPIServer: genericServer,
 	}
@@ -174,6 +200,12 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	}
 	s.Informers = externalinformers.NewSharedInformerFactory(crdClient, 5*time.Minute)
 
+	dynamicClient, err := dynamic.NewForConfig(s.GenericAPIServer.LoopbackClientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %v", err)
+	}
+	referenceLister := newCustomResourceReferenceLister(s.Informers.Apiextensions().V1().CustomResourceDefinitions().Lister(), dynamicClient)
+
 	delegateHandler := delegationTarget.UnprotectedHandler()
 	if delegateHandler == nil {
 		delegateHandler = http.NotFoundHandler()
@@ -203,6 +235,10 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		c.GenericConfig.RequestTimeout,
 		time.Duration(c.GenericConfig.MinRequestTimeout)*time.Second,
 		apiGroupInfo.StaticOpenAPISpec,
+		c.ExtraConfig.EmbeddedResourceSchemaResolver,
+		referenceLister,
+		formats,
+		c.ExtraConfig.CRDStorageOverrides,
 		c.GenericConfig.MaxRequestBodyBytes,
 	)
 	if err != nil {
@@ -220,6 +256,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		crdClient.ApiextensionsV1(),
 		crdHandler,
 	)
+	retentionController := retention.NewRetentionController(s.Informers.Apiextensions().V1().CustomResourceDefinitions(), crdHandler)
 	openapiController := openapicontroller.NewController(s.Informers.Apiextensions().V1().CustomResourceDefinitions())
 	var openapiv3Controller *openapiv3controller.Controller
 	if utilfeature.DefaultFeatureGate.Enabled(features.OpenAPIV3) {
@@ -228,6 +265,8 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 
 	s.GenericAPIServer.AddPostStartHookOrDie("start-apiextensions-informers", func(context genericapiserver.PostStartHookContext) error {
 		s.Informers.Start(context.StopCh)
+		referenceLister.Run(context.StopCh)
+		go c.ExtraConfig.CRDStorageOverrides.Run(context.StopCh)
 		return nil
 	})
 	s.GenericAPIServer.AddPostStartHookOrDie("start-apiextensions-controllers", func(context genericapiserver.PostStartHookContext) error {
@@ -247,6 +286,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		go nonStructuralSchemaController.Run(5, context.StopCh)
 		go apiApprovalController.Run(5, context.StopCh)
 		go finalizingController.Run(5, context.StopCh)
+		go retentionController.Run(context.StopCh)
 
 		discoverySyncedCh := make(chan struct{})
 		go discoveryController.Run(context.StopCh, discoverySyncedCh)
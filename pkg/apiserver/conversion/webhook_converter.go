@@ -22,9 +22,13 @@ import (
 	"fmt"
 	"time"
 
+	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/sensitive"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -71,6 +75,10 @@ type webhookConverter struct {
 	nopConverter  nopConverter
 
 	conversionReviewVersions []string
+
+	// sensitiveSchemas are the structural schemas of the versions with fields marked with
+	// x-kubernetes-sensitive, whose values are redacted in conversion errors.
+	sensitiveSchemas map[string]*structuralschema.Structural
 }
 
 func webhookClientConfigForCRD(crd *apiextensionsv1.CustomResourceDefinition) *webhook.ClientConfig {
@@ -109,9 +117,34 @@ func (f *webhookConverterFactory) NewWebhookConverter(crd *apiextensionsv1.Custo
 		nopConverter:  nopConverter{},
 
 		conversionReviewVersions: crd.Spec.Conversion.Webhook.ConversionReviewVersions,
+		sensitiveSchemas:         sensitiveSchemasForCRD(crd),
 	}, nil
 }
 
+// sensitiveSchemasForCRD returns the structural schemas of the versions of crd with fields marked
+// with x-kubernetes-sensitive.
+func sensitiveSchemasForCRD(crd *apiextensionsv1.CustomResourceDefinition) map[string]*structuralschema.Structural {
+	ret := map[string]*structuralschema.Structural{}
+	for _, v := range crd.Spec.Versions {
+		val, err := apiextensionshelpers.GetSchemaForVersion(crd, v.Name)
+		if err != nil || val == nil {
+			continue
+		}
+		internalValidation := &apiextensionsinternal.CustomResourceValidation{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(val, internalValidation, nil); err != nil {
+			continue
+		}
+		s, err := structuralschema.NewStructural(internalValidation.OpenAPIV3Schema)
+		if err != nil {
+			continue
+		}
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return e.XSensitive }) {
+			ret[v.Name] = s
+		}
+	}
+	return ret
+}
+
 // getObjectsToConvert returns a list of objects requiring conversion.
 // if obj is a list, getObjectsToConvert returns a (potentially empty) list of the items that are not already in the desired version.
 // if obj is not a list, and is already in the desired version, getObjectsToConvert returns an empty list.
@@ -226,7 +259,37 @@ func getConvertedObjectsFromResponse(expectedUID types.UID, response runtime.Obj
 	}
 }
 
+// Convert calls the conversion webhook to convert in to toGV. The values of fields marked with
+// x-kubernetes-sensitive in in are redacted in the returned error.
 func (c *webhookConverter) Convert(in runtime.Object, toGV schema.GroupVersion) (runtime.Object, error) {
+	out, err := c.convert(in, toGV)
+	if err != nil && len(c.sensitiveSchemas) > 0 {
+		return nil, errors.New(sensitive.RedactString(err.Error(), c.sensitiveValues(in)))
+	}
+	return out, err
+}
+
+// sensitiveValues returns the values of the fields of in, or of its items if in is a list, marked
+// with x-kubernetes-sensitive.
+func (c *webhookConverter) sensitiveValues(in runtime.Object) []string {
+	var objects []unstructured.Unstructured
+	switch in := in.(type) {
+	case *unstructured.Unstructured:
+		objects = append(objects, *in)
+	case *unstructured.UnstructuredList:
+		objects = in.Items
+	}
+
+	var values []string
+	for _, obj := range objects {
+		if s, ok := c.sensitiveSchemas[obj.GroupVersionKind().Version]; ok {
+			values = append(values, sensitive.Values(obj.Object, s)...)
+		}
+	}
+	return values
+}
+
+func (c *webhookConverter) convert(in runtime.Object, toGV schema.GroupVersion) (runtime.Object, error) {
 	// In general, the webhook should not do any defaulting or validation. A special case of that is an empty object
 	// conversion that must result an empty object and practically is the same as nopConverter.
 	// A smoke test in API machinery calls the converter on empty objects. As this case happens consistently
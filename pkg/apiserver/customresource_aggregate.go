@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metainternalversionscheme "k8s.io/apimachinery/pkg/apis/meta/internalversion/scheme"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// serveAggregate serves list requests with the groupBy query parameter, a comma-separated list of
+// keys. Instead of the custom resources, the counts of the matching custom resources grouped by the
+// values of the keys are returned as JSON. Like other list requests, they are authorized through
+// the list verb.
+func (r *crdHandler) serveAggregate(requestInfo *apirequest.RequestInfo, crdInfo *crdInfo) http.HandlerFunc {
+	gv := schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}
+	storage := crdInfo.storages[requestInfo.APIVersion].CustomResource
+	scope := crdInfo.requestScopes[requestInfo.APIVersion]
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		options := &metainternalversion.ListOptions{}
+		if err := metainternalversionscheme.ParameterCodec.DecodeParameters(query, scope.MetaGroupVersion, options); err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest(err.Error()), Codecs, gv, w, req)
+			return
+		}
+		var groupBy []string
+		for _, key := range strings.Split(query.Get("groupBy"), ",") {
+			if key = strings.TrimSpace(key); len(key) > 0 {
+				groupBy = append(groupBy, key)
+			}
+		}
+
+		ctx := apirequest.WithNamespace(req.Context(), requestInfo.Namespace)
+		result, err := storage.Aggregate(ctx, options, groupBy)
+		if err != nil {
+			responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusOK, result, w)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+// withCELSelector passes the celSelector query parameter of the list and watch requests served by
+// handler to the storage. It is a CEL expression over the custom resource, `self`, evaluating to a
+// bool, and is compiled against the structural schema of the version like validation rules.
+func withCELSelector(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		if _, ok := query["celSelector"]; !ok {
+			handler(w, req)
+			return
+		}
+		ctx := customresource.WithCELSelector(req.Context(), query.Get("celSelector"))
+		handler(w, req.WithContext(ctx))
+	}
+}
@@ -165,6 +165,17 @@ func (c *DiscoveryController) sync(version schema.GroupVersion) error {
 				Verbs:      metav1.Verbs([]string{"get", "patch", "update"}),
 			})
 		}
+
+		if subresources != nil {
+			for _, custom := range subresources.Custom {
+				apiResourcesForDiscovery = append(apiResourcesForDiscovery, metav1.APIResource{
+					Name:       crd.Status.AcceptedNames.Plural + "/" + custom.Name,
+					Namespaced: crd.Spec.Scope == apiextensionsv1.NamespaceScoped,
+					Kind:       crd.Status.AcceptedNames.Kind,
+					Verbs:      metav1.Verbs([]string{"get", "patch", "update"}),
+				})
+			}
+		}
 	}
 
 	if !foundGroup {
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"sync"
+
+	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/embedded"
+	listers "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+// crdEmbeddedResourceResolver resolves the schemas of custom resources embedded in other
+// custom resources from the established CustomResourceDefinitions.
+type crdEmbeddedResourceResolver struct {
+	crdLister listers.CustomResourceDefinitionLister
+
+	lock sync.Mutex
+	// schemas caches the schemas per CRD and version, valid for the given resourceVersion of the CRD
+	schemas map[types.UID]*crdEmbeddedResourceSchemas
+}
+
+type crdEmbeddedResourceSchemas struct {
+	resourceVersion string
+	versions        map[string]*embedded.Schema
+}
+
+func newCRDEmbeddedResourceResolver(crdLister listers.CustomResourceDefinitionLister) *crdEmbeddedResourceResolver {
+	return &crdEmbeddedResourceResolver{
+		crdLister: crdLister,
+		schemas:   map[types.UID]*crdEmbeddedResourceSchemas{},
+	}
+}
+
+// Resolve implements embedded.Resolver.
+func (r *crdEmbeddedResourceResolver) Resolve(gvk schema.GroupVersionKind) (*embedded.Schema, error) {
+	crds, err := r.crdLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, crd := range crds {
+		if crd.Spec.Group != gvk.Group || crd.Status.AcceptedNames.Kind != gvk.Kind {
+			continue
+		}
+		if !apiextensionshelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established) || !apiextensionshelpers.HasServedCRDVersion(crd, gvk.Version) {
+			return nil, nil
+		}
+		return r.schemaFor(crd, gvk.Version)
+	}
+	return nil, nil
+}
+
+func (r *crdEmbeddedResourceResolver) schemaFor(crd *apiextensionsv1.CustomResourceDefinition, version string) (*embedded.Schema, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	cached, ok := r.schemas[crd.UID]
+	if !ok || cached.resourceVersion != crd.ResourceVersion {
+		cached = &crdEmbeddedResourceSchemas{resourceVersion: crd.ResourceVersion, versions: map[string]*embedded.Schema{}}
+		r.schemas[crd.UID] = cached
+	}
+	if s, ok := cached.versions[version]; ok {
+		return s, nil
+	}
+
+	val, err := apiextensionshelpers.GetSchemaForVersion(crd, version)
+	if err != nil {
+		return nil, err
+	}
+	internalSchema := &apiextensionsinternal.JSONSchemaProps{Type: "object", XPreserveUnknownFields: pointer.BoolPtr(true)}
+	if val != nil && val.OpenAPIV3Schema != nil {
+		internalValidation := &apiextensionsinternal.CustomResourceValidation{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(val, internalValidation, nil); err != nil {
+			return nil, fmt.Errorf("failed converting CRD validation to internal version: %v", err)
+		}
+		internalSchema = internalValidation.OpenAPIV3Schema
+	}
+	s, err := embedded.NewSchema(internalSchema)
+	if err != nil {
+		return nil, err
+	}
+	// like for the custom resources themselves, defaults which would be pruned are dropped
+	s.Structural = s.Structural.DeepCopy()
+	if err := structuraldefaulting.PruneDefaults(s.Structural); err != nil {
+		return nil, err
+	}
+
+	cached.versions[version] = s
+	return s, nil
+}
+
+// removeDeadSchemas drops the cached schemas of deleted CRDs.
+func (r *crdEmbeddedResourceResolver) removeDeadSchemas() {
+	crds, err := r.crdLister.List(labels.Everything())
+	if err != nil {
+		return
+	}
+	alive := map[types.UID]bool{}
+	for _, crd := range crds {
+		alive[crd.UID] = true
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for uid := range r.schemas {
+		if !alive[uid] {
+			delete(r.schemas, uid)
+		}
+	}
+}
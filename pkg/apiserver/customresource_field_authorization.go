@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/writepermission"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// fieldAuthorizingAdmission wraps an admission chain and rejects updates changing fields marked with
+// x-kubernetes-write-permission if the requester is not authorized to update the named subresource.
+// Updates of the scale subresource change the spec replicas field of the custom resource.
+type fieldAuthorizingAdmission struct {
+	delegate   admission.Interface
+	authorizer authorizer.Authorizer
+	// structuralSchemas are the structural schemas per version
+	structuralSchemas map[string]*structuralschema.Structural
+	// specReplicasPaths are the fields of the spec replicas paths of the scale subresources per version
+	specReplicasPaths map[string][]string
+}
+
+var _ admission.MutationInterface = fieldAuthorizingAdmission{}
+var _ admission.ValidationInterface = fieldAuthorizingAdmission{}
+
+// newFieldAuthorizingAdmission returns delegate, wrapped with field authorization if any of the
+// schemas has fields marked with x-kubernetes-write-permission.
+func newFieldAuthorizingAdmission(delegate admission.Interface, a authorizer.Authorizer, structuralSchemas map[string]*structuralschema.Structural, specReplicasPaths map[string][]string) admission.Interface {
+	if a == nil {
+		return delegate
+	}
+	for _, s := range structuralSchemas {
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return e.XWritePermission != nil }) {
+			return fieldAuthorizingAdmission{delegate: delegate, authorizer: a, structuralSchemas: structuralSchemas, specReplicasPaths: specReplicasPaths}
+		}
+	}
+	return delegate
+}
+
+// Handles returns true for updates, and for all operations handled by the delegate.
+func (f fieldAuthorizingAdmission) Handles(operation admission.Operation) bool {
+	return operation == admission.Update || (f.delegate != nil && f.delegate.Handles(operation))
+}
+
+// Admit calls the delegate if it is mutating.
+func (f fieldAuthorizingAdmission) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if mutating, ok := f.delegate.(admission.MutationInterface); ok && mutating.Handles(a.GetOperation()) {
+		return mutating.Admit(ctx, a, o)
+	}
+	return nil
+}
+
+// Validate calls the delegate if it is validating, and then checks that the requester is authorized
+// to change the protected fields changed by an update.
+func (f fieldAuthorizingAdmission) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if validating, ok := f.delegate.(admission.ValidationInterface); ok && validating.Handles(a.GetOperation()) {
+		if err := validating.Validate(ctx, a, o); err != nil {
+			return err
+		}
+	}
+	if a.GetOperation() != admission.Update {
+		return nil
+	}
+	oldObj, newObj, ok := f.customResources(a)
+	if !ok {
+		return nil
+	}
+	// the kind of scale updates is Scale, so the version is taken from the resource
+	s := f.structuralSchemas[a.GetResource().Version]
+
+	var errs field.ErrorList
+	authorized := map[string]bool{}
+	for _, change := range writepermission.Changes(s, oldObj, newObj) {
+		allowed, found := authorized[change.Subresource]
+		if !found {
+			var err error
+			if allowed, err = f.authorize(ctx, a, change.Subresource); err != nil {
+				return apierrors.NewInternalError(err)
+			}
+			authorized[change.Subresource] = allowed
+		}
+		if !allowed {
+			errs = append(errs, field.Forbidden(change.Path, fmt.Sprintf("changing this field requires permission to update %s/%s", a.GetResource().Resource, change.Subresource)))
+		}
+	}
+	if len(errs) > 0 {
+		return apierrors.NewForbidden(a.GetResource().GroupResource(), a.GetName(), errs.ToAggregate())
+	}
+	return nil
+}
+
+// customResources returns the content of the old and the new custom resource of the update a. The
+// scale subresource is mapped to custom resources with only the spec replicas field.
+func (f fieldAuthorizingAdmission) customResources(a admission.Attributes) (map[string]interface{}, map[string]interface{}, bool) {
+	if oldScale, ok := a.GetOldObject().(*autoscalingv1.Scale); ok {
+		newScale, ok := a.GetObject().(*autoscalingv1.Scale)
+		fields := f.specReplicasPaths[a.GetResource().Version]
+		if !ok || len(fields) == 0 {
+			return nil, nil, false
+		}
+		oldObj, newObj := map[string]interface{}{}, map[string]interface{}{}
+		if err := unstructured.SetNestedField(oldObj, int64(oldScale.Spec.Replicas), fields...); err != nil {
+			return nil, nil, false
+		}
+		if err := unstructured.SetNestedField(newObj, int64(newScale.Spec.Replicas), fields...); err != nil {
+			return nil, nil, false
+		}
+		return oldObj, newObj, true
+	}
+
+	newObj, ok := a.GetObject().(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, false
+	}
+	oldObj, ok := a.GetOldObject().(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, false
+	}
+	return oldObj.Object, newObj.Object, true
+}
+
+// authorize returns whether the requester of a is allowed to update the given subresource of the object.
+func (f fieldAuthorizingAdmission) authorize(ctx context.Context, a admission.Attributes, subresource string) (bool, error) {
+	resource := a.GetResource()
+	decision, _, err := f.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            "update",
+		Namespace:       a.GetNamespace(),
+		APIGroup:        resource.Group,
+		APIVersion:      resource.Version,
+		Resource:        resource.Resource,
+		Subresource:     subresource,
+		Name:            a.GetName(),
+		ResourceRequest: true,
+	})
+	if err != nil && decision != authorizer.DecisionAllow {
+		return false, err
+	}
+	return decision == authorizer.DecisionAllow, nil
+}
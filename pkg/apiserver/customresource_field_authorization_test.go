@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type subresourceAuthorizer map[string]bool
+
+func (a subresourceAuthorizer) Authorize(ctx context.Context, attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a[attrs.GetUser().GetName()+":"+attrs.GetVerb()+":"+attrs.GetResource()+"/"+attrs.GetSubresource()] {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionNoOpinion, "", nil
+}
+
+func TestFieldAuthorizingAdmission(t *testing.T) {
+	tier, approval := "fields/tier", "approval"
+	structuralSchemas := map[string]*structuralschema.Structural{
+		"v1beta1": {
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"spec": {
+					Generic: structuralschema.Generic{Type: "object"},
+					Properties: map[string]structuralschema.Structural{
+						"replicas": {
+							Generic:    structuralschema.Generic{Type: "integer"},
+							Extensions: structuralschema.Extensions{XWritePermission: &tier},
+						},
+						"tier": {
+							Generic:    structuralschema.Generic{Type: "string"},
+							Extensions: structuralschema.Extensions{XWritePermission: &tier},
+						},
+						"size": {Generic: structuralschema.Generic{Type: "integer"}},
+					},
+				},
+				"status": {
+					Generic: structuralschema.Generic{Type: "object"},
+					Properties: map[string]structuralschema.Structural{
+						"approved": {
+							Generic:    structuralschema.Generic{Type: "boolean"},
+							Extensions: structuralschema.Extensions{XWritePermission: &approval},
+						},
+					},
+				},
+			},
+		},
+	}
+	specReplicasPaths := map[string][]string{"v1beta1": {"spec", "replicas"}}
+	authz := subresourceAuthorizer{"admin:update:foos/fields/tier": true, "admin:update:foos/approval": true}
+
+	if a := newFieldAuthorizingAdmission(nil, authz, map[string]*structuralschema.Structural{"v1beta1": {}}, specReplicasPaths); a != nil {
+		t.Fatalf("expected no admission without write permissions, got %#v", a)
+	}
+	a, ok := newFieldAuthorizingAdmission(nil, authz, structuralSchemas, specReplicasPaths).(admission.ValidationInterface)
+	if !ok {
+		t.Fatalf("expected validating admission")
+	}
+
+	newObject := func(tier string, size int64, approved bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1beta1",
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{"name": "foo", "namespace": "default"},
+			"spec":       map[string]interface{}{"tier": tier, "replicas": int64(1), "size": size},
+			"status":     map[string]interface{}{"approved": approved},
+		}}
+	}
+	newScale := func(replicas int32) *autoscalingv1.Scale {
+		return &autoscalingv1.Scale{Spec: autoscalingv1.ScaleSpec{Replicas: replicas}}
+	}
+
+	tests := []struct {
+		name        string
+		user        string
+		old, new    runtime.Object
+		subresource string
+		expectedErr string
+	}{
+		{"unprotected change", "user", newObject("gold", 1, false), newObject("gold", 2, false), "", ""},
+		{"protected change by admin", "admin", newObject("gold", 1, false), newObject("silver", 1, false), "", ""},
+		{"protected change by user", "user", newObject("gold", 1, false), newObject("silver", 1, false), "", "spec.tier: Forbidden: changing this field requires permission to update foos/fields/tier"},
+		{"unprotected status change", "user", newObject("gold", 1, false), newObject("gold", 1, false), "status", ""},
+		{"protected status change by admin", "admin", newObject("gold", 1, false), newObject("gold", 1, true), "status", ""},
+		{"protected status change by user", "user", newObject("gold", 1, false), newObject("gold", 1, true), "status", "status.approved: Forbidden: changing this field requires permission to update foos/approval"},
+		{"protected change through a custom subresource by user", "user", newObject("gold", 1, false), newObject("silver", 1, false), "tiering", "spec.tier: Forbidden: changing this field requires permission to update foos/fields/tier"},
+		{"unchanged scale by user", "user", newScale(1), newScale(1), "scale", ""},
+		{"protected scale change by admin", "admin", newScale(1), newScale(3), "scale", ""},
+		{"protected scale change by user", "user", newScale(1), newScale(3), "scale", "spec.replicas: Forbidden: changing this field requires permission to update foos/fields/tier"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind := schema.GroupVersionKind{Group: "example.com", Version: "v1beta1", Kind: "Foo"}
+			if tt.subresource == "scale" {
+				kind = autoscalingv1.SchemeGroupVersion.WithKind("Scale")
+			}
+			attrs := admission.NewAttributesRecord(tt.new, tt.old, kind, "default", "foo",
+				schema.GroupVersionResource{Group: "example.com", Version: "v1beta1", Resource: "foos"}, tt.subresource,
+				admission.Update, nil, false, &user.DefaultInfo{Name: tt.user})
+			err := a.Validate(context.TODO(), attrs, nil)
+			if len(tt.expectedErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !apierrors.IsForbidden(err) {
+				t.Fatalf("expected forbidden error, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("expected error to contain %q, got %q", tt.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestAuthorizeResource(t *testing.T) {
+	r := &crdHandler{authorizer: subresourceAuthorizer{"admin:update:foos/": true, "admin:create:foos/": true}}
+
+	tests := []struct {
+		name        string
+		user        string
+		subresource string
+		verb        string
+		expectedErr string
+	}{
+		{"history restore by admin", "admin", "history", "update", ""},
+		{"history restore by user", "user", "history", "update", "foos/history requires permission to update the resource"},
+		{"trash restore by admin", "admin", "restore", "create", ""},
+		{"trash restore by user", "user", "restore", "create", "foos/restore requires permission to create the resource"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := apirequest.WithUser(context.TODO(), &user.DefaultInfo{Name: tt.user})
+			requestInfo := &apirequest.RequestInfo{APIGroup: "example.com", APIVersion: "v1beta1", Resource: "foos", Subresource: tt.subresource, Namespace: "default", Name: "foo"}
+			err := r.authorizeResource(ctx, requestInfo, tt.verb)
+			if len(tt.expectedErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !apierrors.IsForbidden(err) {
+				t.Fatalf("expected forbidden error, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("expected error to contain %q, got %q", tt.expectedErr, err.Error())
+			}
+		})
+	}
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package apiserver
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"path"
 	"sort"
@@ -27,6 +30,7 @@ import (
 	"time"
 
 	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/typed"
 
 	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
 	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -34,14 +38,17 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/conversion"
 	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/embedded"
 	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
 	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/reference"
 	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	informers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions/apiextensions/v1"
 	listers "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/controller/establish"
 	"k8s.io/apiextensions-apiserver/pkg/controller/finalizer"
 	"k8s.io/apiextensions-apiserver/pkg/controller/openapi/builder"
+	"k8s.io/apiextensions-apiserver/pkg/controller/retention"
 	"k8s.io/apiextensions-apiserver/pkg/crdserverscheme"
 	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
 	"k8s.io/apiextensions-apiserver/pkg/registry/customresource/tableconvertor"
@@ -86,7 +93,6 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kube-openapi/pkg/util/proto"
 	"k8s.io/kube-openapi/pkg/validation/spec"
-	"k8s.io/kube-openapi/pkg/validation/strfmt"
 	"k8s.io/kube-openapi/pkg/validation/validate"
 )
 
@@ -131,6 +137,22 @@ type crdHandler struct {
 	// of TypeMeta and ObjectMeta
 	staticOpenAPISpec *spec.Swagger
 
+	// embeddedResourceResolver resolves the schemas of the kinds allowed by
+	// x-kubernetes-embedded-resource-kinds.
+	embeddedResourceResolver embedded.Resolver
+	// crdEmbeddedResourceResolver resolves the schemas of embedded custom resources.
+	crdEmbeddedResourceResolver *crdEmbeddedResourceResolver
+
+	// referenceLister looks up the referents of x-kubernetes-reference fields. If nil, references
+	// are not checked.
+	referenceLister reference.Lister
+
+	// formats are the string formats supported in the schemas of the served custom resources.
+	formats *apiservervalidation.FormatRegistry
+
+	// storageOverrides are the per-CRD storage settings. Storages are re-created when they change.
+	storageOverrides *CRDStorageOverrides
+
 	// The limit on the request size that would be accepted and decoded in a write request
 	// 0 means no limit.
 	maxRequestBodyBytes int64
@@ -161,9 +183,20 @@ type crdInfo struct {
 	// Status scope per version
 	statusRequestScopes map[string]*handlers.RequestScope
 
+	// Custom subresource scopes per version and subresource name
+	customRequestScopes map[string]map[string]*handlers.RequestScope
+
 	// storageVersion is the CRD version used when storing the object in etcd.
 	storageVersion string
 
+	// maxPageSize caps the limit of list requests which set one. 0 means unset.
+	maxPageSize int64
+
+	// updateAdmission is the admission used for updates and patches of the main resource, and of the
+	// status, scale and custom subresources. It authorizes changes of fields with
+	// x-kubernetes-write-permission.
+	updateAdmission admission.Interface
+
 	waitGroup *utilwaitgroup.SafeWaitGroup
 }
 
@@ -185,6 +218,10 @@ func NewCustomResourceDefinitionHandler(
 	requestTimeout time.Duration,
 	minRequestTimeout time.Duration,
 	staticOpenAPISpec *spec.Swagger,
+	embeddedResourceSchemaResolver embedded.Resolver,
+	referenceLister reference.Lister,
+	formats *apiservervalidation.FormatRegistry,
+	storageOverrides *CRDStorageOverrides,
 	maxRequestBodyBytes int64) (*crdHandler, error) {
 	ret := &crdHandler{
 		versionDiscoveryHandler: versionDiscoveryHandler,
@@ -201,12 +238,26 @@ func NewCustomResourceDefinitionHandler(
 		minRequestTimeout:       minRequestTimeout,
 		staticOpenAPISpec:       staticOpenAPISpec,
 		maxRequestBodyBytes:     maxRequestBodyBytes,
+		referenceLister:         referenceLister,
+		formats:                 formats,
+		storageOverrides:        storageOverrides,
 	}
+	// without a registry of the server, the OpenAPI and Kubernetes specific formats are supported
+	if ret.formats == nil {
+		ret.formats = apiservervalidation.DefaultFormatRegistry
+	}
+	// built-in kinds are resolved by the given resolver, or from the static OpenAPI spec
+	if embeddedResourceSchemaResolver == nil {
+		embeddedResourceSchemaResolver = embedded.NewOpenAPIResolver(staticOpenAPISpec)
+	}
+	ret.crdEmbeddedResourceResolver = newCRDEmbeddedResourceResolver(ret.crdLister)
+	ret.embeddedResourceResolver = embedded.Resolvers{embeddedResourceSchemaResolver, ret.crdEmbeddedResourceResolver}
 	crdInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    ret.createCustomResourceDefinition,
 		UpdateFunc: ret.updateCustomResourceDefinition,
 		DeleteFunc: func(obj interface{}) {
 			ret.removeDeadStorage()
+			ret.crdEmbeddedResourceResolver.removeDeadSchemas()
 		},
 	})
 	crConverterFactory, err := conversion.NewCRConverterFactory(serviceResolver, authResolverWrapper)
@@ -214,6 +265,9 @@ func NewCustomResourceDefinitionHandler(
 		return nil, err
 	}
 	ret.converterFactory = crConverterFactory
+	if storageOverrides != nil {
+		storageOverrides.AddListener(ret.storageOverrideChanged)
+	}
 
 	ret.customStorage.Store(crdStorageMap{})
 
@@ -351,6 +405,16 @@ func (r *crdHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		handlerFunc = r.serveStatus(w, req, requestInfo, crdInfo, terminating, supportedTypes)
 	case subresource == "scale" && subresources != nil && subresources.Scale != nil:
 		handlerFunc = r.serveScale(w, req, requestInfo, crdInfo, terminating, supportedTypes)
+	case hasCustomSubresource(subresources, subresource):
+		handlerFunc = r.serveCustomSubresource(w, req, requestInfo, crdInfo, terminating, supportedTypes)
+	case subresource == "lint":
+		handlerFunc = r.serveLint(w, req, requestInfo, crdInfo)
+	case subresource == "deleted" && crdInfo.storages[requestInfo.APIVersion].Trash != nil:
+		handlerFunc = r.serveDeleted(w, req, requestInfo, crdInfo)
+	case subresource == "restore" && crdInfo.storages[requestInfo.APIVersion].Trash != nil:
+		handlerFunc = r.serveRestore(w, req, requestInfo, crdInfo, terminating)
+	case subresource == "history" && crdInfo.storages[requestInfo.APIVersion].History != nil:
+		handlerFunc = r.serveHistory(w, req, requestInfo, crdInfo)
 	case len(subresource) == 0:
 		handlerFunc = r.serveResource(w, req, requestInfo, crdInfo, crd, terminating, supportedTypes)
 	default:
@@ -374,13 +438,16 @@ func (r *crdHandler) serveResource(w http.ResponseWriter, req *http.Request, req
 
 	switch requestInfo.Verb {
 	case "get":
-		return handlers.GetResource(storage, requestScope)
+		return withFieldProjection(handlers.GetResource(storage, requestScope), requestScope)
 	case "list":
+		if _, ok := req.URL.Query()["groupBy"]; ok {
+			return withCELSelector(r.serveAggregate(requestInfo, crdInfo))
+		}
 		forceWatch := false
-		return handlers.ListResource(storage, storage, requestScope, forceWatch, r.minRequestTimeout)
+		return withFieldProjection(withCELSelector(withSortOptions(withMaxPageSize(handlers.ListResource(storage, storage, requestScope, forceWatch, r.minRequestTimeout), crdInfo.maxPageSize), requestInfo)), requestScope)
 	case "watch":
 		forceWatch := true
-		return handlers.ListResource(storage, storage, requestScope, forceWatch, r.minRequestTimeout)
+		return withCELSelector(handlers.ListResource(storage, storage, requestScope, forceWatch, r.minRequestTimeout))
 	case "create":
 		// we want to track recently created CRDs so that in HA environments we don't have server A allow a create and server B
 		// not have observed the established, so a followup get,update,delete results in a 404. We've observed about 800ms
@@ -397,9 +464,9 @@ func (r *crdHandler) serveResource(w http.ResponseWriter, req *http.Request, req
 		}
 		return handlers.CreateResource(storage, requestScope, r.admission)
 	case "update":
-		return handlers.UpdateResource(storage, requestScope, r.admission)
+		return handlers.UpdateResource(storage, requestScope, crdInfo.updateAdmission)
 	case "patch":
-		return handlers.PatchResource(storage, requestScope, r.admission, supportedTypes)
+		return handlers.PatchResource(storage, requestScope, crdInfo.updateAdmission, supportedTypes)
 	case "delete":
 		allowsOptions := true
 		return handlers.DeleteResource(storage, allowsOptions, requestScope, r.admission)
@@ -423,9 +490,29 @@ func (r *crdHandler) serveStatus(w http.ResponseWriter, req *http.Request, reque
 	case "get":
 		return handlers.GetResource(storage, requestScope)
 	case "update":
-		return handlers.UpdateResource(storage, requestScope, r.admission)
+		return handlers.UpdateResource(storage, requestScope, crdInfo.updateAdmission)
+	case "patch":
+		return handlers.PatchResource(storage, requestScope, crdInfo.updateAdmission, supportedTypes)
+	default:
+		responsewriters.ErrorNegotiated(
+			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
+			Codecs, schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}, w, req,
+		)
+		return nil
+	}
+}
+
+func (r *crdHandler) serveCustomSubresource(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo, terminating bool, supportedTypes []string) http.HandlerFunc {
+	requestScope := crdInfo.customRequestScopes[requestInfo.APIVersion][requestInfo.Subresource]
+	storage := crdInfo.storages[requestInfo.APIVersion].Custom[requestInfo.Subresource]
+
+	switch requestInfo.Verb {
+	case "get":
+		return handlers.GetResource(storage, requestScope)
+	case "update":
+		return handlers.UpdateResource(storage, requestScope, crdInfo.updateAdmission)
 	case "patch":
-		return handlers.PatchResource(storage, requestScope, r.admission, supportedTypes)
+		return handlers.PatchResource(storage, requestScope, crdInfo.updateAdmission, supportedTypes)
 	default:
 		responsewriters.ErrorNegotiated(
 			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
@@ -435,6 +522,68 @@ func (r *crdHandler) serveStatus(w http.ResponseWriter, req *http.Request, reque
 	}
 }
 
+// hasCustomSubresource returns true if subresources define a custom subresource with the given name.
+func hasCustomSubresource(subresources *apiextensionsv1.CustomResourceSubresources, name string) bool {
+	if subresources == nil || len(name) == 0 {
+		return false
+	}
+	for _, custom := range subresources.Custom {
+		if custom.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeResource returns Forbidden unless the requester is allowed the verb on the custom
+// resource of requestInfo. Subresources acting on the custom resource, like restoring it, require
+// this in addition to the authorization of the subresource request.
+func (r *crdHandler) authorizeResource(ctx context.Context, requestInfo *apirequest.RequestInfo, verb string) error {
+	if r.authorizer == nil {
+		return nil
+	}
+	userInfo, _ := apirequest.UserFrom(ctx)
+	decision, _, err := r.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            userInfo,
+		Verb:            verb,
+		Namespace:       requestInfo.Namespace,
+		APIGroup:        requestInfo.APIGroup,
+		APIVersion:      requestInfo.APIVersion,
+		Resource:        requestInfo.Resource,
+		Name:            requestInfo.Name,
+		ResourceRequest: true,
+	})
+	if decision == authorizer.DecisionAllow {
+		return nil
+	}
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	return apierrors.NewForbidden(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Name,
+		fmt.Errorf("%s/%s requires permission to %s the resource", requestInfo.Resource, requestInfo.Subresource, verb))
+}
+
+// withObjectBody returns a copy of req with obj encoded as JSON body and without the given query
+// parameters. Subresources acting on the custom resource, like restoring it, serve the copy with
+// the generic handlers of the custom resource, so that admission and field managers apply as for
+// any other write.
+func withObjectBody(req *http.Request, obj runtime.Object, dropParams ...string) (*http.Request, error) {
+	body, err := runtime.Encode(unstructured.UnstructuredJSONScheme, obj)
+	if err != nil {
+		return nil, err
+	}
+	ret := req.Clone(req.Context())
+	query := ret.URL.Query()
+	for _, p := range dropParams {
+		query.Del(p)
+	}
+	ret.URL.RawQuery = query.Encode()
+	ret.Body = ioutil.NopCloser(bytes.NewReader(body))
+	ret.ContentLength = int64(len(body))
+	ret.Header.Set("Content-Type", runtime.ContentTypeJSON)
+	return ret, nil
+}
+
 func (r *crdHandler) serveScale(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo, terminating bool, supportedTypes []string) http.HandlerFunc {
 	requestScope := crdInfo.scaleRequestScopes[requestInfo.APIVersion]
 	storage := crdInfo.storages[requestInfo.APIVersion].Scale
@@ -443,9 +592,9 @@ func (r *crdHandler) serveScale(w http.ResponseWriter, req *http.Request, reques
 	case "get":
 		return handlers.GetResource(storage, requestScope)
 	case "update":
-		return handlers.UpdateResource(storage, requestScope, r.admission)
+		return handlers.UpdateResource(storage, requestScope, crdInfo.updateAdmission)
 	case "patch":
-		return handlers.PatchResource(storage, requestScope, r.admission, supportedTypes)
+		return handlers.PatchResource(storage, requestScope, crdInfo.updateAdmission, supportedTypes)
 	default:
 		responsewriters.ErrorNegotiated(
 			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
@@ -513,6 +662,21 @@ func (r *crdHandler) updateCustomResourceDefinition(oldObj, newObj interface{})
 	r.removeStorage_locked(newCRD.UID)
 }
 
+// storageOverrideChanged removes the storage of the CRD of the given resource so it gets re-created
+// with the changed storage override
+func (r *crdHandler) storageOverrideChanged(gr schema.GroupResource) {
+	crd, err := r.crdLister.Get(gr.String())
+	if err != nil {
+		return
+	}
+
+	r.customStorageLock.Lock()
+	defer r.customStorageLock.Unlock()
+
+	klog.V(4).Infof("Re-creating the storage of customresourcedefinition %s because its storage override changed", crd.Name)
+	r.removeStorage_locked(crd.UID)
+}
+
 // removeStorage_locked removes the cached storage with the given uid as key from the storage map. This function
 // updates r.customStorage with the cleaned-up storageMap and tears down the old storage.
 // NOTE: Caller MUST hold r.customStorageLock to write r.customStorage thread-safely.
@@ -594,6 +758,20 @@ func (r *crdHandler) GetCustomResourceListerCollectionDeleter(crd *apiextensions
 	return info.storages[info.storageVersion].CustomResource, nil
 }
 
+// GetCustomResourceTrash returns the trash of the storage version of the given crd. It fails if
+// the served storage does not retain deleted custom resources.
+func (r *crdHandler) GetCustomResourceTrash(crd *apiextensionsv1.CustomResourceDefinition) (retention.Purger, error) {
+	info, err := r.getOrCreateServingInfoFor(crd.UID, crd.Name)
+	if err != nil {
+		return nil, err
+	}
+	trash := info.storages[info.storageVersion].Trash
+	if trash == nil {
+		return nil, fmt.Errorf("deleted %s.%s are not retained", crd.Status.AcceptedNames.Plural, crd.Spec.Group)
+	}
+	return trash, nil
+}
+
 // getOrCreateServingInfoFor gets the CRD serving info for the given CRD UID if the key exists in the storage map.
 // Otherwise the function fetches the up-to-date CRD using the given CRD name and creates CRD serving info.
 func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crdInfo, error) {
@@ -628,6 +806,7 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 	storages := map[string]customresource.CustomResourceStorage{}
 	statusScopes := map[string]*handlers.RequestScope{}
 	scaleScopes := map[string]*handlers.RequestScope{}
+	customScopes := map[string]map[string]*handlers.RequestScope{}
 	deprecated := map[string]bool{}
 	warnings := map[string][]string{}
 
@@ -680,6 +859,12 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 			return nil, err
 		}
 	}
+	for _, s := range structuralSchemas {
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return len(e.XNormalize) > 0 }) {
+			typeConverter = normalizingTypeConverter{TypeConverter: typeConverter, structuralSchemas: structuralSchemas}
+			break
+		}
+	}
 
 	safeConverter, unsafeConverter, err := r.converterFactory.NewConverter(crd)
 	if err != nil {
@@ -688,6 +873,8 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 
 	// Create replicasPathInCustomResource
 	replicasPathInCustomResource := fieldmanager.ResourcePathMappings{}
+	// specReplicasPaths holds the fields of the spec replicas paths of the scale subresources by version
+	specReplicasPaths := map[string][]string{}
 	for _, v := range crd.Spec.Versions {
 		subresources, err := apiextensionshelpers.GetSubresourcesForVersion(crd, v.Name)
 		if err != nil {
@@ -705,8 +892,27 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 			path = append(path, fieldpath.PathElement{FieldName: &s})
 		}
 		replicasPathInCustomResource[schema.GroupVersion{Group: crd.Spec.Group, Version: v.Name}.String()] = path
+		specReplicasPaths[v.Name] = splitReplicasPath
 	}
 
+	// the limits are shared by the storages of all versions
+	var limits *customresource.ObjectLimits
+	if crd.Spec.Limits != nil {
+		internalLimits := &apiextensionsinternal.CustomResourceLimits{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceLimits_To_apiextensions_CustomResourceLimits(crd.Spec.Limits, internalLimits, nil); err != nil {
+			return nil, fmt.Errorf("failed converting CRD limits to internal version: %v", err)
+		}
+		limits = customresource.NewObjectLimits(schema.GroupResource{Group: crd.Spec.Group, Resource: crd.Status.AcceptedNames.Plural}, internalLimits)
+	}
+
+	var maxRevisions int32
+	if crd.Spec.History != nil {
+		maxRevisions = crd.Spec.History.MaxRevisions
+	}
+
+	storageOverride, _ := r.storageOverrides.Get(schema.GroupResource{Group: crd.Spec.Group, Resource: crd.Status.AcceptedNames.Plural})
+	maxPageSize := storageOverride.maxPageSize()
+
 	for _, v := range crd.Spec.Versions {
 		// In addition to Unstructured objects (Custom Resources), we also may sometimes need to
 		// decode unversioned Options objects, so we delegate to parameterScheme for such types.
@@ -737,7 +943,7 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 				return nil, fmt.Errorf("failed to convert CRD validation to internal version: %v", err)
 			}
 		}
-		validator, _, err := apiservervalidation.NewSchemaValidator(internalValidationSchema)
+		validator, _, err := apiservervalidation.NewSchemaValidatorWithFormats(internalValidationSchema, r.formats)
 		if err != nil {
 			return nil, err
 		}
@@ -758,11 +964,16 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 			// for the status subresource, validate only against the status schema
 			if internalValidationSchema != nil && internalValidationSchema.OpenAPIV3Schema != nil && internalValidationSchema.OpenAPIV3Schema.Properties != nil {
 				if statusSchema, ok := internalValidationSchema.OpenAPIV3Schema.Properties["status"]; ok {
+					// the status schema can reference the definitions at the root
+					statusSchema.Definitions = internalValidationSchema.OpenAPIV3Schema.Definitions
 					openapiSchema := &spec.Schema{}
-					if err := apiservervalidation.ConvertJSONSchemaPropsWithPostProcess(&statusSchema, openapiSchema, apiservervalidation.StripUnsupportedFormatsPostProcess); err != nil {
+					if err := apiservervalidation.ConvertJSONSchemaPropsWithPostProcess(&statusSchema, openapiSchema, r.formats.StripUnsupportedFormatsPostProcess); err != nil {
+						return nil, err
+					}
+					if err := apiservervalidation.ResolveLocalReferences(openapiSchema); err != nil {
 						return nil, err
 					}
-					statusValidator = validate.NewSchemaValidator(openapiSchema, nil, "", strfmt.Default)
+					statusValidator = validate.NewSchemaValidator(openapiSchema, nil, "", r.formats.Registry())
 				}
 			}
 		}
@@ -776,30 +987,61 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 			}
 		}
 
+		var customSpecs []apiextensionsinternal.CustomResourceSubresourceCustom
+		if subresources != nil {
+			for i := range subresources.Custom {
+				equivalentResourceRegistry.RegisterKindFor(resource, subresources.Custom[i].Name, kind)
+				customSpec := apiextensionsinternal.CustomResourceSubresourceCustom{}
+				if err := apiextensionsv1.Convert_v1_CustomResourceSubresourceCustom_To_apiextensions_CustomResourceSubresourceCustom(&subresources.Custom[i], &customSpec, nil); err != nil {
+					return nil, fmt.Errorf("failed converting CRD custom subresource to internal version: %v", err)
+				}
+				customSpecs = append(customSpecs, customSpec)
+			}
+		}
+
+		var generationSpec *apiextensionsinternal.CustomResourceGeneration
+		if v.Generation != nil {
+			generationSpec = &apiextensionsinternal.CustomResourceGeneration{}
+			if err := apiextensionsv1.Convert_v1_CustomResourceGeneration_To_apiextensions_CustomResourceGeneration(v.Generation, generationSpec, nil); err != nil {
+				return nil, fmt.Errorf("failed converting CRD generation paths to internal version: %v", err)
+			}
+		}
+
 		columns, err := getColumnsForVersion(crd, v.Name)
 		if err != nil {
 			utilruntime.HandleError(err)
 			return nil, fmt.Errorf("the server could not properly serve the CR columns")
 		}
-		table, err := tableconvertor.New(columns)
+		table, err := tableconvertor.New(columns, structuralSchemas[v.Name])
 		if err != nil {
 			klog.V(2).Infof("The CRD for %v has an invalid printer specification, falling back to default printing: %v", kind, err)
 		}
+		// lists can be sorted and grouped by the printer columns
+		columnPaths := make([]string, 0, len(columns))
+		for _, column := range columns {
+			columnPaths = append(columnPaths, column.JSONPath)
+		}
 
-		storages[v.Name] = customresource.NewStorage(
+		strategy := customresource.NewStrategy(
+			typer,
+			crd.Spec.Scope == apiextensionsv1.NamespaceScoped,
+			kind,
+			validator,
+			statusValidator,
+			structuralSchemas,
+			statusSpec,
+			scaleSpec,
+			customSpecs,
+			generationSpec,
+			r.embeddedResourceResolver,
+			r.referenceLister,
+			r.formats,
+		)
+		storage := customresource.NewStorage(
 			resource.GroupResource(),
 			kind,
 			schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Status.AcceptedNames.ListKind},
-			customresource.NewStrategy(
-				typer,
-				crd.Spec.Scope == apiextensionsv1.NamespaceScoped,
-				kind,
-				validator,
-				statusValidator,
-				structuralSchemas,
-				statusSpec,
-				scaleSpec,
-			),
+			strategy,
 			crdConversionRESTOptionsGetter{
 				RESTOptionsGetter:     r.restOptionsGetter,
 				converter:             safeConverter,
@@ -808,11 +1050,18 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 				structuralSchemas:     structuralSchemas,
 				structuralSchemaGK:    kind.GroupKind(),
 				preserveUnknownFields: crd.Spec.PreserveUnknownFields,
+				storageOverride:       storageOverride,
 			},
 			crd.Status.AcceptedNames.Categories,
 			table,
 			replicasPathInCustomResource,
+			limits,
+			crd.Spec.Retention != nil,
+			maxRevisions,
+			columnPaths,
 		)
+		storage.Lint = customresource.NewLintREST(strategy, storage.CustomResource, crd.Spec.PreserveUnknownFields)
+		storages[v.Name] = storage
 
 		selfLinkPrefix := ""
 		switch crd.Spec.Scope {
@@ -942,6 +1191,37 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 
 		statusScopes[v.Name] = &statusScope
 
+		// override custom subresource values
+		if subresources != nil && len(subresources.Custom) > 0 {
+			customScopes[v.Name] = map[string]*handlers.RequestScope{}
+			for _, custom := range subresources.Custom {
+				// shallow copy
+				customScope := *requestScopes[v.Name]
+				customScope.Subresource = custom.Name
+				customScope.Namer = handlers.ContextBasedNaming{
+					SelfLinker:         meta.NewAccessor(),
+					ClusterScoped:      clusterScoped,
+					SelfLinkPathPrefix: selfLinkPrefix,
+					SelfLinkPathSuffix: "/" + custom.Name,
+				}
+
+				if utilfeature.DefaultFeatureGate.Enabled(features.ServerSideApply) {
+					resetFields := storages[v.Name].Custom[custom.Name].GetResetFields()
+					customScope, err = scopeWithFieldManager(
+						typeConverter,
+						customScope,
+						resetFields,
+						custom.Name,
+					)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				customScopes[v.Name][custom.Name] = &customScope
+			}
+		}
+
 		if v.Deprecated {
 			deprecated[v.Name] = true
 			if v.DeprecationWarning != nil {
@@ -959,9 +1239,12 @@ func (r *crdHandler) getOrCreateServingInfoFor(uid types.UID, name string) (*crd
 		requestScopes:       requestScopes,
 		scaleRequestScopes:  scaleScopes,
 		statusRequestScopes: statusScopes,
+		customRequestScopes: customScopes,
 		deprecated:          deprecated,
 		warnings:            warnings,
 		storageVersion:      storageVersion,
+		maxPageSize:         maxPageSize,
+		updateAdmission:     newFieldAuthorizingAdmission(r.admission, r.authorizer, structuralSchemas, specReplicasPaths),
 		waitGroup:           &utilwaitgroup.SafeWaitGroup{},
 	}
 
@@ -1127,7 +1410,29 @@ func (d unstructuredDefaulter) Default(in runtime.Object) {
 		return
 	}
 
-	structuraldefaulting.Default(u.UnstructuredContent(), d.structuralSchemas[u.GetObjectKind().GroupVersionKind().Version])
+	s := d.structuralSchemas[u.GetObjectKind().GroupVersionKind().Version]
+	structuraldefaulting.Default(u.UnstructuredContent(), s)
+	structuraldefaulting.Canonicalize(u.UnstructuredContent(), s)
+	structuraldefaulting.Normalize(u.UnstructuredContent(), s)
+}
+
+// normalizingTypeConverter normalizes custom resources before converting them into typed values. Hence,
+// server-side apply compares applied configurations against the normalized values of the live object,
+// and does not report conflicts for values that only differ before normalization.
+type normalizingTypeConverter struct {
+	fieldmanager.TypeConverter
+	structuralSchemas map[string]*structuralschema.Structural // by version
+}
+
+func (c normalizingTypeConverter) ObjectToTyped(obj runtime.Object) (*typed.TypedValue, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		if s := c.structuralSchemas[u.GroupVersionKind().Version]; s != nil {
+			u = u.DeepCopy()
+			structuraldefaulting.Normalize(u.Object, s)
+			obj = u
+		}
+	}
+	return c.TypeConverter.ObjectToTyped(obj)
 }
 
 type CRDRESTOptionsGetter struct {
@@ -1139,6 +1444,10 @@ type CRDRESTOptionsGetter struct {
 	DeleteCollectionWorkers   int
 	CountMetricPollPeriod     time.Duration
 	StorageObjectCountTracker flowcontrolrequest.StorageObjectCountTracker
+
+	// StorageDecorator creates the storage of custom resources instead of etcd if set, e.g. with
+	// the NewStorage method of a local.Database. The watch cache is not used on top of it.
+	StorageDecorator generic.StorageDecorator
 }
 
 func (t CRDRESTOptionsGetter) GetRESTOptions(resource schema.GroupResource) (generic.RESTOptions, error) {
@@ -1151,7 +1460,9 @@ func (t CRDRESTOptionsGetter) GetRESTOptions(resource schema.GroupResource) (gen
 		CountMetricPollPeriod:     t.CountMetricPollPeriod,
 		StorageObjectCountTracker: t.StorageObjectCountTracker,
 	}
-	if t.EnableWatchCache {
+	if t.StorageDecorator != nil {
+		ret.Decorator = t.StorageDecorator
+	} else if t.EnableWatchCache {
 		ret.Decorator = genericregistry.StorageWithCacher()
 	}
 	return ret, nil
@@ -1180,11 +1491,13 @@ type crdConversionRESTOptionsGetter struct {
 	structuralSchemas     map[string]*structuralschema.Structural // by version
 	structuralSchemaGK    schema.GroupKind
 	preserveUnknownFields bool
+	storageOverride       CRDStorageOverride
 }
 
 func (t crdConversionRESTOptionsGetter) GetRESTOptions(resource schema.GroupResource) (generic.RESTOptions, error) {
 	ret, err := t.RESTOptionsGetter.GetRESTOptions(resource)
 	if err == nil {
+		t.storageOverride.applyTo(&ret)
 		d := schemaCoercingDecoder{delegate: ret.StorageConfig.Codec, validator: unstructuredSchemaCoercer{
 			// drop invalid fields while decoding old CRs (before we haven't had any ObjectMeta validation)
 			dropInvalidMetadata:   true,
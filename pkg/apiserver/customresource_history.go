@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// serveHistory serves the history subresource. A GET lists the revisions of a custom resource, the
+// most recent first, or returns the difference between the specs of the revisions given by the from
+// and to query parameters as JSON; to defaults to the current spec. A POST restores the spec of the
+// revision given by the revision query parameter through an update, which requires permission to
+// update the custom resource. The update is handled like the body of an update of the custom
+// resource, i.e. it passes mutating and validating admission and updates the managed fields of the
+// field manager of the request, and the updated custom resource is returned.
+func (r *crdHandler) serveHistory(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo) http.HandlerFunc {
+	gv := schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}
+	if requestInfo.Verb != "get" && requestInfo.Verb != "create" {
+		responsewriters.ErrorNegotiated(
+			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
+			Codecs, gv, w, req,
+		)
+		return nil
+	}
+	history := crdInfo.storages[requestInfo.APIVersion].History
+	scope := crdInfo.requestScopes[requestInfo.APIVersion]
+
+	if requestInfo.Verb == "get" {
+		return func(w http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+			query := req.URL.Query()
+			if from := query.Get("from"); len(from) > 0 {
+				diff, err := history.Diff(ctx, requestInfo.Name, from, query.Get("to"))
+				if err != nil {
+					responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+					return
+				}
+				responsewriters.WriteRawJSON(http.StatusOK, diff, w)
+				return
+			}
+			if len(query.Get("to")) > 0 {
+				responsewriters.ErrorNegotiated(apierrors.NewBadRequest("the to parameter requires the from parameter"), Codecs, gv, w, req)
+				return
+			}
+			list, err := history.List(ctx, requestInfo.Name)
+			if err != nil {
+				responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+				return
+			}
+			responsewriters.WriteObjectNegotiated(scope.Serializer, scope, gv, w, req, http.StatusOK, list)
+		}
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		revision := req.URL.Query().Get("revision")
+		if len(revision) == 0 {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest("the revision parameter is required"), Codecs, gv, w, req)
+			return
+		}
+		if err := r.authorizeResource(ctx, requestInfo, "update"); err != nil {
+			responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+			return
+		}
+		obj, err := history.Restorable(ctx, requestInfo.Name, revision)
+		if err != nil {
+			responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+			return
+		}
+		updateReq, err := withObjectBody(req, obj, "revision")
+		if err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewInternalError(err), Codecs, gv, w, req)
+			return
+		}
+		handlers.UpdateResource(crdInfo.storages[requestInfo.APIVersion].CustomResource, scope, crdInfo.updateAdmission)(w, updateReq)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// serveLint serves POST requests to the lint subresource. The posted object is pruned, defaulted
+// and validated like on create, the create is run in a dry run, and the results are returned as
+// JSON. Nothing is persisted and admission is not run.
+func (r *crdHandler) serveLint(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo) http.HandlerFunc {
+	gv := schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}
+	if requestInfo.Verb != "create" {
+		responsewriters.ErrorNegotiated(
+			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
+			Codecs, gv, w, req,
+		)
+		return nil
+	}
+	storage := crdInfo.storages[requestInfo.APIVersion].Lint
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		var reader io.Reader = req.Body
+		if r.maxRequestBodyBytes > 0 {
+			reader = io.LimitReader(req.Body, r.maxRequestBodyBytes+1)
+		}
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest(err.Error()), Codecs, gv, w, req)
+			return
+		}
+		if r.maxRequestBodyBytes > 0 && int64(len(body)) > r.maxRequestBodyBytes {
+			responsewriters.ErrorNegotiated(apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("limit is %d", r.maxRequestBodyBytes)), Codecs, gv, w, req)
+			return
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := utiljson.Unmarshal(body, &obj.Object); err != nil || obj.Object == nil {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest("the request body must be a JSON object"), Codecs, gv, w, req)
+			return
+		}
+
+		// like on create, name and namespace default to those of the request and must match them
+		if len(obj.GetName()) == 0 {
+			obj.SetName(requestInfo.Name)
+		} else if obj.GetName() != requestInfo.Name {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest("the name of the object does not match the name on the URL"), Codecs, gv, w, req)
+			return
+		}
+		if len(requestInfo.Namespace) > 0 {
+			if len(obj.GetNamespace()) == 0 {
+				obj.SetNamespace(requestInfo.Namespace)
+			} else if obj.GetNamespace() != requestInfo.Namespace {
+				responsewriters.ErrorNegotiated(apierrors.NewBadRequest("the namespace of the provided object does not match the namespace sent on the request"), Codecs, gv, w, req)
+				return
+			}
+		}
+
+		responsewriters.WriteRawJSON(http.StatusOK, storage.Lint(req.Context(), obj), w)
+	}
+}
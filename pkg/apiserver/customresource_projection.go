@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"k8s.io/apiserver/pkg/endpoints/handlers"
+	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+// withFieldProjection passes the fields query parameter of the get and list requests served by
+// handler to the storage. It is a comma-separated list of paths in the dot notation, e.g.
+// `spec.replicas,status.phase`, which the custom resources are projected to. The kind the response
+// is negotiated to is passed along, so that tables and partial object metadata are served from the
+// whole custom resources.
+func withFieldProjection(handler http.HandlerFunc, requestScope *handlers.RequestScope) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		values, ok := req.URL.Query()["fields"]
+		if !ok {
+			handler(w, req)
+			return
+		}
+		projection := customresource.FieldProjection{}
+		for _, value := range values {
+			for _, path := range strings.Split(value, ",") {
+				if path = strings.TrimSpace(path); len(path) > 0 {
+					projection.Paths = append(projection.Paths, path)
+				}
+			}
+		}
+		// errors are left to the negotiation of the handler
+		if mediaType, _, err := negotiation.NegotiateOutputMediaType(req, requestScope.Serializer, requestScope); err == nil && mediaType.Convert != nil {
+			switch mediaType.Convert.Kind {
+			case "Table":
+				projection.As = customresource.ProjectAsTable
+			case "PartialObjectMetadata", "PartialObjectMetadataList":
+				projection.As = customresource.ProjectAsPartialObjectMetadata
+			}
+		}
+
+		ctx := customresource.WithFieldProjection(req.Context(), projection)
+		handler(w, req.WithContext(ctx))
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	listers "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// maxReferenceInformers is the maximum number of resources whose referents are looked up.
+	maxReferenceInformers = 100
+	// referenceInformerSyncTimeout is how long a lookup waits for the informer of the resource to sync.
+	referenceInformerSyncTimeout = 5 * time.Second
+)
+
+// customResourceReferenceLister looks up the referents of x-kubernetes-reference fields in
+// informers of their resources. The resources must be served by CustomResourceDefinitions. The
+// informer of a resource is started with the first lookup, which waits for it to sync for a
+// limited time, and is stopped when the CustomResourceDefinition is found to be deleted.
+type customResourceReferenceLister struct {
+	crdLister listers.CustomResourceDefinitionLister
+	client    dynamic.Interface
+
+	lock sync.Mutex
+	// stopCh is nil until Run is called, and after it is closed
+	stopCh    <-chan struct{}
+	informers map[schema.GroupResource]*referenceInformer
+}
+
+// referenceInformer is the informer of a version of a resource.
+type referenceInformer struct {
+	version  string
+	informer informers.GenericInformer
+	stopCh   chan struct{}
+}
+
+func newCustomResourceReferenceLister(crdLister listers.CustomResourceDefinitionLister, client dynamic.Interface) *customResourceReferenceLister {
+	return &customResourceReferenceLister{
+		crdLister: crdLister,
+		client:    client,
+		informers: map[schema.GroupResource]*referenceInformer{},
+	}
+}
+
+// Run enables the lookups. Informers are stopped when stopCh is closed.
+func (l *customResourceReferenceLister) Run(stopCh <-chan struct{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.stopCh = stopCh
+	go func() {
+		<-stopCh
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		l.stopCh = nil
+		for gr := range l.informers {
+			l.stop(gr)
+		}
+	}()
+}
+
+// Exists implements reference.Lister.
+func (l *customResourceReferenceLister) Exists(gr schema.GroupResource, namespace, name string) (bool, error) {
+	crd, err := l.crdLister.Get(gr.String())
+	if apierrors.IsNotFound(err) {
+		l.lock.Lock()
+		l.stop(gr)
+		l.lock.Unlock()
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if namespaced := crd.Spec.Scope == apiextensionsv1.NamespaceScoped; namespaced != (len(namespace) > 0) {
+		return false, fmt.Errorf("%s is %s, not as declared by the reference", gr, crd.Spec.Scope)
+	}
+	// prefer the storage version, which is not necessarily served
+	version := ""
+	for _, v := range crd.Spec.Versions {
+		if v.Served && (len(version) == 0 || v.Storage) {
+			version = v.Name
+		}
+	}
+	if len(version) == 0 {
+		return false, nil
+	}
+
+	informer, err := l.informerFor(gr, version)
+	if err != nil {
+		return false, err
+	}
+	if !informer.Informer().HasSynced() {
+		ctx, cancel := context.WithTimeout(context.Background(), referenceInformerSyncTimeout)
+		defer cancel()
+		if !cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+			return false, fmt.Errorf("the informer of %s is not synced yet", gr)
+		}
+	}
+	if len(namespace) > 0 {
+		_, err = informer.Lister().ByNamespace(namespace).Get(name)
+	} else {
+		_, err = informer.Lister().Get(name)
+	}
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// informerFor returns the running informer of the given version of gr, and starts it if needed.
+// The informer of another version of gr is stopped.
+func (l *customResourceReferenceLister) informerFor(gr schema.GroupResource, version string) (informers.GenericInformer, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.stopCh == nil {
+		return nil, fmt.Errorf("the informers are not running")
+	}
+	if i, ok := l.informers[gr]; ok {
+		if i.version == version {
+			return i.informer, nil
+		}
+		l.stop(gr)
+	}
+	if len(l.informers) >= maxReferenceInformers {
+		return nil, fmt.Errorf("the referents of at most %d resources are looked up", maxReferenceInformers)
+	}
+
+	i := &referenceInformer{
+		version:  version,
+		informer: dynamicinformer.NewFilteredDynamicInformer(l.client, gr.WithVersion(version), metav1.NamespaceAll, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, nil),
+		stopCh:   make(chan struct{}),
+	}
+	go i.informer.Informer().Run(i.stopCh)
+	l.informers[gr] = i
+	return i.informer, nil
+}
+
+// stop stops the informer of gr if there is one. The lock must be held.
+func (l *customResourceReferenceLister) stop(gr schema.GroupResource) {
+	if i, ok := l.informers[gr]; ok {
+		close(i.stopCh)
+		delete(l.informers, gr)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+// withSortOptions passes the sortBy and sortOrder query parameters of the list requests served by
+// handler to the storage. sortBy is the JSON path of a printer column, and sortOrder is `asc`, the
+// default, or `desc`. Watch requests are not sorted.
+func withSortOptions(handler http.HandlerFunc, requestInfo *apirequest.RequestInfo) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		sortBy, sortOrder := query.Get("sortBy"), query.Get("sortOrder")
+		if len(sortBy) == 0 {
+			if len(sortOrder) > 0 {
+				err := apierrors.NewBadRequest("the sortOrder parameter requires the sortBy parameter")
+				responsewriters.ErrorNegotiated(err, Codecs, schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}, w, req)
+				return
+			}
+			handler(w, req)
+			return
+		}
+		if sortOrder != "" && sortOrder != "asc" && sortOrder != "desc" {
+			err := apierrors.NewBadRequest(fmt.Sprintf("unsupported sortOrder %q: must be asc or desc", sortOrder))
+			responsewriters.ErrorNegotiated(err, Codecs, schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}, w, req)
+			return
+		}
+
+		ctx := customresource.WithSortOptions(req.Context(), customresource.SortOptions{Path: sortBy, Descending: sortOrder == "desc"})
+		handler(w, req.WithContext(ctx))
+	}
+}
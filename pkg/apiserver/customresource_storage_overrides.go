@@ -0,0 +1,293 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// CRDStorageOverride overrides the storage settings of the custom resources of one
+// CustomResourceDefinition. Unset fields keep the server-wide settings.
+type CRDStorageOverride struct {
+	// EnableWatchCache enables or disables the watch cache.
+	EnableWatchCache *bool `json:"enableWatchCache,omitempty"`
+	// WatchCacheSize is the size of the watch cache. Like for --watch-cache-sizes, watch caches
+	// are sized dynamically, so the only allowed size is 0, which disables the watch cache.
+	WatchCacheSize *int `json:"watchCacheSize,omitempty"`
+	// MaxPageSize caps the limit of list requests which set one. Requests without a limit are not
+	// paged, because their clients may not follow continue tokens.
+	MaxPageSize *int64 `json:"maxPageSize,omitempty"`
+	// StoragePrefix replaces the etcd key prefix of the server, e.g. /registry-large. The custom
+	// resources stored under the previous prefix are not moved, so it cannot be changed while the
+	// server runs.
+	StoragePrefix string `json:"storagePrefix,omitempty"`
+}
+
+// validate returns an error if the override is invalid.
+func (o CRDStorageOverride) validate() error {
+	var errs []error
+	if o.WatchCacheSize != nil && *o.WatchCacheSize != 0 {
+		errs = append(errs, fmt.Errorf("watchCacheSize must be 0, which disables the watch cache: watch caches are sized dynamically"))
+	}
+	if o.MaxPageSize != nil && *o.MaxPageSize <= 0 {
+		errs = append(errs, fmt.Errorf("maxPageSize must be greater than 0"))
+	}
+	if len(o.StoragePrefix) > 0 && !strings.HasPrefix(o.StoragePrefix, "/") {
+		errs = append(errs, fmt.Errorf("storagePrefix must start with /"))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// applyTo applies the watch cache and etcd prefix overrides to opts.
+func (o CRDStorageOverride) applyTo(opts *generic.RESTOptions) {
+	if len(o.StoragePrefix) > 0 {
+		opts.StorageConfig.Prefix = o.StoragePrefix
+	}
+	if o.EnableWatchCache == nil && o.WatchCacheSize == nil {
+		return
+	}
+	// the watch cache only wraps etcd
+	if len(opts.StorageConfig.Type) > 0 && opts.StorageConfig.Type != storagebackend.StorageTypeETCD3 {
+		return
+	}
+	enabled := o.EnableWatchCache == nil || *o.EnableWatchCache
+	if o.WatchCacheSize != nil && *o.WatchCacheSize == 0 {
+		enabled = false
+	}
+	if enabled {
+		opts.Decorator = genericregistry.StorageWithCacher()
+	} else {
+		opts.Decorator = generic.UndecoratedStorage
+	}
+}
+
+// maxPageSize returns the maximum page size of lists, 0 if unset.
+func (o CRDStorageOverride) maxPageSize() int64 {
+	if o.MaxPageSize != nil {
+		return *o.MaxPageSize
+	}
+	return 0
+}
+
+// CRDStorageOverrides holds the storage overrides of CustomResourceDefinitions, keyed by the
+// group and resource of their custom resources. They can be changed at runtime, and listeners
+// are notified about the resources whose overrides changed.
+type CRDStorageOverrides struct {
+	// path is the file the overrides are reloaded from, if not empty.
+	path string
+
+	lock      sync.RWMutex
+	overrides map[schema.GroupResource]CRDStorageOverride
+	listeners []func(schema.GroupResource)
+	// lastFile is the content of path the overrides were last loaded from.
+	lastFile []byte
+}
+
+// NewCRDStorageOverrides returns the given overrides.
+func NewCRDStorageOverrides(overrides map[schema.GroupResource]CRDStorageOverride) (*CRDStorageOverrides, error) {
+	o := &CRDStorageOverrides{}
+	if err := o.Set(overrides); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// NewCRDStorageOverridesFromFile returns the overrides in the given YAML or JSON file, which maps
+// <resource>.<group> to overrides, e.g.
+//
+//	widgets.example.com:
+//	  enableWatchCache: false
+//	  maxPageSize: 500
+//
+// Run reloads the file.
+func NewCRDStorageOverridesFromFile(path string) (*CRDStorageOverrides, error) {
+	o := &CRDStorageOverrides{path: path}
+	if err := o.reload(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Get returns the override of the given resource, and whether there is one.
+func (o *CRDStorageOverrides) Get(gr schema.GroupResource) (CRDStorageOverride, bool) {
+	if o == nil {
+		return CRDStorageOverride{}, false
+	}
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	override, ok := o.overrides[gr]
+	return override, ok
+}
+
+// Set replaces the overrides, and notifies the listeners about the resources whose overrides changed.
+// Storage prefixes can only be set with the first overrides.
+func (o *CRDStorageOverrides) Set(overrides map[schema.GroupResource]CRDStorageOverride) error {
+	var errs []error
+	for gr, override := range overrides {
+		if err := override.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid storage override of %s: %v", gr, err))
+		}
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	o.lock.Lock()
+	// the overrides are nil until they are set first
+	if o.overrides != nil {
+		for gr := range unionKeys(o.overrides, overrides) {
+			if oldPrefix, newPrefix := o.overrides[gr].StoragePrefix, overrides[gr].StoragePrefix; oldPrefix != newPrefix {
+				errs = append(errs, fmt.Errorf("the storagePrefix of %s cannot be changed from %q to %q while the server runs", gr, oldPrefix, newPrefix))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		o.lock.Unlock()
+		return utilerrors.NewAggregate(errs)
+	}
+	var changed []schema.GroupResource
+	for gr, override := range overrides {
+		if old, ok := o.overrides[gr]; !ok || !reflect.DeepEqual(old, override) {
+			changed = append(changed, gr)
+		}
+	}
+	for gr := range o.overrides {
+		if _, ok := overrides[gr]; !ok {
+			changed = append(changed, gr)
+		}
+	}
+	o.overrides = make(map[schema.GroupResource]CRDStorageOverride, len(overrides))
+	for gr, override := range overrides {
+		o.overrides[gr] = override
+	}
+	listeners := o.listeners
+	o.lock.Unlock()
+
+	for _, gr := range changed {
+		for _, listener := range listeners {
+			listener(gr)
+		}
+	}
+	return nil
+}
+
+// unionKeys returns the resources of a and b.
+func unionKeys(a, b map[schema.GroupResource]CRDStorageOverride) map[schema.GroupResource]bool {
+	ret := make(map[schema.GroupResource]bool, len(a)+len(b))
+	for gr := range a {
+		ret[gr] = true
+	}
+	for gr := range b {
+		ret[gr] = true
+	}
+	return ret
+}
+
+// AddListener adds a function called with every resource whose override changed.
+func (o *CRDStorageOverrides) AddListener(listener func(schema.GroupResource)) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.listeners = append(o.listeners, listener)
+}
+
+// Run reloads the file of the overrides periodically until stopCh is closed. It returns
+// immediately if the overrides are not from a file.
+func (o *CRDStorageOverrides) Run(stopCh <-chan struct{}) {
+	if o == nil || len(o.path) == 0 {
+		return
+	}
+	wait.Until(func() {
+		if err := o.reload(); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to reload the storage overrides of custom resources: %v", err))
+		}
+	}, 10*time.Second, stopCh)
+}
+
+func (o *CRDStorageOverrides) reload() error {
+	data, err := os.ReadFile(o.path)
+	if err != nil {
+		return err
+	}
+	o.lock.RLock()
+	unchanged := o.lastFile != nil && bytes.Equal(data, o.lastFile)
+	o.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	var byName map[string]CRDStorageOverride
+	if err := yaml.UnmarshalStrict(data, &byName); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", o.path, err)
+	}
+	overrides := make(map[schema.GroupResource]CRDStorageOverride, len(byName))
+	for name, override := range byName {
+		gr := schema.ParseGroupResource(name)
+		if len(gr.Group) == 0 {
+			return fmt.Errorf("invalid resource %q in %s: must be <resource>.<group>", name, o.path)
+		}
+		overrides[gr] = override
+	}
+	if err := o.Set(overrides); err != nil {
+		return err
+	}
+	klog.V(2).Infof("Loaded the storage overrides of custom resources from %s", o.path)
+
+	o.lock.Lock()
+	o.lastFile = data
+	o.lock.Unlock()
+	return nil
+}
+
+// withMaxPageSize caps the limit of the list requests served by handler which set one. Requests
+// without a limit are passed on unchanged, because their clients do not expect continue tokens. A
+// page size of 0 means no maximum.
+func withMaxPageSize(handler http.HandlerFunc, maxPageSize int64) http.HandlerFunc {
+	if maxPageSize == 0 {
+		return handler
+	}
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		limit, err := strconv.ParseInt(query.Get("limit"), 10, 64)
+		if err != nil || limit <= maxPageSize {
+			handler(w, req)
+			return
+		}
+		query.Set("limit", strconv.FormatInt(maxPageSize, 10))
+
+		req = req.Clone(req.Context())
+		req.URL.RawQuery = query.Encode()
+		handler(w, req)
+	}
+}
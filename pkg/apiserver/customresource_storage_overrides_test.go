@@ -0,0 +1,258 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/utils/pointer"
+)
+
+func TestCRDStorageOverridesFromFile(t *testing.T) {
+	widgets := schema.GroupResource{Group: "example.com", Resource: "widgets"}
+	tests := []struct {
+		name        string
+		file        string
+		expected    map[schema.GroupResource]CRDStorageOverride
+		expectedErr bool
+	}{
+		{
+			name: "valid",
+			file: `
+widgets.example.com:
+  enableWatchCache: false
+  watchCacheSize: 0
+  maxPageSize: 500
+  storagePrefix: /registry-widgets
+`,
+			expected: map[schema.GroupResource]CRDStorageOverride{
+				widgets: {EnableWatchCache: pointer.BoolPtr(false), WatchCacheSize: pointer.IntPtr(0), MaxPageSize: pointer.Int64Ptr(500), StoragePrefix: "/registry-widgets"},
+			},
+		},
+		{
+			name:        "missing group",
+			file:        "widgets:\n  maxPageSize: 500\n",
+			expectedErr: true,
+		},
+		{
+			name:        "unknown field",
+			file:        "widgets.example.com:\n  pageSize: 500\n",
+			expectedErr: true,
+		},
+		{
+			name:        "removed default page size",
+			file:        "widgets.example.com:\n  defaultPageSize: 100\n",
+			expectedErr: true,
+		},
+		{
+			name:        "negative watch cache size",
+			file:        "widgets.example.com:\n  watchCacheSize: -1\n",
+			expectedErr: true,
+		},
+		{
+			name:        "non-zero watch cache size",
+			file:        "widgets.example.com:\n  watchCacheSize: 100\n",
+			expectedErr: true,
+		},
+		{
+			name:        "relative storage prefix",
+			file:        "widgets.example.com:\n  storagePrefix: registry\n",
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "overrides.yaml")
+			if err := os.WriteFile(path, []byte(tt.file), 0644); err != nil {
+				t.Fatal(err)
+			}
+			overrides, err := NewCRDStorageOverridesFromFile(path)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for gr, expected := range tt.expected {
+				if got, ok := overrides.Get(gr); !ok || !reflect.DeepEqual(got, expected) {
+					t.Errorf("expected override %v for %s, got %v", expected, gr, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCRDStorageOverridesSet(t *testing.T) {
+	widgets := schema.GroupResource{Group: "example.com", Resource: "widgets"}
+	gadgets := schema.GroupResource{Group: "example.com", Resource: "gadgets"}
+	sprockets := schema.GroupResource{Group: "example.com", Resource: "sprockets"}
+
+	overrides, err := NewCRDStorageOverrides(map[schema.GroupResource]CRDStorageOverride{
+		widgets: {MaxPageSize: pointer.Int64Ptr(100), StoragePrefix: "/registry-widgets"},
+		gadgets: {MaxPageSize: pointer.Int64Ptr(100)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changed []string
+	overrides.AddListener(func(gr schema.GroupResource) {
+		changed = append(changed, gr.String())
+	})
+
+	if err := overrides.Set(map[schema.GroupResource]CRDStorageOverride{
+		widgets:   {MaxPageSize: pointer.Int64Ptr(100), StoragePrefix: "/registry-widgets"},
+		sprockets: {EnableWatchCache: pointer.BoolPtr(false)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(changed)
+	if expected := []string{"gadgets.example.com", "sprockets.example.com"}; !reflect.DeepEqual(changed, expected) {
+		t.Errorf("expected changes of %v, got %v", expected, changed)
+	}
+
+	changed = nil
+	if err := overrides.Set(map[schema.GroupResource]CRDStorageOverride{
+		widgets: {MaxPageSize: pointer.Int64Ptr(-1)},
+	}); err == nil {
+		t.Error("expected error")
+	}
+	if len(changed) > 0 {
+		t.Errorf("expected no changes of invalid overrides, got %v", changed)
+	}
+	if _, ok := overrides.Get(sprockets); !ok {
+		t.Error("expected invalid overrides not to be applied")
+	}
+
+	for name, invalid := range map[string]map[schema.GroupResource]CRDStorageOverride{
+		"changed prefix": {
+			widgets:   {MaxPageSize: pointer.Int64Ptr(100), StoragePrefix: "/registry-other"},
+			sprockets: {EnableWatchCache: pointer.BoolPtr(false)},
+		},
+		"removed prefix": {
+			widgets:   {MaxPageSize: pointer.Int64Ptr(100)},
+			sprockets: {EnableWatchCache: pointer.BoolPtr(false)},
+		},
+		"added prefix": {
+			widgets:   {MaxPageSize: pointer.Int64Ptr(100), StoragePrefix: "/registry-widgets"},
+			sprockets: {StoragePrefix: "/registry-sprockets"},
+		},
+	} {
+		if err := overrides.Set(invalid); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if len(changed) > 0 {
+		t.Errorf("expected no changes of storage prefixes, got %v", changed)
+	}
+	if got, _ := overrides.Get(widgets); got.StoragePrefix != "/registry-widgets" {
+		t.Errorf("expected storage prefix /registry-widgets to be kept, got %q", got.StoragePrefix)
+	}
+}
+
+func TestCRDStorageOverrideApplyTo(t *testing.T) {
+	cached := func() generic.RESTOptions {
+		return generic.RESTOptions{
+			StorageConfig: &storagebackend.ConfigForResource{Config: storagebackend.Config{Prefix: "/registry"}},
+		}
+	}
+	tests := []struct {
+		name           string
+		override       CRDStorageOverride
+		expectedPrefix string
+		expectedCached *bool
+	}{
+		{
+			name:           "no override",
+			expectedPrefix: "/registry",
+		},
+		{
+			name:           "prefix",
+			override:       CRDStorageOverride{StoragePrefix: "/registry-widgets"},
+			expectedPrefix: "/registry-widgets",
+		},
+		{
+			name:           "watch cache disabled",
+			override:       CRDStorageOverride{EnableWatchCache: pointer.BoolPtr(false)},
+			expectedPrefix: "/registry",
+			expectedCached: pointer.BoolPtr(false),
+		},
+		{
+			name:           "watch cache size of 0",
+			override:       CRDStorageOverride{EnableWatchCache: pointer.BoolPtr(true), WatchCacheSize: pointer.IntPtr(0)},
+			expectedPrefix: "/registry",
+			expectedCached: pointer.BoolPtr(false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := cached()
+			tt.override.applyTo(&opts)
+			if opts.StorageConfig.Prefix != tt.expectedPrefix {
+				t.Errorf("expected prefix %q, got %q", tt.expectedPrefix, opts.StorageConfig.Prefix)
+			}
+			switch {
+			case tt.expectedCached == nil && opts.Decorator != nil:
+				t.Errorf("expected decorator to be unchanged")
+			case tt.expectedCached != nil && opts.Decorator == nil:
+				t.Errorf("expected decorator to be set")
+			case tt.expectedCached != nil && !*tt.expectedCached && reflect.ValueOf(opts.Decorator).Pointer() != reflect.ValueOf(generic.UndecoratedStorage).Pointer():
+				t.Errorf("expected undecorated storage")
+			case tt.expectedCached != nil && *tt.expectedCached && reflect.ValueOf(opts.Decorator).Pointer() == reflect.ValueOf(generic.UndecoratedStorage).Pointer():
+				t.Errorf("expected storage with cacher")
+			}
+		})
+	}
+}
+
+func TestWithMaxPageSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		maxPageSize   int64
+		expectedLimit string
+	}{
+		{name: "no maximum", query: "limit=1000", expectedLimit: "1000"},
+		{name: "capped limit", query: "limit=1000", maxPageSize: 500, expectedLimit: "500"},
+		{name: "unlimited", query: "", maxPageSize: 500, expectedLimit: ""},
+		{name: "limit of 0", query: "limit=0", maxPageSize: 500, expectedLimit: "0"},
+		{name: "limit below maximum", query: "limit=10", maxPageSize: 500, expectedLimit: "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var limit string
+			handler := withMaxPageSize(func(w http.ResponseWriter, req *http.Request) {
+				limit = req.URL.Query().Get("limit")
+			}, tt.maxPageSize)
+			handler(httptest.NewRecorder(), httptest.NewRequest("GET", "/apis/example.com/v1/widgets?"+tt.query, nil))
+			if limit != tt.expectedLimit {
+				t.Errorf("expected limit %q, got %q", tt.expectedLimit, limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/endpoints/handlers"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// serveDeleted serves GET requests to the deleted subresource, which lists the retained deleted
+// copies of a custom resource.
+func (r *crdHandler) serveDeleted(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo) http.HandlerFunc {
+	if requestInfo.Verb != "get" {
+		responsewriters.ErrorNegotiated(
+			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
+			Codecs, schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}, w, req,
+		)
+		return nil
+	}
+	return handlers.GetResource(crdInfo.storages[requestInfo.APIVersion].Trash, crdInfo.requestScopes[requestInfo.APIVersion])
+}
+
+// serveRestore serves POST requests to the restore subresource, which recreates a retained deleted
+// copy of a custom resource. The uid query parameter selects the copy, and defaults to the most
+// recently deleted one. Restoring requires permission to create the custom resource. The copy is
+// created like the body of a create of the custom resource, i.e. it passes mutating and validating
+// admission and gets the managed fields of the field manager of the request, and is returned.
+func (r *crdHandler) serveRestore(w http.ResponseWriter, req *http.Request, requestInfo *apirequest.RequestInfo, crdInfo *crdInfo, terminating bool) http.HandlerFunc {
+	gv := schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}
+	if requestInfo.Verb != "create" {
+		responsewriters.ErrorNegotiated(
+			apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb),
+			Codecs, gv, w, req,
+		)
+		return nil
+	}
+	if terminating {
+		err := apierrors.NewMethodNotSupported(schema.GroupResource{Group: requestInfo.APIGroup, Resource: requestInfo.Resource}, requestInfo.Verb)
+		err.ErrStatus.Message = "restore not allowed while custom resource definition is terminating"
+		responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+		return nil
+	}
+	trash := crdInfo.storages[requestInfo.APIVersion].Trash
+	scope := crdInfo.requestScopes[requestInfo.APIVersion]
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		if err := r.authorizeResource(ctx, requestInfo, "create"); err != nil {
+			responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+			return
+		}
+		obj, uid, err := trash.Restorable(ctx, requestInfo.Name, types.UID(req.URL.Query().Get("uid")))
+		if err != nil {
+			responsewriters.ErrorNegotiated(err, Codecs, gv, w, req)
+			return
+		}
+		createReq, err := withObjectBody(req, obj, "uid")
+		if err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewInternalError(err), Codecs, gv, w, req)
+			return
+		}
+		handlers.CreateResource(trash.Restorer(uid), scope, r.admission)(w, createReq)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	celmodel "k8s.io/apiextensions-apiserver/third_party/forked/celopenapi/model"
 )
 
@@ -48,11 +49,58 @@ type CompilationResult struct {
 //  - nil Program, non-nil Error: Compilation resulted in an error
 //  - nil Program, nil Error: The provided rule was empty so compilation was not attempted
 func Compile(s *schema.Structural, isResourceRoot bool) ([]CompilationResult, error) {
+	return CompileWithFormats(s, isResourceRoot, apiservervalidation.DefaultFormatRegistry)
+}
+
+// CompileWithFormats compiles the rules like Compile. The programs check string formats with the
+// given registry.
+func CompileWithFormats(s *schema.Structural, isResourceRoot bool, formats *apiservervalidation.FormatRegistry) ([]CompilationResult, error) {
 	if len(s.Extensions.XValidations) == 0 {
 		return nil, nil
 	}
 	celRules := s.Extensions.XValidations
 
+	env, err := newEnv(s, isResourceRoot, formats)
+	if err != nil {
+		return nil, err
+	}
+	if env == nil {
+		return nil, nil
+	}
+
+	// compResults is the return value which saves a list of compilation results in the same order as x-kubernetes-validations rules.
+	compResults := make([]CompilationResult, len(celRules))
+	for i, rule := range celRules {
+		var compilationResult CompilationResult
+		if len(strings.TrimSpace(rule.Rule)) == 0 {
+			// include a compilation result, but leave both program and error nil per documented return semantics of this
+			// function
+		} else {
+			ast, issues := env.Compile(rule.Rule)
+			if issues != nil {
+				compilationResult.Error = &Error{ErrorTypeInvalid, "compilation failed: " + issues.String()}
+			} else if !proto.Equal(ast.ResultType(), decls.Bool) {
+				compilationResult.Error = &Error{ErrorTypeInvalid, "cel expression must evaluate to a bool"}
+			} else {
+				prog, err := env.Program(ast)
+				if err != nil {
+					compilationResult.Error = &Error{ErrorTypeInvalid, "program instantiation failed: " + err.Error()}
+				} else {
+					compilationResult.Program = prog
+				}
+			}
+		}
+
+		compResults[i] = compilationResult
+	}
+
+	return compResults, nil
+}
+
+// newEnv returns the CEL environment of expressions over data of the structural schema s, declared
+// as self, which checks string formats with the given registry. It returns nil if s does not declare
+// types for expressions.
+func newEnv(s *schema.Structural, isResourceRoot bool, formats *apiservervalidation.FormatRegistry) (*cel.Env, error) {
 	var propDecls []*expr.Decl
 	var root *celmodel.DeclType
 	var ok bool
@@ -84,38 +132,13 @@ func Compile(s *schema.Structural, isResourceRoot bool) ([]CompilationResult, er
 	propDecls = append(propDecls, decls.NewVar(ScopedVarName, root.ExprType()))
 	opts = append(opts, cel.Declarations(propDecls...))
 	opts = append(opts, ext.Strings())
+	opts = append(opts, cel.Lib(formatLibrary{formats: formats}))
 	env, err = env.Extend(opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// compResults is the return value which saves a list of compilation results in the same order as x-kubernetes-validations rules.
-	compResults := make([]CompilationResult, len(celRules))
-	for i, rule := range celRules {
-		var compilationResult CompilationResult
-		if len(strings.TrimSpace(rule.Rule)) == 0 {
-			// include a compilation result, but leave both program and error nil per documented return semantics of this
-			// function
-		} else {
-			ast, issues := env.Compile(rule.Rule)
-			if issues != nil {
-				compilationResult.Error = &Error{ErrorTypeInvalid, "compilation failed: " + issues.String()}
-			} else if !proto.Equal(ast.ResultType(), decls.Bool) {
-				compilationResult.Error = &Error{ErrorTypeInvalid, "cel expression must evaluate to a bool"}
-			} else {
-				prog, err := env.Program(ast)
-				if err != nil {
-					compilationResult.Error = &Error{ErrorTypeInvalid, "program instantiation failed: " + err.Error()}
-				} else {
-					compilationResult.Program = prog
-				}
-			}
-		}
-
-		compResults[i] = compilationResult
-	}
-
-	return compResults, nil
+	return env, nil
 }
 
 // generateUniqueSelfTypeName creates a placeholder type name to use in a CEL programs for cases
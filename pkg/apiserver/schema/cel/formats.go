@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter/functions"
+
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+// formatLibrary exposes the string formats of a format registry to CEL expressions:
+//
+//	<string>.isFormat(<string>) <bool>
+//
+// returns true if the receiver is of the given format, e.g. `self.limit.isFormat('k8s-quantity')`.
+// Unknown formats result in an evaluation error.
+type formatLibrary struct {
+	formats *apiservervalidation.FormatRegistry
+}
+
+func (formatLibrary) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Declarations(
+			decls.NewFunction("isFormat",
+				decls.NewInstanceOverload("string_is_format_string", []*expr.Type{decls.String, decls.String}, decls.Bool),
+			),
+		),
+	}
+}
+
+func (l formatLibrary) ProgramOptions() []cel.ProgramOption {
+	return []cel.ProgramOption{
+		cel.Functions(&functions.Overload{
+			Operator: "string_is_format_string",
+			Binary:   l.isFormat,
+		}),
+	}
+}
+
+func (l formatLibrary) isFormat(value, format ref.Val) ref.Val {
+	s, ok := value.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(value)
+	}
+	f, ok := format.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(format)
+	}
+	if !l.formats.Supports(string(f)) {
+		return types.NewErr("unknown format %q", string(f))
+	}
+	return types.Bool(l.formats.Validates(string(f), string(s)))
+}
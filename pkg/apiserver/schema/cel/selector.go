@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+const (
+	// SelectorMaxLength is the maximum length of a selector expression.
+	SelectorMaxLength = 1024
+	// selectorInterruptCheckFrequency is how many comprehension iterations run between checks whether
+	// the evaluation of a selector must be interrupted.
+	selectorInterruptCheckFrequency = 100
+)
+
+// Selector is a compiled CEL expression which selects custom resources. Like in the validation
+// rules of the root of a schema, the custom resource is `self`.
+//
+// The cost of a selector is not limited when it is compiled: this version of CEL does not track the
+// cost of evaluations, and its static estimate is unbounded for any comprehension over the custom
+// resource, e.g. `self.spec.items.all(...)`. Instead, the length of the expression is limited by
+// SelectorMaxLength, and callers bound each evaluation by the deadline of its context, which is
+// checked between comprehension iterations.
+type Selector struct {
+	program    cel.Program
+	structural *schema.Structural
+}
+
+// CompileSelector compiles a selector expression evaluating to a bool over custom resources with
+// the structural schema s. String formats are checked with the given registry.
+func CompileSelector(s *schema.Structural, expression string, formats *apiservervalidation.FormatRegistry) (*Selector, error) {
+	if len(strings.TrimSpace(expression)) == 0 {
+		return nil, fmt.Errorf("must not be empty")
+	}
+	if len(expression) > SelectorMaxLength {
+		return nil, fmt.Errorf("must be at most %d characters long", SelectorMaxLength)
+	}
+	env, err := newEnv(s, true, formats)
+	if err != nil {
+		return nil, err
+	}
+	if env == nil {
+		return nil, fmt.Errorf("the schema does not declare types for expressions")
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil {
+		return nil, fmt.Errorf("compilation failed: %s", issues.String())
+	}
+	if !proto.Equal(ast.ResultType(), decls.Bool) {
+		return nil, fmt.Errorf("must evaluate to a bool")
+	}
+	program, err := env.Program(ast, cel.InterruptCheckFrequency(selectorInterruptCheckFrequency))
+	if err != nil {
+		return nil, fmt.Errorf("program instantiation failed: %v", err)
+	}
+	return &Selector{program: program, structural: s}, nil
+}
+
+// Matches evaluates the selector against obj. Evaluation is interrupted once ctx is done, and then
+// the error of ctx is returned. Other evaluation errors, e.g. about missing fields, are no match.
+func (s *Selector) Matches(ctx context.Context, obj map[string]interface{}) (bool, error) {
+	result, _, err := s.program.ContextEval(ctx, NewValidationActivation(obj, s.structural))
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if err != nil {
+		return false, nil
+	}
+	return result == types.True, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+func TestSelector(t *testing.T) {
+	s := &schema.Structural{
+		Generic: schema.Generic{Type: "object"},
+		Properties: map[string]schema.Structural{
+			"spec": {
+				Generic: schema.Generic{Type: "object"},
+				Properties: map[string]schema.Structural{
+					"priority": {Generic: schema.Generic{Type: "integer"}},
+				},
+			},
+			"status": {
+				Generic: schema.Generic{Type: "object"},
+				Properties: map[string]schema.Structural{
+					"phase": {Generic: schema.Generic{Type: "string"}},
+				},
+			},
+		},
+	}
+	selector, err := CompileSelector(s, "self.spec.priority > 5 && self.status.phase != 'Done'", apiservervalidation.DefaultFormatRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		obj      map[string]interface{}
+		expected bool
+	}{
+		{
+			name:     "match",
+			obj:      map[string]interface{}{"spec": map[string]interface{}{"priority": int64(6)}, "status": map[string]interface{}{"phase": "Running"}},
+			expected: true,
+		},
+		{
+			name: "no match",
+			obj:  map[string]interface{}{"spec": map[string]interface{}{"priority": int64(6)}, "status": map[string]interface{}{"phase": "Done"}},
+		},
+		{
+			name: "missing field",
+			obj:  map[string]interface{}{"spec": map[string]interface{}{"priority": int64(6)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := selector.Matches(context.Background(), tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if matches != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, matches)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := selector.Matches(ctx, tests[0].obj); err == nil {
+		t.Errorf("expected an error evaluating with a done context")
+	}
+
+	// formats are checked with the given registry
+	formats := apiservervalidation.NewFormatRegistry()
+	if err := formats.Register(apiservervalidation.Format{Name: "x-done", Validate: func(s string) bool { return s == "Done" }}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		formats  *apiservervalidation.FormatRegistry
+		expected bool
+	}{{formats, true}, {apiservervalidation.DefaultFormatRegistry, false}} {
+		selector, err := CompileSelector(s, "self.status.phase.isFormat('x-done')", tt.formats)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if matches, err := selector.Matches(context.Background(), tests[1].obj); err != nil || matches != tt.expected {
+			t.Errorf("expected %v matching a registered format, got %v, %v", tt.expected, matches, err)
+		}
+	}
+
+	for _, expression := range []string{"", "self.spec.priority", "self.spec.unknown > 1", "self.spec.priority > 1 || " + strings.Repeat("true || ", SelectorMaxLength/8) + "true"} {
+		if _, err := CompileSelector(s, expression, apiservervalidation.DefaultFormatRegistry); err == nil {
+			t.Errorf("expected %q not to compile", expression)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	"k8s.io/apiextensions-apiserver/third_party/forked/celopenapi/model"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -56,28 +57,83 @@ type Validator struct {
 // Returns nil only if there no validator rules in the Structural schema. May return a validator containing
 // only errors.
 func NewValidator(s *schema.Structural) *Validator {
-	return validator(s, true)
+	return NewValidatorWithFormats(s, apiservervalidation.DefaultFormatRegistry)
 }
 
-func validator(s *schema.Structural, isResourceRoot bool) *Validator {
-	compiledRules, err := Compile(s, isResourceRoot)
+// NewValidatorWithFormats returns a validator like NewValidator, whose programs check string formats
+// with the given registry.
+func NewValidatorWithFormats(s *schema.Structural, formats *apiservervalidation.FormatRegistry) *Validator {
+	b := &validatorBuilder{definitions: map[string]*Validator{}, formats: formats}
+	v := b.validator(s, true)
+	for _, f := range b.deferred {
+		f()
+	}
+	return v
+}
+
+// validatorBuilder builds the validators of a schema. Schemas resolved from the same definition
+// share one validator, such that recursive schemas lead to a cyclic validator.
+type validatorBuilder struct {
+	// definitions holds the validators by definition name, nil if there are no validation rules.
+	definitions map[string]*Validator
+	// deferred holds the assignments of properties validators resolved from a definition. These are
+	// stored by value and can only be copied once the definition validator is complete.
+	deferred []func()
+	// formats checks the string formats in the programs
+	formats *apiservervalidation.FormatRegistry
+}
+
+func (b *validatorBuilder) validator(s *schema.Structural, isResourceRoot bool) *Validator {
+	if len(s.Definition) == 0 {
+		return b.newValidator(s, isResourceRoot)
+	}
+
+	if v, ok := b.definitions[s.Definition]; ok {
+		// this might be a validator still being built in case of recursion
+		return v
+	}
+	if !hasValidationRules(s, map[string]bool{}) {
+		b.definitions[s.Definition] = nil
+		return nil
+	}
+	v := &Validator{}
+	b.definitions[s.Definition] = v
+	if built := b.newValidator(s, isResourceRoot); built != nil {
+		*v = *built
+	}
+	return v
+}
+
+func (b *validatorBuilder) newValidator(s *schema.Structural, isResourceRoot bool) *Validator {
+	compiledRules, err := CompileWithFormats(s, isResourceRoot, b.formats)
 	var itemsValidator, additionalPropertiesValidator *Validator
 	var propertiesValidators map[string]Validator
+	hasPropertiesValidators := false
 	if s.Items != nil {
-		itemsValidator = validator(s.Items, s.Items.XEmbeddedResource)
+		itemsValidator = b.validator(s.Items, s.Items.XEmbeddedResource)
 	}
 	if len(s.Properties) > 0 {
 		propertiesValidators = make(map[string]Validator, len(s.Properties))
 		for k, prop := range s.Properties {
-			if p := validator(&prop, prop.XEmbeddedResource); p != nil {
+			p := b.validator(&prop, prop.XEmbeddedResource)
+			if p == nil {
+				continue
+			}
+			hasPropertiesValidators = true
+			if len(prop.Definition) > 0 {
+				k := k
+				b.deferred = append(b.deferred, func() {
+					propertiesValidators[k] = *p
+				})
+			} else {
 				propertiesValidators[k] = *p
 			}
 		}
 	}
 	if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
-		additionalPropertiesValidator = validator(s.AdditionalProperties.Structural, s.AdditionalProperties.Structural.XEmbeddedResource)
+		additionalPropertiesValidator = b.validator(s.AdditionalProperties.Structural, s.AdditionalProperties.Structural.XEmbeddedResource)
 	}
-	if len(compiledRules) > 0 || err != nil || itemsValidator != nil || additionalPropertiesValidator != nil || len(propertiesValidators) > 0 {
+	if len(compiledRules) > 0 || err != nil || itemsValidator != nil || additionalPropertiesValidator != nil || hasPropertiesValidators {
 		return &Validator{
 			compiledRules:        compiledRules,
 			compilationErr:       err,
@@ -91,6 +147,31 @@ func validator(s *schema.Structural, isResourceRoot bool) *Validator {
 	return nil
 }
 
+// hasValidationRules returns true if s or any of its descendants has x-kubernetes-validations rules.
+func hasValidationRules(s *schema.Structural, visited map[string]bool) bool {
+	if len(s.Definition) > 0 {
+		if visited[s.Definition] {
+			return false
+		}
+		visited[s.Definition] = true
+	}
+	if len(s.XValidations) > 0 {
+		return true
+	}
+	if s.Items != nil && hasValidationRules(s.Items, visited) {
+		return true
+	}
+	for _, prop := range s.Properties {
+		if hasValidationRules(&prop, visited) {
+			return true
+		}
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil && hasValidationRules(s.AdditionalProperties.Structural, visited) {
+		return true
+	}
+	return false
+}
+
 // Validate validates all x-kubernetes-validations rules in Validator against obj and returns any errors.
 func (s *Validator) Validate(fldPath *field.Path, sts *schema.Structural, obj interface{}) field.ErrorList {
 	if s == nil || obj == nil {
@@ -179,6 +179,44 @@ func TestValidationExpressions(t *testing.T) {
 				"type(self.val1) == google.protobuf.Timestamp",
 			},
 		},
+		{name: "string formats",
+			obj:    objs("my-name", "500m", "1.2.3-beta.1", "not a label"),
+			schema: schemas(stringType, stringType, stringType, stringType),
+			valid: []string{
+				"self.val1.isFormat('rfc1123-label')",
+				"self.val2.isFormat('k8s-quantity')",
+				"self.val3.isFormat('semver')",
+				"!self.val4.isFormat('rfc1123-label')",
+				"!self.val1.isFormat('k8s-quantity')",
+				"self.val1.isFormat('rfc1123-subdomain')",
+			},
+			errors: map[string]string{
+				"self.val1.isFormat('unknown')": "unknown format",
+			},
+		},
+		{name: "quantities",
+			obj:    objs("500m", "1Gi", int64(2), "1500m"),
+			schema: schemas(withValueType("quantity", stringType), withValueType("quantity", stringType), withValueType("quantity", intOrStringType()), withValueType("quantity", intOrStringType())),
+			valid: []string{
+				"self.val1 == 0.5",
+				"self.val2 == 1073741824.0",
+				"self.val1 < self.val3",
+				"self.val3 > self.val4",
+				"self.val4 == 1.5",
+				"type(self.val3) == double",
+			},
+		},
+		{name: "typed durations",
+			obj:    objs("90m", int64(60), "1h30m0s"),
+			schema: schemas(withValueType("duration", stringType), withValueType("duration", intOrStringType()), withValueType("duration", intOrStringType())),
+			valid: []string{
+				"self.val1 == duration('1h30m')",
+				"self.val2 == duration('1m')",
+				"self.val1 == self.val3",
+				"self.val2 < self.val1",
+				"type(self.val2) == google.protobuf.Duration",
+			},
+		},
 		{name: "enums",
 			obj: map[string]interface{}{"enumStr": "Pending"},
 			schema: objectTypePtr(map[string]schema.Structural{"enumStr": {
@@ -1527,6 +1565,11 @@ func withRule(s schema.Structural, rule string) schema.Structural {
 	return s
 }
 
+func withValueType(valueType string, s schema.Structural) schema.Structural {
+	s.Extensions.XValueType = &valueType
+	return s
+}
+
 func withDefault(dflt interface{}, s schema.Structural) schema.Structural {
 	s.Generic.Default = schema.JSON{Object: dflt}
 	return s
@@ -26,6 +26,7 @@ import (
 	"github.com/google/cel-go/common/types/ref"
 	"github.com/google/cel-go/common/types/traits"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apiextensions-apiserver/third_party/forked/celopenapi/model"
@@ -42,6 +43,14 @@ func UnstructuredToVal(unstructured interface{}, schema *structuralschema.Struct
 		}
 		return types.NewErr("invalid data, got null for schema with nullable=false")
 	}
+	if schema.XValueType != nil {
+		switch *schema.XValueType {
+		case "quantity":
+			return quantityToVal(unstructured)
+		case "duration":
+			return durationToVal(unstructured)
+		}
+	}
 	if schema.XIntOrString {
 		switch v := unstructured.(type) {
 		case string:
@@ -206,6 +215,44 @@ func UnstructuredToVal(unstructured interface{}, schema *structuralschema.Struct
 	return types.NewErr("invalid type, expected object, array, number, integer, boolean or string, or no type with x-kubernetes-int-or-string or x-kubernetes-preserve-unknown-fields is true, got %s", schema.Type)
 }
 
+// quantityToVal converts a quantity given as string or integer to a CEL double.
+func quantityToVal(unstructured interface{}) ref.Val {
+	switch v := unstructured.(type) {
+	case string:
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return types.NewErr("Invalid quantity %s: %v", v, err)
+		}
+		return types.Double(q.AsApproximateFloat64())
+	case int:
+		return types.Double(v)
+	case int32:
+		return types.Double(v)
+	case int64:
+		return types.Double(v)
+	}
+	return types.NewErr("invalid data, expected quantity to be either a string or integer, got %T", unstructured)
+}
+
+// durationToVal converts a duration given as string or integer number of seconds to a CEL duration.
+func durationToVal(unstructured interface{}) ref.Val {
+	switch v := unstructured.(type) {
+	case string:
+		d, err := strfmt.ParseDuration(v)
+		if err != nil {
+			return types.NewErr("Invalid duration %s: %v", v, err)
+		}
+		return types.Duration{Duration: d}
+	case int:
+		return types.Duration{Duration: time.Duration(v) * time.Second}
+	case int32:
+		return types.Duration{Duration: time.Duration(v) * time.Second}
+	case int64:
+		return types.Duration{Duration: time.Duration(v) * time.Second}
+	}
+	return types.NewErr("invalid data, expected duration to be either a string or integer, got %T", unstructured)
+}
+
 // unknownPreserved represents unknown data preserved in custom resources via x-kubernetes-preserve-unknown-fields.
 // It preserves the data at runtime without assuming it is of any particular type and supports only equality checking.
 // unknownPreserved should be used only for values are not directly accessible in CEL expressions, i.e. for data
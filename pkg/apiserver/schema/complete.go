@@ -52,6 +52,11 @@ func validateValueValidationCompleteness(v *ValueValidation, s *Structural, sPat
 	for i := range v.OneOf {
 		allErrs = append(allErrs, validateNestedValueValidationCompleteness(&v.OneOf[i], s, sPath, vPath.Child("oneOf").Index(i))...)
 	}
+	allErrs = append(allErrs, validateNestedValueValidationCompleteness(v.If, s, sPath, vPath.Child("if"))...)
+	allErrs = append(allErrs, validateNestedValueValidationCompleteness(v.Then, s, sPath, vPath.Child("then"))...)
+	allErrs = append(allErrs, validateNestedValueValidationCompleteness(v.Else, s, sPath, vPath.Child("else"))...)
+
+	// propertyNames applies to the property names, not to the value. Hence, there is nothing to complete.
 
 	return allErrs
 }
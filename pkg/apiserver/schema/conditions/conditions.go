@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+const (
+	// TransitionTime is the x-kubernetes-conditions value for lists of conditions whose
+	// lastTransitionTime is set by the server.
+	TransitionTime = "transitionTime"
+	// ObservedGeneration is the x-kubernetes-conditions value for lists of conditions whose
+	// lastTransitionTime and, on the status subresource, observedGeneration are set by the server.
+	ObservedGeneration = "observedGeneration"
+)
+
+// SetTransitionTimes sets the lastTransitionTime of the conditions in the lists of obj marked with
+// x-kubernetes-conditions in s. Conditions whose status is unchanged compared to the condition of
+// the same type in oldObj keep the old lastTransitionTime, all other conditions get now. oldObj
+// may be nil on create. Items of other lists are matched with the old items by index.
+func SetTransitionTimes(s *structuralschema.Structural, oldObj, obj map[string]interface{}, now time.Time) {
+	if s == nil || obj == nil {
+		return
+	}
+	setTransitionTimes(s, oldObj, obj, now.UTC().Format(time.RFC3339))
+}
+
+func setTransitionTimes(s *structuralschema.Structural, oldValue, newValue interface{}, now string) {
+	if s == nil {
+		return
+	}
+
+	switch newValue := newValue.(type) {
+	case map[string]interface{}:
+		oldMap, _ := oldValue.(map[string]interface{})
+		for k, v := range newValue {
+			if prop, ok := s.Properties[k]; ok {
+				setTransitionTimes(&prop, oldMap[k], v, now)
+			} else if s.AdditionalProperties != nil {
+				setTransitionTimes(s.AdditionalProperties.Structural, oldMap[k], v, now)
+			}
+		}
+	case []interface{}:
+		oldList, _ := oldValue.([]interface{})
+		if s.XConditions != nil {
+			// the old list is shared, e.g. with status reset on the main resource. Nothing changed.
+			if len(oldList) > 0 && len(oldList) == len(newValue) && &oldList[0] == &newValue[0] {
+				return
+			}
+			setConditionTransitionTimes(oldList, newValue, now)
+			return
+		}
+		for i, v := range newValue {
+			var old interface{}
+			if i < len(oldList) {
+				old = oldList[i]
+			}
+			setTransitionTimes(s.Items, old, v, now)
+		}
+	}
+}
+
+func setConditionTransitionTimes(oldConditions, newConditions []interface{}, now string) {
+	oldByType := map[interface{}]map[string]interface{}{}
+	for _, c := range oldConditions {
+		if c, ok := c.(map[string]interface{}); ok {
+			oldByType[c["type"]] = c
+		}
+	}
+	for _, c := range newConditions {
+		c, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		old, found := oldByType[c["type"]]
+		if found && equality.Semantic.DeepEqual(old["status"], c["status"]) && old["lastTransitionTime"] != nil {
+			c["lastTransitionTime"] = old["lastTransitionTime"]
+		} else {
+			c["lastTransitionTime"] = now
+		}
+	}
+}
+
+// SetObservedGenerations sets the observedGeneration of the conditions in the lists of obj marked
+// with x-kubernetes-conditions `observedGeneration` in s to generation.
+func SetObservedGenerations(s *structuralschema.Structural, obj map[string]interface{}, generation int64) {
+	if s == nil || obj == nil {
+		return
+	}
+	setObservedGenerations(s, obj, generation)
+}
+
+func setObservedGenerations(s *structuralschema.Structural, x interface{}, generation int64) {
+	if s == nil {
+		return
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				setObservedGenerations(&prop, v, generation)
+			} else if s.AdditionalProperties != nil {
+				setObservedGenerations(s.AdditionalProperties.Structural, v, generation)
+			}
+		}
+	case []interface{}:
+		if s.XConditions != nil {
+			if *s.XConditions == ObservedGeneration {
+				for _, c := range x {
+					if c, ok := c.(map[string]interface{}); ok {
+						c["observedGeneration"] = generation
+					}
+				}
+			}
+			return
+		}
+		for _, v := range x {
+			setObservedGenerations(s.Items, v, generation)
+		}
+	}
+}
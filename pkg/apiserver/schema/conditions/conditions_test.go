@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+func conditionsSchema(conditions string) *structuralschema.Structural {
+	return &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"status": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"conditions": {
+						Generic:    structuralschema.Generic{Type: "array"},
+						Extensions: structuralschema.Extensions{XConditions: &conditions},
+						Items: &structuralschema.Structural{
+							Generic: structuralschema.Generic{Type: "object"},
+							Properties: map[string]structuralschema.Structural{
+								"type":               {Generic: structuralschema.Generic{Type: "string"}},
+								"status":             {Generic: structuralschema.Generic{Type: "string"}},
+								"lastTransitionTime": {Generic: structuralschema.Generic{Type: "string"}},
+								"observedGeneration": {Generic: structuralschema.Generic{Type: "integer"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetTransitionTimes(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := conditionsSchema(TransitionTime)
+
+	tests := []struct {
+		name     string
+		old, new string
+		expected string
+	}{
+		{
+			name:     "create",
+			new:      `{"status":{"conditions":[{"type":"Ready","status":"True"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-10-01T12:00:00Z"}]}}`,
+		},
+		{
+			name:     "client time is overridden on create",
+			new:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2000-01-01T00:00:00Z"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-10-01T12:00:00Z"}]}}`,
+		},
+		{
+			name:     "unchanged status keeps old time",
+			old:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+			new:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2000-01-01T00:00:00Z","reason":"Other"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z","reason":"Other"}]}}`,
+		},
+		{
+			name:     "changed status",
+			old:      `{"status":{"conditions":[{"type":"Ready","status":"False","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+			new:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-10-01T12:00:00Z"}]}}`,
+		},
+		{
+			name:     "new condition",
+			old:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+			new:      `{"status":{"conditions":[{"type":"Progressing","status":"True"},{"type":"Ready","status":"True"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Progressing","status":"True","lastTransitionTime":"2021-10-01T12:00:00Z"},{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+		},
+		{
+			name:     "reordered conditions keep old times",
+			old:      `{"status":{"conditions":[{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"},{"type":"Degraded","status":"False","lastTransitionTime":"2021-08-01T00:00:00Z"}]}}`,
+			new:      `{"status":{"conditions":[{"type":"Degraded","status":"False"},{"type":"Ready","status":"True"}]}}`,
+			expected: `{"status":{"conditions":[{"type":"Degraded","status":"False","lastTransitionTime":"2021-08-01T00:00:00Z"},{"type":"Ready","status":"True","lastTransitionTime":"2021-09-01T00:00:00Z"}]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oldObj, newObj, expected map[string]interface{}
+			if len(tt.old) > 0 {
+				if err := json.Unmarshal([]byte(tt.old), &oldObj); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := json.Unmarshal([]byte(tt.new), &newObj); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			SetTransitionTimes(s, oldObj, newObj, now)
+			if !reflect.DeepEqual(newObj, expected) {
+				t.Errorf("expected %v, got %v", expected, newObj)
+			}
+		})
+	}
+}
+
+func TestSetTransitionTimesSharedList(t *testing.T) {
+	conditions := []interface{}{
+		map[string]interface{}{"type": "Ready", "status": "True"},
+	}
+	oldObj := map[string]interface{}{"status": map[string]interface{}{"conditions": conditions}}
+	newObj := map[string]interface{}{"status": oldObj["status"]}
+
+	SetTransitionTimes(conditionsSchema(TransitionTime), oldObj, newObj, time.Now())
+	if _, ok := conditions[0].(map[string]interface{})["lastTransitionTime"]; ok {
+		t.Errorf("expected the conditions shared with the old object to be unchanged, got %v", conditions)
+	}
+}
+
+func TestSetObservedGenerations(t *testing.T) {
+	obj := func() map[string]interface{} {
+		return map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "observedGeneration": int64(1)},
+				},
+			},
+		}
+	}
+
+	got := obj()
+	SetObservedGenerations(conditionsSchema(ObservedGeneration), got, 3)
+	if generation := got["status"].(map[string]interface{})["conditions"].([]interface{})[0].(map[string]interface{})["observedGeneration"]; generation != int64(3) {
+		t.Errorf("expected observedGeneration 3, got %v", generation)
+	}
+
+	got = obj()
+	SetObservedGenerations(conditionsSchema(TransitionTime), got, 3)
+	if !reflect.DeepEqual(got, obj()) {
+		t.Errorf("expected observedGeneration to be unchanged, got %v", got)
+	}
+}
@@ -18,9 +18,16 @@ package schema
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 )
 
+// DefinitionRefPrefix is the prefix of $ref values pointing to local definitions.
+const DefinitionRefPrefix = "#/definitions/"
+
 // NewStructural converts an OpenAPI v3 schema into a structural schema. A pre-validated JSONSchemaProps will
 // not fail on NewStructural. This means that we require that:
 //
@@ -28,11 +35,15 @@ import (
 // - the following fields are not set:
 //   - id
 //   - schema
-//   - $ref
 //   - patternProperties
 //   - dependencies
-//   - additionalItems
-//   - definitions.
+//   - additionalItems.
+//
+// Definitions are only allowed at the root. $ref must point to a local definition in the form
+// "#/definitions/<name>" without any other field being set, and is not allowed at the root, as a
+// definition itself or under a logical junctor. References are resolved into the referenced
+// definition, with Definition set to its name. Recursive definitions lead to a cyclic structural
+// schema. Definitions which are not referenced are not allowed.
 //
 // The follow fields are not preserved:
 // - externalDocs
@@ -42,16 +53,58 @@ func NewStructural(s *apiextensions.JSONSchemaProps) (*Structural, error) {
 		return nil, nil
 	}
 
+	if s.Ref != nil {
+		return nil, fmt.Errorf("OpenAPIV3Schema '$ref' is not supported at the root")
+	}
+
+	refs := &references{
+		definitions: s.Definitions,
+		resolved:    map[string]*Structural{},
+	}
+	root := *s
+	root.Definitions = nil
+	ss, err := newStructural(&root, refs)
+	if err != nil {
+		return nil, err
+	}
+	if err := refs.resolve(); err != nil {
+		return nil, err
+	}
+	var unreferenced []string
+	for name := range s.Definitions {
+		if _, ok := refs.resolved[name]; !ok {
+			unreferenced = append(unreferenced, name)
+		}
+	}
+	if len(unreferenced) > 0 {
+		sort.Strings(unreferenced)
+		return nil, fmt.Errorf("OpenAPIV3Schema definitions %q are not referenced", unreferenced)
+	}
+
+	return ss, nil
+}
+
+func newStructural(s *apiextensions.JSONSchemaProps, refs *references) (*Structural, error) {
+	if s == nil {
+		return nil, nil
+	}
+
 	if err := validateUnsupportedFields(s); err != nil {
 		return nil, err
 	}
+	if len(s.Definitions) > 0 {
+		return nil, fmt.Errorf("OpenAPIV3Schema 'definitions' is only supported at the root")
+	}
+	if s.Ref != nil {
+		return refs.placeholder(s)
+	}
 
 	vv, err := newValueValidation(s)
 	if err != nil {
 		return nil, err
 	}
 
-	g, err := newGenerics(s)
+	g, err := newGenerics(s, refs)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +125,7 @@ func NewStructural(s *apiextensions.JSONSchemaProps) (*Structural, error) {
 			// we validate that it is not an array
 			return nil, fmt.Errorf("OpenAPIV3Schema 'items' must be a schema, but is an array")
 		}
-		item, err := NewStructural(s.Items.Schema)
+		item, err := newStructural(s.Items.Schema, refs)
 		if err != nil {
 			return nil, err
 		}
@@ -82,18 +135,108 @@ func NewStructural(s *apiextensions.JSONSchemaProps) (*Structural, error) {
 	if len(s.Properties) > 0 {
 		ss.Properties = make(map[string]Structural, len(s.Properties))
 		for k, x := range s.Properties {
-			fld, err := NewStructural(&x)
+			fld, err := newStructural(&x, refs)
 			if err != nil {
 				return nil, err
 			}
 			ss.Properties[k] = *fld
+			if len(fld.Definition) > 0 {
+				refs.setProperty(ss.Properties, k, fld.Definition)
+			}
 		}
 	}
 
 	return ss, nil
 }
 
-func newGenerics(s *apiextensions.JSONSchemaProps) (*Generic, error) {
+// references collects the $ref schemas met during conversion and resolves them
+// against the definitions of the root schema afterwards.
+type references struct {
+	definitions apiextensions.JSONSchemaDefinitions
+	// resolved holds the converted definitions by name.
+	resolved map[string]*Structural
+	// unresolved holds the places to write a definition to once it is converted.
+	unresolved []reference
+}
+
+type reference struct {
+	name string
+	set  func(def *Structural)
+}
+
+// placeholder returns an empty schema for the given $ref schema which is replaced
+// by the referenced definition in resolve.
+func (r *references) placeholder(s *apiextensions.JSONSchemaProps) (*Structural, error) {
+	if r == nil {
+		return nil, fmt.Errorf("OpenAPIV3Schema '$ref' is not supported under logical junctors")
+	}
+	name, err := definitionName(s)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := r.definitions[name]; !ok {
+		return nil, fmt.Errorf("OpenAPIV3Schema '$ref' %q points to an unknown definition", *s.Ref)
+	}
+
+	ss := &Structural{Definition: name}
+	r.unresolved = append(r.unresolved, reference{name: name, set: func(def *Structural) {
+		*ss = *def
+	}})
+	return ss, nil
+}
+
+// setProperty records that the given property is a reference to be resolved. Properties are
+// stored by value, such that the placeholder itself is not enough.
+func (r *references) setProperty(properties map[string]Structural, k string, name string) {
+	r.unresolved = append(r.unresolved, reference{name: name, set: func(def *Structural) {
+		properties[k] = *def
+	}})
+}
+
+// resolve converts the referenced definitions and writes them into the places they are referenced from.
+// Converting a definition can add more references, which are resolved as well.
+func (r *references) resolve() error {
+	for i := 0; i < len(r.unresolved); i++ {
+		ref := r.unresolved[i]
+		def, err := r.definition(ref.name)
+		if err != nil {
+			return err
+		}
+		ref.set(def)
+	}
+	return nil
+}
+
+func (r *references) definition(name string) (*Structural, error) {
+	if def, ok := r.resolved[name]; ok {
+		return def, nil
+	}
+
+	s := r.definitions[name]
+	if s.Ref != nil {
+		return nil, fmt.Errorf("OpenAPIV3Schema definition %q must not be a '$ref'", name)
+	}
+	def, err := newStructural(&s, r)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAPIV3Schema definition %q: %v", name, err)
+	}
+	def.Definition = name
+	r.resolved[name] = def
+	return def, nil
+}
+
+// definitionName returns the name of the local definition a $ref schema points to.
+func definitionName(s *apiextensions.JSONSchemaProps) (string, error) {
+	if !reflect.DeepEqual(*s, apiextensions.JSONSchemaProps{Ref: s.Ref}) {
+		return "", fmt.Errorf("OpenAPIV3Schema '$ref' must not be combined with other fields")
+	}
+	if !strings.HasPrefix(*s.Ref, DefinitionRefPrefix) || len(*s.Ref) == len(DefinitionRefPrefix) {
+		return "", fmt.Errorf("OpenAPIV3Schema '$ref' %q must point to a local definition of the form %q", *s.Ref, DefinitionRefPrefix+"<name>")
+	}
+	return strings.TrimPrefix(*s.Ref, DefinitionRefPrefix), nil
+}
+
+func newGenerics(s *apiextensions.JSONSchemaProps, refs *references) (*Generic, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -109,7 +252,7 @@ func newGenerics(s *apiextensions.JSONSchemaProps) (*Generic, error) {
 
 	if s.AdditionalProperties != nil {
 		if s.AdditionalProperties.Schema != nil {
-			ss, err := NewStructural(s.AdditionalProperties.Schema)
+			ss, err := newStructural(s.AdditionalProperties.Schema, refs)
 			if err != nil {
 				return nil, err
 			}
@@ -147,6 +290,24 @@ func newValueValidation(s *apiextensions.JSONSchemaProps) (*ValueValidation, err
 		MinProperties:    s.MinProperties,
 		Required:         s.Required,
 		Not:              not,
+
+		DependentRequired: s.DependentRequired,
+	}
+
+	if s.Const != nil {
+		v.Const = &JSON{*s.Const}
+	}
+	if v.If, err = newNestedValueValidation(s.If); err != nil {
+		return nil, err
+	}
+	if v.Then, err = newNestedValueValidation(s.Then); err != nil {
+		return nil, err
+	}
+	if v.Else, err = newNestedValueValidation(s.Else); err != nil {
+		return nil, err
+	}
+	if v.PropertyNames, err = newNestedValueValidation(s.PropertyNames); err != nil {
+		return nil, err
 	}
 
 	for _, e := range s.Enum {
@@ -188,13 +349,19 @@ func newNestedValueValidation(s *apiextensions.JSONSchemaProps) (*NestedValueVal
 	if err := validateUnsupportedFields(s); err != nil {
 		return nil, err
 	}
+	if s.Ref != nil {
+		return nil, fmt.Errorf("OpenAPIV3Schema '$ref' is not supported under logical junctors")
+	}
+	if len(s.Definitions) > 0 {
+		return nil, fmt.Errorf("OpenAPIV3Schema 'definitions' is only supported at the root")
+	}
 
 	vv, err := newValueValidation(s)
 	if err != nil {
 		return nil, err
 	}
 
-	g, err := newGenerics(s)
+	g, err := newGenerics(s, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -247,6 +414,15 @@ func newExtensions(s *apiextensions.JSONSchemaProps) (*Extensions, error) {
 		XListType:         s.XListType,
 		XMapType:          s.XMapType,
 		XValidations:      s.XValidations,
+		XValueType:        s.XValueType,
+		XNormalize:        s.XNormalize,
+		XDeprecated:       s.XDeprecated,
+		XSensitive:        s.XSensitive,
+		XWritePermission:  s.XWritePermission,
+		XConditions:       s.XConditions,
+
+		XEmbeddedResourceKinds: s.XEmbeddedResourceKinds,
+		XReference:             s.XReference,
 	}
 
 	if s.XPreserveUnknownFields != nil {
@@ -267,9 +443,6 @@ func validateUnsupportedFields(s *apiextensions.JSONSchemaProps) error {
 	if len(s.Schema) > 0 {
 		return fmt.Errorf("OpenAPIV3Schema 'schema' is not supported")
 	}
-	if s.Ref != nil && len(*s.Ref) > 0 {
-		return fmt.Errorf("OpenAPIV3Schema '$ref' is not supported")
-	}
 	if len(s.PatternProperties) > 0 {
 		return fmt.Errorf("OpenAPIV3Schema 'patternProperties' is not supported")
 	}
@@ -279,9 +452,6 @@ func validateUnsupportedFields(s *apiextensions.JSONSchemaProps) error {
 	if s.AdditionalItems != nil {
 		return fmt.Errorf("OpenAPIV3Schema 'additionalItems' is not supported")
 	}
-	if len(s.Definitions) > 0 {
-		return fmt.Errorf("OpenAPIV3Schema 'definitions' is not supported")
-	}
 
 	return nil
 }
@@ -104,3 +104,144 @@ func TestStructuralRoundtripOrError(t *testing.T) {
 		}
 	}
 }
+
+func TestStructuralDefinitions(t *testing.T) {
+	ref := func(name string) apiextensions.JSONSchemaProps {
+		r := "#/definitions/" + name
+		return apiextensions.JSONSchemaProps{Ref: &r}
+	}
+	refPtr := func(name string) *apiextensions.JSONSchemaProps {
+		r := ref(name)
+		return &r
+	}
+	node := apiextensions.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensions.JSONSchemaProps{
+			"value": {Type: "string"},
+			"children": {
+				Type:  "array",
+				Items: &apiextensions.JSONSchemaPropsOrArray{Schema: refPtr("node")},
+			},
+			"labels": {
+				Type:                 "object",
+				AdditionalProperties: &apiextensions.JSONSchemaPropsOrBool{Allows: true, Schema: refPtr("leaf")},
+			},
+		},
+	}
+	leaf := apiextensions.JSONSchemaProps{Type: "string", MaxLength: int64Ptr(42)}
+
+	tests := []struct {
+		name        string
+		schema      *apiextensions.JSONSchemaProps
+		expectError bool
+	}{
+		{"recursive", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			Properties:  map[string]apiextensions.JSONSchemaProps{"spec": ref("node")},
+			Definitions: apiextensions.JSONSchemaDefinitions{"node": node, "leaf": leaf},
+		}, false},
+		{"unknown definition", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			Properties:  map[string]apiextensions.JSONSchemaProps{"spec": ref("foo")},
+			Definitions: apiextensions.JSONSchemaDefinitions{"node": node, "leaf": leaf},
+		}, true},
+		{"non-local reference", &apiextensions.JSONSchemaProps{
+			Type:       "object",
+			Properties: map[string]apiextensions.JSONSchemaProps{"spec": {Ref: stringPtr("http://example.com/schema.json")}},
+		}, true},
+		{"reference with other fields", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			Properties:  map[string]apiextensions.JSONSchemaProps{"spec": {Ref: stringPtr("#/definitions/leaf"), Description: "foo"}},
+			Definitions: apiextensions.JSONSchemaDefinitions{"leaf": leaf},
+		}, true},
+		{"reference at the root", &apiextensions.JSONSchemaProps{
+			Ref:         stringPtr("#/definitions/leaf"),
+			Definitions: apiextensions.JSONSchemaDefinitions{"leaf": leaf},
+		}, true},
+		{"reference as definition", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			Properties:  map[string]apiextensions.JSONSchemaProps{"spec": ref("foo")},
+			Definitions: apiextensions.JSONSchemaDefinitions{"foo": ref("leaf"), "leaf": leaf},
+		}, true},
+		{"reference under a logical junctor", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			AllOf:       []apiextensions.JSONSchemaProps{{Properties: map[string]apiextensions.JSONSchemaProps{"spec": ref("leaf")}}},
+			Definitions: apiextensions.JSONSchemaDefinitions{"leaf": leaf},
+		}, true},
+		{"unreferenced definitions", &apiextensions.JSONSchemaProps{
+			Type:        "object",
+			Properties:  map[string]apiextensions.JSONSchemaProps{"spec": ref("leaf")},
+			Definitions: apiextensions.JSONSchemaDefinitions{"node": node, "leaf": leaf},
+		}, true},
+		{"nested definitions", &apiextensions.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiextensions.JSONSchemaProps{"spec": {
+				Type:        "object",
+				Definitions: apiextensions.JSONSchemaDefinitions{"leaf": leaf},
+			}},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss, err := NewStructural(tt.schema)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// the schema is cyclic through the definition
+			spec := ss.Properties["spec"]
+			if spec.Definition != "node" {
+				t.Fatalf("expected spec to be resolved from definition node, got %q", spec.Definition)
+			}
+			child := spec.Properties["children"].Items
+			if child.Definition != "node" || child.Properties["children"].Items != child {
+				t.Errorf("expected children items to be the node definition")
+			}
+			if label := spec.Properties["labels"].AdditionalProperties.Structural; label.Definition != "leaf" || label.Type != "string" {
+				t.Errorf("expected labels to be the leaf definition, got %#v", label)
+			}
+
+			// deepcopy keeps the cycle, but does not share it with the original
+			cp := ss.DeepCopy()
+			cpChild := cp.Properties["spec"].Properties["children"].Items
+			if cpChild == child || cpChild.Properties["children"].Items != cpChild {
+				t.Errorf("expected a cyclic copy of the node definition")
+			}
+
+			if errs := ValidateStructural(nil, ss); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+
+			// roundtrip through go-openapi, JSON, v1beta1 JSONSchemaProp, internal JSONSchemaProp
+			bs, err := json.Marshal(ss.ToKubeOpenAPI())
+			if err != nil {
+				t.Fatal(err)
+			}
+			v1beta1Schema := &apiextensionsv1beta1.JSONSchemaProps{}
+			if err := json.Unmarshal(bs, v1beta1Schema); err != nil {
+				t.Fatal(err)
+			}
+			internalSchema := &apiextensions.JSONSchemaProps{}
+			if err := apiextensionsv1beta1.Convert_v1beta1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(v1beta1Schema, internalSchema, nil); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.schema, internalSchema) {
+				t.Errorf("original and result differ: %v", diff.ObjectDiff(tt.schema, internalSchema))
+			}
+		})
+	}
+}
+
+func int64Ptr(x int64) *int64 {
+	return &x
+}
+
+func stringPtr(x string) *string {
+	return &x
+}
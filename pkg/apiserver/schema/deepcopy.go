@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+// DeepCopyInto copies the receiver into out. Schemas resolved from the same definition
+// keep sharing their children in the copy, such that cyclic schemas can be copied.
+func (in *Structural) DeepCopyInto(out *Structural) {
+	in.deepCopyInto(out, map[string]*Structural{})
+}
+
+// DeepCopy copies the receiver, creating a new Structural.
+func (in *Structural) DeepCopy() *Structural {
+	if in == nil {
+		return nil
+	}
+	out := new(Structural)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Structural) deepCopyInto(out *Structural, copied map[string]*Structural) {
+	if len(in.Definition) > 0 {
+		if c, ok := copied[in.Definition]; ok {
+			*out = *c
+			return
+		}
+	}
+
+	*out = *in
+	in.Generic.Default.DeepCopyInto(&out.Generic.Default)
+	in.Extensions.DeepCopyInto(&out.Extensions)
+	if in.ValueValidation != nil {
+		out.ValueValidation = in.ValueValidation.DeepCopy()
+	}
+
+	// allocate the children before descending such that cycles back to this
+	// definition end up in the same containers.
+	if in.Items != nil {
+		out.Items = new(Structural)
+	}
+	if in.Properties != nil {
+		out.Properties = make(map[string]Structural, len(in.Properties))
+	}
+	if in.AdditionalProperties != nil {
+		out.AdditionalProperties = &StructuralOrBool{Bool: in.AdditionalProperties.Bool}
+		if in.AdditionalProperties.Structural != nil {
+			out.AdditionalProperties.Structural = new(Structural)
+		}
+	}
+	if len(in.Definition) > 0 {
+		copied[in.Definition] = out
+	}
+
+	if in.Items != nil {
+		in.Items.deepCopyInto(out.Items, copied)
+	}
+	for k, v := range in.Properties {
+		var fld Structural
+		v.deepCopyInto(&fld, copied)
+		out.Properties[k] = fld
+	}
+	if in.AdditionalProperties != nil && in.AdditionalProperties.Structural != nil {
+		in.AdditionalProperties.Structural.deepCopyInto(out.AdditionalProperties.Structural, copied)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulting
+
+import (
+	"time"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+)
+
+// Canonicalize rewrites the string values of fields with x-kubernetes-value-type
+// quantity or duration in x into their canonical form, e.g. "1000m" into "1" and
+// "90m" into "1h30m0s". Integer values are canonical already. Values which cannot
+// be parsed are left unchanged for validation to reject them.
+func Canonicalize(x interface{}, s *structuralschema.Structural) {
+	if s == nil {
+		return
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, found := s.Properties[k]; found {
+				x[k] = canonicalize(v, &prop)
+			} else if s.AdditionalProperties != nil {
+				x[k] = canonicalize(v, s.AdditionalProperties.Structural)
+			}
+		}
+	case []interface{}:
+		for i, v := range x {
+			x[i] = canonicalize(v, s.Items)
+		}
+	}
+}
+
+// canonicalize returns the canonical form of x if it is a quantity or duration string,
+// and x otherwise after canonicalizing its children.
+func canonicalize(x interface{}, s *structuralschema.Structural) interface{} {
+	if s == nil {
+		return x
+	}
+
+	str, ok := x.(string)
+	if !ok || s.XValueType == nil {
+		Canonicalize(x, s)
+		return x
+	}
+
+	switch *s.XValueType {
+	case "quantity":
+		if q, err := resource.ParseQuantity(str); err == nil {
+			return q.String()
+		}
+	case "duration":
+		if d, err := strfmt.ParseDuration(str); err == nil {
+			return time.Duration(d).String()
+		}
+	}
+	return x
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulting
+
+import (
+	"reflect"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+func TestCanonicalize(t *testing.T) {
+	quantity := "quantity"
+	duration := "duration"
+	quantityType := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string"},
+		Extensions: structuralschema.Extensions{XValueType: &quantity},
+	}
+	intOrStringQuantityType := structuralschema.Structural{
+		Extensions: structuralschema.Extensions{XIntOrString: true, XValueType: &quantity},
+	}
+	durationType := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string"},
+		Extensions: structuralschema.Extensions{XValueType: &duration},
+	}
+
+	tests := []struct {
+		name     string
+		json     string
+		schema   *structuralschema.Structural
+		expected string
+	}{
+		{"empty", "null", nil, "null"},
+		{"no value type", `{"a":"1000m"}`, &structuralschema.Structural{
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"a": {Generic: structuralschema.Generic{Type: "string"}},
+			},
+		}, `{"a":"1000m"}`},
+		{"quantities", `{"a":"1000m","b":"0.5","c":"1Gi","d":5,"e":"2000","f":"invalid"}`, &structuralschema.Structural{
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"a": quantityType,
+				"b": quantityType,
+				"c": quantityType,
+				"d": intOrStringQuantityType,
+				"e": intOrStringQuantityType,
+				"f": quantityType,
+			},
+		}, `{"a":"1","b":"500m","c":"1Gi","d":5,"e":"2k","f":"invalid"}`},
+		{"durations", `{"a":"90m","b":"1h0m0s","c":"invalid"}`, &structuralschema.Structural{
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"a": durationType,
+				"b": durationType,
+				"c": durationType,
+			},
+		}, `{"a":"1h30m0s","b":"1h0m0s","c":"invalid"}`},
+		{"nested in arrays and maps", `{"a":["1000m","2"],"m":{"x":"60s"}}`, &structuralschema.Structural{
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"a": {
+					Generic: structuralschema.Generic{Type: "array"},
+					Items:   &quantityType,
+				},
+				"m": {
+					Generic: structuralschema.Generic{
+						Type:                 "object",
+						AdditionalProperties: &structuralschema.StructuralOrBool{Structural: &durationType},
+					},
+				},
+			},
+		}, `{"a":["1","2"],"m":{"x":"1m0s"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var in interface{}
+			if err := json.Unmarshal([]byte(tt.json), &in); err != nil {
+				t.Fatal(err)
+			}
+
+			var expected interface{}
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			Canonicalize(in, tt.schema)
+			if !reflect.DeepEqual(in, expected) {
+				t.Errorf("expected: %s\ngot: %#v", tt.expected, in)
+			}
+		})
+	}
+}
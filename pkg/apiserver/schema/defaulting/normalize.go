@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulting
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Normalizations are the supported values of x-kubernetes-normalize, in the order they are applied.
+var Normalizations = []string{"trim", "collapse-whitespace", "lowercase", "nfc"}
+
+// Normalize applies the x-kubernetes-normalize normalizations in s to the string values in x.
+func Normalize(x interface{}, s *structuralschema.Structural) {
+	if s == nil {
+		return
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, found := s.Properties[k]; found {
+				x[k] = normalize(v, &prop)
+			} else if s.AdditionalProperties != nil {
+				x[k] = normalize(v, s.AdditionalProperties.Structural)
+			}
+		}
+	case []interface{}:
+		for i, v := range x {
+			x[i] = normalize(v, s.Items)
+		}
+	}
+}
+
+// normalize returns the normalized value of x if it is a string, and x otherwise after normalizing its children.
+func normalize(x interface{}, s *structuralschema.Structural) interface{} {
+	if s == nil {
+		return x
+	}
+
+	str, ok := x.(string)
+	if !ok || len(s.XNormalize) == 0 {
+		Normalize(x, s)
+		return x
+	}
+	return NormalizeString(str, s.XNormalize)
+}
+
+// NormalizeString applies the given normalizations to value. The normalizations are applied in the order
+// of Normalizations, independently of the order given, such that normalizing is idempotent. Unknown
+// normalizations are ignored.
+func NormalizeString(value string, normalizations []string) string {
+	requested := sets.NewString(normalizations...)
+	if requested.Has("trim") {
+		value = strings.TrimSpace(value)
+	}
+	if requested.Has("collapse-whitespace") {
+		value = collapseWhitespace(value)
+	}
+	if requested.Has("lowercase") {
+		value = strings.ToLower(value)
+	}
+	if requested.Has("nfc") {
+		value = norm.NFC.String(value)
+	}
+	return value
+}
+
+// collapseWhitespace replaces each run of whitespace in s by a single space.
+func collapseWhitespace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	inSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				b.WriteRune(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulting
+
+import (
+	"reflect"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+func TestNormalizeString(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		normalizations []string
+		expected       string
+	}{
+		{"none", " Foo  Bar ", nil, " Foo  Bar "},
+		{"trim", " \tFoo  Bar\n", []string{"trim"}, "Foo  Bar"},
+		{"collapse whitespace", " Foo \t\n Bar ", []string{"collapse-whitespace"}, " Foo Bar "},
+		{"lowercase", "Foo.Example.COM", []string{"lowercase"}, "foo.example.com"},
+		{"nfc", "Cafe\u0301", []string{"nfc"}, "Caf\u00e9"},
+		{"all", "  Cafe\u0301   Example.COM ", []string{"lowercase", "nfc", "collapse-whitespace", "trim"}, "caf\u00e9 example.com"},
+		{"unknown", "Foo", []string{"uppercase"}, "Foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeString(tt.value, tt.normalizations)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+			if again := NormalizeString(got, tt.normalizations); again != got {
+				t.Errorf("expected normalization to be idempotent, got %q after normalizing %q again", again, got)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	normalized := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string"},
+		Extensions: structuralschema.Extensions{XNormalize: []string{"trim", "lowercase"}},
+	}
+	s := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"host":  normalized,
+			"plain": {Generic: structuralschema.Generic{Type: "string"}},
+			"hosts": {
+				Generic: structuralschema.Generic{Type: "array"},
+				Items:   &normalized,
+			},
+			"labels": {
+				Generic: structuralschema.Generic{
+					Type:                 "object",
+					AdditionalProperties: &structuralschema.StructuralOrBool{Structural: &normalized},
+				},
+			},
+		},
+	}
+
+	var in interface{}
+	if err := json.Unmarshal([]byte(`{"host":" Foo.Example.COM ","plain":" Foo ","hosts":["A.com ",1],"labels":{"Key":" Value"}}`), &in); err != nil {
+		t.Fatal(err)
+	}
+	var expected interface{}
+	if err := json.Unmarshal([]byte(`{"host":"foo.example.com","plain":" Foo ","hosts":["a.com",1],"labels":{"Key":"value"}}`), &expected); err != nil {
+		t.Fatal(err)
+	}
+
+	Normalize(in, s)
+	if !reflect.DeepEqual(in, expected) {
+		t.Errorf("expected: %#v\ngot: %#v", expected, in)
+	}
+}
@@ -29,13 +29,15 @@ import (
 // PruneDefaults prunes default values according to the schema and according to
 // the ObjectMeta definition of the running server. It mutates the passed schema.
 func PruneDefaults(s *structuralschema.Structural) error {
-	p := pruner{s}
+	p := pruner{rootSchema: s, visited: map[string]bool{}}
 	_, err := p.pruneDefaults(s, NewRootObjectFunc())
 	return err
 }
 
 type pruner struct {
 	rootSchema *structuralschema.Structural
+	// visited holds the definitions whose children have been pruned already.
+	visited map[string]bool
 }
 
 func (p *pruner) pruneDefaults(s *structuralschema.Structural, f SurroundingObjectFunc) (changed bool, err error) {
@@ -62,6 +64,14 @@ func (p *pruner) pruneDefaults(s *structuralschema.Structural, f SurroundingObje
 		changed = changed || !reflect.DeepEqual(orig, s.Default.Object)
 	}
 
+	// the children of a definition are shared by every schema resolved from it
+	if len(s.Definition) > 0 {
+		if p.visited[s.Definition] {
+			return changed, nil
+		}
+		p.visited[s.Definition] = true
+	}
+
 	if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
 		c, err := p.pruneDefaults(s.AdditionalProperties.Structural, f.Child("*"))
 		if err != nil {
@@ -28,7 +28,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"k8s.io/kube-openapi/pkg/validation/strfmt"
 	kubeopenapivalidate "k8s.io/kube-openapi/pkg/validation/validate"
 )
 
@@ -47,17 +46,51 @@ func ValidateDefaults(pth *field.Path, s *structuralschema.Structural, isResourc
 		}
 	}
 
-	return validate(pth, s, s, f, false, requirePrunedDefaults)
+	defs := &definitions{seen: map[string]bool{}}
+	allErrs, err := validate(pth, s, s, f, false, requirePrunedDefaults, defs)
+	if err != nil {
+		return nil, err
+	}
+
+	// defaults in definitions are validated once, independently of where they are referenced from.
+	// Definitions can reference more definitions.
+	for i := 0; i < len(defs.queue); i++ {
+		def := *defs.queue[i]
+		def.Definition = ""
+		f := NewRootObjectFunc().WithTypeMeta(metav1.TypeMeta{APIVersion: "validation/v1", Kind: "Validation"})
+		errs, err := validate(pth.Child("definitions").Key(defs.queue[i].Definition), &def, &def, f, false, requirePrunedDefaults, defs)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs, nil
+}
+
+// definitions collects the definitions referenced in a schema.
+type definitions struct {
+	seen  map[string]bool
+	queue []*structuralschema.Structural
 }
 
 // validate is the recursive step func for the validation. insideMeta is true if s specifies
 // TypeMeta or ObjectMeta. The SurroundingObjectFunc f is used to validate defaults of
-// TypeMeta or ObjectMeta fields.
-func validate(pth *field.Path, s *structuralschema.Structural, rootSchema *structuralschema.Structural, f SurroundingObjectFunc, insideMeta, requirePrunedDefaults bool) (field.ErrorList, error) {
+// TypeMeta or ObjectMeta fields. Schemas resolved from a definition are queued in defs
+// instead of being validated.
+func validate(pth *field.Path, s *structuralschema.Structural, rootSchema *structuralschema.Structural, f SurroundingObjectFunc, insideMeta, requirePrunedDefaults bool, defs *definitions) (field.ErrorList, error) {
 	if s == nil {
 		return nil, nil
 	}
 
+	if len(s.Definition) > 0 {
+		if !defs.seen[s.Definition] {
+			defs.seen[s.Definition] = true
+			defs.queue = append(defs.queue, s)
+		}
+		return nil, nil
+	}
+
 	if s.XEmbeddedResource {
 		insideMeta = false
 		f = NewRootObjectFunc().WithTypeMeta(metav1.TypeMeta{APIVersion: "validation/v1", Kind: "Validation"})
@@ -67,7 +100,11 @@ func validate(pth *field.Path, s *structuralschema.Structural, rootSchema *struc
 	allErrs := field.ErrorList{}
 
 	if s.Default.Object != nil {
-		validator := kubeopenapivalidate.NewSchemaValidator(s.ToKubeOpenAPI(), nil, "", strfmt.Default)
+		openapiSchema := s.ToKubeOpenAPI()
+		if err := apiservervalidation.ResolveLocalReferences(openapiSchema); err != nil {
+			return nil, fmt.Errorf("failed to resolve references: %v", err)
+		}
+		validator := kubeopenapivalidate.NewSchemaValidator(openapiSchema, nil, "", apiservervalidation.DefaultFormatRegistry.Registry())
 
 		if insideMeta {
 			obj, _, err := f(runtime.DeepCopyJSONValue(s.Default.Object))
@@ -114,7 +151,7 @@ func validate(pth *field.Path, s *structuralschema.Structural, rootSchema *struc
 	// do not follow additionalProperties because defaults are forbidden there
 
 	if s.Items != nil {
-		errs, err := validate(pth.Child("items"), s.Items, rootSchema, f.Index(), insideMeta, requirePrunedDefaults)
+		errs, err := validate(pth.Child("items"), s.Items, rootSchema, f.Index(), insideMeta, requirePrunedDefaults, defs)
 		if err != nil {
 			return nil, err
 		}
@@ -126,7 +163,7 @@ func validate(pth *field.Path, s *structuralschema.Structural, rootSchema *struc
 		if s.XEmbeddedResource && (k == "metadata" || k == "apiVersion" || k == "kind") {
 			subInsideMeta = true
 		}
-		errs, err := validate(pth.Child("properties").Key(k), &subSchema, rootSchema, f.Child(k), subInsideMeta, requirePrunedDefaults)
+		errs, err := validate(pth.Child("properties").Key(k), &subSchema, rootSchema, f.Child(k), subInsideMeta, requirePrunedDefaults, defs)
 		if err != nil {
 			return nil, err
 		}
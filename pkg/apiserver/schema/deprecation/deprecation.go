@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+// Usage is the use of a field marked with x-kubernetes-deprecated in an object.
+type Usage struct {
+	// Path is the path of the field in the object, e.g. spec.items[0].foo.
+	Path *field.Path
+	// SchemaPath is the path of the field in the schema, with list indices and
+	// map keys replaced by [*], e.g. spec.items[*].foo.
+	SchemaPath string
+	// Message is the deprecation message of the field.
+	Message string
+}
+
+// Warning returns the warning for u returned to clients.
+func (u Usage) Warning() string {
+	return fmt.Sprintf("%s: %s", u.Path.String(), u.Message)
+}
+
+// Find returns the fields of obj which are marked with x-kubernetes-deprecated in s, which
+// are set to a value other than their default and which are new or changed compared to oldObj,
+// sorted by path. oldObj is nil on create. List items are compared with the old items by index.
+func Find(s *structuralschema.Structural, oldObj, obj map[string]interface{}) []Usage {
+	if s == nil || obj == nil {
+		return nil
+	}
+	usages := findObject(nil, nil, s, oldObj, obj)
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Path.String() < usages[j].Path.String()
+	})
+	return usages
+}
+
+func find(fldPath, schemaPath *field.Path, s *structuralschema.Structural, old, x interface{}) []Usage {
+	if s == nil {
+		return nil
+	}
+
+	var usages []Usage
+	if s.XDeprecated != nil && x != nil && !equality.Semantic.DeepEqual(x, s.Default.Object) && !equality.Semantic.DeepEqual(x, old) {
+		usages = append(usages, Usage{Path: fldPath, SchemaPath: schemaPath.String(), Message: *s.XDeprecated})
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		oldMap, _ := old.(map[string]interface{})
+		usages = append(usages, findObject(fldPath, schemaPath, s, oldMap, x)...)
+	case []interface{}:
+		oldList, _ := old.([]interface{})
+		for i, v := range x {
+			var oldItem interface{}
+			if i < len(oldList) {
+				oldItem = oldList[i]
+			}
+			usages = append(usages, find(fldPath.Index(i), schemaPath.Key("*"), s.Items, oldItem, v)...)
+		}
+	}
+	return usages
+}
+
+func findObject(fldPath, schemaPath *field.Path, s *structuralschema.Structural, oldObj, obj map[string]interface{}) []Usage {
+	var usages []Usage
+	for k, v := range obj {
+		if prop, ok := s.Properties[k]; ok {
+			usages = append(usages, find(fldPath.Child(k), schemaPath.Child(k), &prop, oldObj[k], v)...)
+		} else if s.AdditionalProperties != nil {
+			usages = append(usages, find(fldPath.Key(k), schemaPath.Key("*"), s.AdditionalProperties.Structural, oldObj[k], v)...)
+		}
+	}
+	return usages
+}
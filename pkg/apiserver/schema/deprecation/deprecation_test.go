@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"reflect"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+func TestFind(t *testing.T) {
+	message := "use new instead"
+	deprecated := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string"},
+		Extensions: structuralschema.Extensions{XDeprecated: &message},
+	}
+	deprecatedWithDefault := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string", Default: structuralschema.JSON{Object: "foo"}},
+		Extensions: structuralschema.Extensions{XDeprecated: &message},
+	}
+	schema := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"old":       deprecated,
+			"defaulted": deprecatedWithDefault,
+			"new":       {Generic: structuralschema.Generic{Type: "string"}},
+			"list": {
+				Generic: structuralschema.Generic{Type: "array"},
+				Items: &structuralschema.Structural{
+					Generic: structuralschema.Generic{Type: "object"},
+					Properties: map[string]structuralschema.Structural{
+						"old": deprecated,
+					},
+				},
+			},
+			"map": {
+				Generic: structuralschema.Generic{
+					Type:                 "object",
+					AdditionalProperties: &structuralschema.StructuralOrBool{Structural: &deprecated},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		json     string
+		schema   *structuralschema.Structural
+		expected []string
+	}{
+		{"no schema", `{"old":"x"}`, nil, nil},
+		{"not set", `{"new":"x"}`, schema, nil},
+		{"set", `{"old":"x","new":"y"}`, schema, []string{"old: use new instead"}},
+		{"default value", `{"defaulted":"foo"}`, schema, nil},
+		{"non-default value", `{"defaulted":"bar"}`, schema, []string{"defaulted: use new instead"}},
+		{"nested in lists and maps", `{"list":[{"old":"x"},{}],"map":{"a":"x"}}`, schema, []string{
+			"list[0].old: use new instead",
+			"map[a]: use new instead",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.json), &obj); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, u := range Find(tt.schema, nil, obj) {
+				got = append(got, u.Warning())
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFindChanged(t *testing.T) {
+	message := "use new instead"
+	deprecated := structuralschema.Structural{
+		Generic:    structuralschema.Generic{Type: "string"},
+		Extensions: structuralschema.Extensions{XDeprecated: &message},
+	}
+	schema := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"old": deprecated,
+			"new": {Generic: structuralschema.Generic{Type: "string"}},
+			"list": {
+				Generic: structuralschema.Generic{Type: "array"},
+				Items:   &deprecated,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+		expected []string
+	}{
+		{"unchanged", `{"old":"x","list":["a"]}`, `{"old":"x","new":"y","list":["a"]}`, nil},
+		{"newly set", `{"new":"y"}`, `{"old":"x","new":"y"}`, []string{"old: use new instead"}},
+		{"changed", `{"old":"x"}`, `{"old":"z"}`, []string{"old: use new instead"}},
+		{"list item appended", `{"list":["a"]}`, `{"list":["a","b"]}`, []string{"list[1]: use new instead"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oldObj, obj map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.old), &oldObj); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.new), &obj); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, u := range Find(schema, oldObj, obj) {
+				got = append(got, u.Warning())
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFindSchemaPath(t *testing.T) {
+	message := "deprecated"
+	schema := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"list": {
+				Generic: structuralschema.Generic{Type: "array"},
+				Items: &structuralschema.Structural{
+					Generic:    structuralschema.Generic{Type: "string"},
+					Extensions: structuralschema.Extensions{XDeprecated: &message},
+				},
+			},
+		},
+	}
+
+	usages := Find(schema, nil, map[string]interface{}{"list": []interface{}{"a", "b"}})
+	if len(usages) != 2 {
+		t.Fatalf("expected 2 usages, got %d", len(usages))
+	}
+	for _, u := range usages {
+		if u.SchemaPath != "list[*]" {
+			t.Errorf("expected schema path list[*], got %q", u.SchemaPath)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+// PruneAndDefault prunes and defaults the embedded resources in obj whose schema in s specifies
+// x-kubernetes-embedded-resource-kinds, with the schema of their kind as resolved by r. Embedded
+// resources of kinds which are not allowed or cannot be resolved are left untouched, their errors
+// are reported by Validate.
+func PruneAndDefault(obj interface{}, s *structuralschema.Structural, r Resolver) {
+	if s == nil || r == nil {
+		return
+	}
+
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		if len(s.XEmbeddedResourceKinds) > 0 {
+			if gvk, ok := allowedKind(obj, s); ok {
+				if kindSchema, err := r.Resolve(gvk); err == nil && kindSchema != nil {
+					pruning.Prune(obj, kindSchema.Structural, true)
+					structuraldefaulting.Default(obj, kindSchema.Structural)
+					PruneAndDefault(obj, kindSchema.Structural, r)
+				}
+			}
+		}
+		for k, v := range obj {
+			if prop, ok := s.Properties[k]; ok {
+				PruneAndDefault(v, &prop, r)
+			} else if s.AdditionalProperties != nil {
+				PruneAndDefault(v, s.AdditionalProperties.Structural, r)
+			}
+		}
+	case []interface{}:
+		for _, v := range obj {
+			PruneAndDefault(v, s.Items, r)
+		}
+	}
+}
+
+// Validate validates the embedded resources in obj whose schema in s specifies
+// x-kubernetes-embedded-resource-kinds. Their kind must be one of the allowed kinds, and they are
+// validated against the schema of their kind as resolved by r, as if they were submitted on their own.
+// TypeMeta and ObjectMeta of the embedded resources are validated by objectmeta.Validate.
+func Validate(fldPath *field.Path, obj interface{}, s *structuralschema.Structural, r Resolver) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		if len(s.XEmbeddedResourceKinds) > 0 {
+			allErrs = append(allErrs, validateEmbeddedResource(fldPath, obj, s, r)...)
+		}
+		for k, v := range obj {
+			if prop, ok := s.Properties[k]; ok {
+				allErrs = append(allErrs, Validate(fldPath.Child(k), v, &prop, r)...)
+			} else if s.AdditionalProperties != nil {
+				allErrs = append(allErrs, Validate(fldPath.Key(k), v, s.AdditionalProperties.Structural, r)...)
+			}
+		}
+	case []interface{}:
+		for i, v := range obj {
+			allErrs = append(allErrs, Validate(fldPath.Index(i), v, s.Items, r)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateEmbeddedResource(fldPath *field.Path, obj map[string]interface{}, s *structuralschema.Structural, r Resolver) field.ErrorList {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	if len(apiVersion) == 0 || len(kind) == 0 {
+		// reported by objectmeta.Validate
+		return nil
+	}
+
+	gvk, ok := allowedKind(obj, s)
+	if !ok {
+		allowed := make([]string, 0, len(s.XEmbeddedResourceKinds))
+		for _, k := range s.XEmbeddedResourceKinds {
+			allowed = append(allowed, fmt.Sprintf("%s %s", k.APIVersion, k.Kind))
+		}
+		return field.ErrorList{field.NotSupported(fldPath.Child("kind"), fmt.Sprintf("%s %s", apiVersion, kind), allowed)}
+	}
+	if r == nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("kind"), kind, fmt.Sprintf("the schema of %s %s is unknown", apiVersion, kind))}
+	}
+	kindSchema, err := r.Resolve(gvk)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("kind"), kind, fmt.Sprintf("the schema of %s %s cannot be resolved: %v", apiVersion, kind, err))}
+	}
+	if kindSchema == nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("kind"), kind, fmt.Sprintf("the schema of %s %s is unknown", apiVersion, kind))}
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, apiservervalidation.ValidateCustomResource(fldPath, obj, kindSchema.Validator)...)
+
+	// TypeMeta and ObjectMeta at the root have been validated already, only validate nested embedded resources
+	root := kindSchema.Structural
+	if root != nil && root.XEmbeddedResource {
+		clone := *root
+		clone.XEmbeddedResource = false
+		root = &clone
+	}
+	allErrs = append(allErrs, schemaobjectmeta.Validate(fldPath, obj, root, false)...)
+	allErrs = append(allErrs, structurallisttype.ValidateListSetsAndMaps(fldPath, kindSchema.Structural, obj)...)
+	allErrs = append(allErrs, Validate(fldPath, obj, kindSchema.Structural, r)...)
+
+	return allErrs
+}
+
+// allowedKind returns the kind of the embedded resource obj, and whether it is one of the kinds
+// allowed by s.
+func allowedKind(obj map[string]interface{}, s *structuralschema.Structural) (schema.GroupVersionKind, bool) {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	for _, k := range s.XEmbeddedResourceKinds {
+		if k.APIVersion == apiVersion && k.Kind == kind {
+			gv, err := schema.ParseGroupVersion(apiVersion)
+			if err != nil {
+				return schema.GroupVersionKind{}, false
+			}
+			return gv.WithKind(kind), true
+		}
+	}
+	return schema.GroupVersionKind{}, false
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+type fakeResolver map[schema.GroupVersionKind]*Schema
+
+func (r fakeResolver) Resolve(gvk schema.GroupVersionKind) (*Schema, error) {
+	return r[gvk], nil
+}
+
+func widgetResolver(t *testing.T) Resolver {
+	var one apiextensions.JSON = int64(1)
+	zero := float64(0)
+	s, err := NewSchema(&apiextensions.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensions.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec": {
+				Type: "object",
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"replicas": {Type: "integer", Minimum: &zero, Default: &one},
+					"paused":   {Type: "boolean"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fakeResolver{{Group: "example.com", Version: "v1", Kind: "Widget"}: s}
+}
+
+func templateSchema() *structuralschema.Structural {
+	return &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"template": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Extensions: structuralschema.Extensions{
+					XEmbeddedResource:      true,
+					XPreserveUnknownFields: true,
+					XEmbeddedResourceKinds: []apiextensions.EmbeddedResourceKind{
+						{APIVersion: "example.com/v1", Kind: "Widget"},
+						{APIVersion: "example.com/v1", Kind: "Gadget"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPruneAndDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      string
+		expected string
+	}{
+		{
+			name:     "pruned and defaulted",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","unknown":true},"spec":{"paused":true,"unknown":1},"unknown":{}}}`,
+			expected: `{"template":{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","unknown":true},"spec":{"paused":true,"replicas":1}}}`,
+		},
+		{
+			name:     "kind not allowed",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Other","spec":{"unknown":1}}}`,
+			expected: `{"template":{"apiVersion":"example.com/v1","kind":"Other","spec":{"unknown":1}}}`,
+		},
+		{
+			name:     "unknown kind",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Gadget","spec":{"unknown":1}}}`,
+			expected: `{"template":{"apiVersion":"example.com/v1","kind":"Gadget","spec":{"unknown":1}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj, expected interface{}
+			if err := json.Unmarshal([]byte(tt.obj), &obj); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			PruneAndDefault(obj, templateSchema(), widgetResolver(t))
+			if !reflect.DeepEqual(obj, expected) {
+				t.Errorf("expected %v, got %v", expected, obj)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      string
+		expected []string
+	}{
+		{
+			name: "valid",
+			obj:  `{"template":{"apiVersion":"example.com/v1","kind":"Widget","spec":{"replicas":3}}}`,
+		},
+		{
+			name:     "invalid against the schema of the kind",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Widget","spec":{"replicas":-1,"paused":"yes"}}}`,
+			expected: []string{"template.spec.paused", "template.spec.replicas"},
+		},
+		{
+			name:     "kind not allowed",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Other"}}`,
+			expected: []string{"template.kind"},
+		},
+		{
+			name:     "unknown kind",
+			obj:      `{"template":{"apiVersion":"example.com/v1","kind":"Gadget"}}`,
+			expected: []string{"template.kind"},
+		},
+		{
+			name: "missing kind is left to objectmeta validation",
+			obj:  `{"template":{"apiVersion":"example.com/v1"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj interface{}
+			if err := json.Unmarshal([]byte(tt.obj), &obj); err != nil {
+				t.Fatal(err)
+			}
+
+			errs := Validate(nil, obj, templateSchema(), widgetResolver(t))
+			if got := errorFields(errs); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected errors for %v, got %v", tt.expected, errs)
+			}
+		})
+	}
+}
+
+func errorFields(errs field.ErrorList) []string {
+	var ret []string
+	seen := map[string]bool{}
+	for _, err := range errs {
+		if !seen[err.Field] {
+			ret = append(ret, err.Field)
+		}
+		seen[err.Field] = true
+	}
+	sort.Strings(ret)
+	return ret
+}
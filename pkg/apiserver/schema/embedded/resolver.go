@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"k8s.io/utils/pointer"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+// Schema is the schema of an embedded resource kind.
+type Schema struct {
+	// Structural is used to prune and default embedded objects of the kind.
+	Structural *structuralschema.Structural
+	// Validator is used to validate embedded objects of the kind.
+	Validator *validate.SchemaValidator
+}
+
+// NewSchema returns the schema of an embedded resource kind for the given OpenAPI schema. The
+// schema may contain local definitions.
+func NewSchema(s *apiextensions.JSONSchemaProps) (*Schema, error) {
+	ss, err := structuralschema.NewStructural(s)
+	if err != nil {
+		return nil, err
+	}
+	validator, _, err := apiservervalidation.NewSchemaValidator(&apiextensions.CustomResourceValidation{OpenAPIV3Schema: s})
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{Structural: ss, Validator: validator}, nil
+}
+
+// Resolver resolves the schemas of embedded resource kinds.
+type Resolver interface {
+	// Resolve returns the schema of the given kind, or nil if the kind is unknown.
+	Resolve(gvk schema.GroupVersionKind) (*Schema, error)
+}
+
+// Resolvers is a Resolver which returns the schema of the first resolver knowing the kind.
+type Resolvers []Resolver
+
+// Resolve implements Resolver.
+func (rs Resolvers) Resolve(gvk schema.GroupVersionKind) (*Schema, error) {
+	for _, r := range rs {
+		if r == nil {
+			continue
+		}
+		s, err := r.Resolve(gvk)
+		if err != nil || s != nil {
+			return s, err
+		}
+	}
+	return nil, nil
+}
+
+// quantityDefinition is the definition of resource.Quantity, which is a string in OpenAPI, but
+// is also accepted as a number.
+const quantityDefinition = "io.k8s.apimachinery.pkg.api.resource.Quantity"
+
+type openAPIResolver struct {
+	definitions spec.Definitions
+	kinds       map[schema.GroupVersionKind]string
+
+	lock    sync.Mutex
+	schemas map[schema.GroupVersionKind]*Schema
+}
+
+// NewOpenAPIResolver returns a Resolver for the kinds of the given OpenAPI v2 spec, as marked by
+// the x-kubernetes-group-version-kind extension of its definitions. Only the structure of the
+// definitions is used, i.e. types, properties, items, additional properties and required fields.
+func NewOpenAPIResolver(s *spec.Swagger) Resolver {
+	r := &openAPIResolver{
+		kinds:   map[schema.GroupVersionKind]string{},
+		schemas: map[schema.GroupVersionKind]*Schema{},
+	}
+	if s == nil {
+		return r
+	}
+	r.definitions = s.Definitions
+	for name, def := range s.Definitions {
+		for _, gvk := range groupVersionKinds(def.Extensions) {
+			r.kinds[gvk] = name
+		}
+	}
+	return r
+}
+
+// groupVersionKinds returns the kinds of the x-kubernetes-group-version-kind extension.
+func groupVersionKinds(extensions spec.Extensions) []schema.GroupVersionKind {
+	ext, ok := extensions["x-kubernetes-group-version-kind"]
+	if !ok {
+		return nil
+	}
+	// the extension is a list of maps, of different Go types depending on the origin of the spec
+	bs, err := json.Marshal(ext)
+	if err != nil {
+		return nil
+	}
+	var gvks []schema.GroupVersionKind
+	if err := json.Unmarshal(bs, &gvks); err != nil {
+		return nil
+	}
+	return gvks
+}
+
+// Resolve implements Resolver.
+func (r *openAPIResolver) Resolve(gvk schema.GroupVersionKind) (*Schema, error) {
+	name, ok := r.kinds[gvk]
+	if !ok {
+		return nil, nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if s, ok := r.schemas[gvk]; ok {
+		return s, nil
+	}
+
+	def := r.definitions[name]
+	root := fromOpenAPI(&def)
+	root.Definitions = apiextensions.JSONSchemaDefinitions{}
+	r.addReferencedDefinitions(&root, root.Definitions)
+	if len(root.Definitions) == 0 {
+		root.Definitions = nil
+	}
+	s, err := NewSchema(&root)
+	if err != nil {
+		return nil, err
+	}
+	r.schemas[gvk] = s
+	return s, nil
+}
+
+// addReferencedDefinitions adds the definitions referenced by s, directly or indirectly, to defs.
+func (r *openAPIResolver) addReferencedDefinitions(s *apiextensions.JSONSchemaProps, defs apiextensions.JSONSchemaDefinitions) {
+	if s.Ref != nil {
+		name := strings.TrimPrefix(*s.Ref, structuralschema.DefinitionRefPrefix)
+		if _, ok := defs[name]; ok {
+			return
+		}
+		def := r.definitions[name]
+		defs[name] = fromOpenAPI(&def)
+		converted := defs[name]
+		r.addReferencedDefinitions(&converted, defs)
+		return
+	}
+	for _, prop := range s.Properties {
+		r.addReferencedDefinitions(&prop, defs)
+	}
+	if s.Items != nil && s.Items.Schema != nil {
+		r.addReferencedDefinitions(s.Items.Schema, defs)
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		r.addReferencedDefinitions(s.AdditionalProperties.Schema, defs)
+	}
+}
+
+// fromOpenAPI converts the structure of an OpenAPI v2 schema. Values may be null, as with
+// omitempty pointers of built-in types. Objects without properties and values without type
+// preserve unknown fields.
+func fromOpenAPI(s *spec.Schema) apiextensions.JSONSchemaProps {
+	if ref := s.Ref.String(); len(ref) > 0 {
+		if ref == structuralschema.DefinitionRefPrefix+quantityDefinition {
+			return apiextensions.JSONSchemaProps{Nullable: true, XPreserveUnknownFields: pointer.BoolPtr(true)}
+		}
+		return apiextensions.JSONSchemaProps{Ref: &ref}
+	}
+
+	ret := apiextensions.JSONSchemaProps{Nullable: true}
+	if len(s.Type) == 1 {
+		ret.Type = s.Type[0]
+	}
+	switch {
+	case s.Format == "int-or-string":
+		ret.Type = ""
+		ret.XIntOrString = true
+		return ret
+	case ret.Type == "string" || ret.Type == "integer" || ret.Type == "number":
+		ret.Format = s.Format
+	}
+
+	ret.Required = s.Required
+	if len(s.Properties) > 0 {
+		ret.Properties = make(map[string]apiextensions.JSONSchemaProps, len(s.Properties))
+		for k, prop := range s.Properties {
+			ret.Properties[k] = fromOpenAPI(&prop)
+		}
+	}
+	if s.Items != nil && s.Items.Schema != nil {
+		items := fromOpenAPI(s.Items.Schema)
+		ret.Items = &apiextensions.JSONSchemaPropsOrArray{Schema: &items}
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		additionalProperties := fromOpenAPI(s.AdditionalProperties.Schema)
+		ret.AdditionalProperties = &apiextensions.JSONSchemaPropsOrBool{Allows: true, Schema: &additionalProperties}
+	}
+
+	switch {
+	case len(ret.Type) == 0:
+		ret.XPreserveUnknownFields = pointer.BoolPtr(true)
+	case ret.Type == "object" && ret.Properties == nil && ret.AdditionalProperties == nil:
+		ret.XPreserveUnknownFields = pointer.BoolPtr(true)
+	case ret.Type == "array" && ret.Items == nil:
+		ret.Items = &apiextensions.JSONSchemaPropsOrArray{Schema: &apiextensions.JSONSchemaProps{Nullable: true, XPreserveUnknownFields: pointer.BoolPtr(true)}}
+	}
+
+	return ret
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+const swaggerJSON = `{
+  "swagger": "2.0",
+  "info": {"title": "Kubernetes", "version": "v1"},
+  "paths": {},
+  "definitions": {
+    "io.k8s.api.apps.v1.Deployment": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {"type": "string"},
+        "kind": {"type": "string"},
+        "metadata": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},
+        "spec": {"$ref": "#/definitions/io.k8s.api.apps.v1.DeploymentSpec"}
+      },
+      "x-kubernetes-group-version-kind": [{"group": "apps", "kind": "Deployment", "version": "v1"}]
+    },
+    "io.k8s.api.apps.v1.DeploymentSpec": {
+      "type": "object",
+      "required": ["selector"],
+      "properties": {
+        "replicas": {"type": "integer", "format": "int32"},
+        "selector": {"type": "object", "additionalProperties": {"type": "string"}},
+        "maxSurge": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.util.intstr.IntOrString"},
+        "cpu": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.api.resource.Quantity"},
+        "template": {"type": "object"}
+      }
+    },
+    "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {
+      "type": "object",
+      "properties": {
+        "name": {"type": "string"},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+      }
+    },
+    "io.k8s.apimachinery.pkg.util.intstr.IntOrString": {"type": "string", "format": "int-or-string"},
+    "io.k8s.apimachinery.pkg.api.resource.Quantity": {"type": "string"}
+  }
+}`
+
+func TestOpenAPIResolver(t *testing.T) {
+	swagger := &spec.Swagger{}
+	if err := json.Unmarshal([]byte(swaggerJSON), swagger); err != nil {
+		t.Fatal(err)
+	}
+	r := NewOpenAPIResolver(swagger)
+
+	if s, err := r.Resolve(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}); err != nil || s != nil {
+		t.Errorf("expected unknown kind to resolve to nil, got %v, %v", s, err)
+	}
+
+	s, err := r.Resolve(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s == nil {
+		t.Fatal("expected Deployment to be resolved")
+	}
+	if again, _ := r.Resolve(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}); again != s {
+		t.Errorf("expected the schema to be cached")
+	}
+
+	tests := []struct {
+		name  string
+		obj   string
+		valid bool
+	}{
+		{
+			name:  "valid",
+			obj:   `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"foo","labels":null},"spec":{"replicas":3,"selector":{"app":"foo"},"maxSurge":"25%","cpu":0.5,"template":{"anything":true}}}`,
+			valid: true,
+		},
+		{
+			name:  "int-or-string as integer",
+			obj:   `{"apiVersion":"apps/v1","kind":"Deployment","spec":{"selector":{},"maxSurge":1}}`,
+			valid: true,
+		},
+		{
+			name: "wrong type",
+			obj:  `{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":"3","selector":{}}}`,
+		},
+		{
+			name: "missing required field",
+			obj:  `{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":3}}`,
+		},
+		{
+			name: "wrong type in referenced definition",
+			obj:  `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"labels":{"app":1}},"spec":{"selector":{}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj interface{}
+			if err := utiljson.Unmarshal([]byte(tt.obj), &obj); err != nil {
+				t.Fatal(err)
+			}
+			errs := apiservervalidation.ValidateCustomResource(nil, obj, s.Validator)
+			if tt.valid && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			} else if !tt.valid && len(errs) == 0 {
+				t.Errorf("expected errors")
+			}
+		})
+	}
+}
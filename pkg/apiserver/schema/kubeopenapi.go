@@ -18,33 +18,63 @@ package schema
 
 import (
 	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 )
 
 // ToKubeOpenAPI converts a structural schema to go-openapi schema. It is faithful and roundtrippable.
+// Schemas resolved from a definition are converted into a $ref to the corresponding entry in the
+// definitions of the returned root schema.
 func (s *Structural) ToKubeOpenAPI() *spec.Schema {
 	if s == nil {
 		return nil
 	}
 
+	defs := &definitionQueue{}
+	ret := s.toKubeOpenAPI(defs, true)
+
+	// converting a definition can reference more definitions.
+	for i := 0; i < len(defs.queue); i++ {
+		def := defs.queue[i]
+		if ret.Definitions == nil {
+			ret.Definitions = spec.Definitions{}
+		}
+		ret.Definitions[def.Definition] = *def.toKubeOpenAPI(defs, true)
+	}
+
+	return ret
+}
+
+// toKubeOpenAPI converts s, with references to definitions for all schemas resolved from a
+// definition, except for s itself if expand is true.
+func (s *Structural) toKubeOpenAPI(defs *definitionQueue, expand bool) *spec.Schema {
+	if s == nil {
+		return nil
+	}
+	if len(s.Definition) > 0 && !expand {
+		defs.add(s)
+		return spec.RefSchema(DefinitionRefPrefix + s.Definition)
+	}
+
 	ret := &spec.Schema{}
 
 	if s.Items != nil {
-		ret.Items = &spec.SchemaOrArray{Schema: s.Items.ToKubeOpenAPI()}
+		ret.Items = &spec.SchemaOrArray{Schema: s.Items.toKubeOpenAPI(defs, false)}
 	}
 	if s.Properties != nil {
 		ret.Properties = make(map[string]spec.Schema, len(s.Properties))
 		for k, v := range s.Properties {
-			ret.Properties[k] = *v.ToKubeOpenAPI()
+			ret.Properties[k] = *v.toKubeOpenAPI(defs, false)
 		}
 	}
-	s.Generic.toKubeOpenAPI(ret)
+	s.Generic.toKubeOpenAPI(ret, defs)
 	s.Extensions.toKubeOpenAPI(ret)
 	s.ValueValidation.toKubeOpenAPI(ret)
 
 	return ret
 }
 
-func (g *Generic) toKubeOpenAPI(ret *spec.Schema) {
+func (g *Generic) toKubeOpenAPI(ret *spec.Schema, defs *definitionQueue) {
 	if g == nil {
 		return
 	}
@@ -56,7 +86,11 @@ func (g *Generic) toKubeOpenAPI(ret *spec.Schema) {
 	if g.AdditionalProperties != nil {
 		ret.AdditionalProperties = &spec.SchemaOrBool{
 			Allows: g.AdditionalProperties.Bool,
-			Schema: g.AdditionalProperties.Structural.ToKubeOpenAPI(),
+		}
+		if defs != nil {
+			ret.AdditionalProperties.Schema = g.AdditionalProperties.Structural.toKubeOpenAPI(defs, false)
+		} else {
+			ret.AdditionalProperties.Schema = g.AdditionalProperties.Structural.ToKubeOpenAPI()
 		}
 	}
 	ret.Description = g.Description
@@ -90,6 +124,46 @@ func (x *Extensions) toKubeOpenAPI(ret *spec.Schema) {
 	if len(x.XValidations) > 0 {
 		ret.VendorExtensible.AddExtension("x-kubernetes-validations", x.XValidations)
 	}
+	if x.XValueType != nil {
+		ret.VendorExtensible.AddExtension("x-kubernetes-value-type", *x.XValueType)
+	}
+	if len(x.XNormalize) > 0 {
+		ret.VendorExtensible.AddExtension("x-kubernetes-normalize", x.XNormalize)
+	}
+	if x.XDeprecated != nil {
+		ret.VendorExtensible.AddExtension("x-kubernetes-deprecated", *x.XDeprecated)
+	}
+	if x.XSensitive {
+		ret.VendorExtensible.AddExtension("x-kubernetes-sensitive", true)
+	}
+	if x.XWritePermission != nil {
+		ret.VendorExtensible.AddExtension("x-kubernetes-write-permission", *x.XWritePermission)
+	}
+	if x.XConditions != nil {
+		ret.VendorExtensible.AddExtension("x-kubernetes-conditions", *x.XConditions)
+	}
+	if len(x.XEmbeddedResourceKinds) > 0 {
+		ret.VendorExtensible.AddExtension("x-kubernetes-embedded-resource-kinds", x.XEmbeddedResourceKinds)
+	}
+	if x.XReference != nil {
+		ret.VendorExtensible.AddExtension("x-kubernetes-reference", referenceExtension(x.XReference))
+	}
+}
+
+// referenceExtension returns the published form of x-kubernetes-reference, with the field names
+// of the versioned API.
+func referenceExtension(r *apiextensions.ReferenceTarget) map[string]interface{} {
+	ret := map[string]interface{}{
+		"resource": r.Resource,
+		"scope":    string(r.Scope),
+	}
+	if len(r.Group) > 0 {
+		ret["group"] = r.Group
+	}
+	if len(r.Policy) > 0 {
+		ret["policy"] = string(r.Policy)
+	}
+	return ret
 }
 
 func (v *ValueValidation) toKubeOpenAPI(ret *spec.Schema) {
@@ -128,6 +202,33 @@ func (v *ValueValidation) toKubeOpenAPI(ret *spec.Schema) {
 		ret.OneOf = append(ret.OneOf, *v.OneOf[i].toKubeOpenAPI())
 	}
 	ret.Not = v.Not.toKubeOpenAPI()
+
+	// keywords unknown to spec.Schema are stored as extra properties, which are serialized inline.
+	if v.Const != nil {
+		addExtraProperty(ret, "const", v.Const.Object)
+	}
+	if v.If != nil {
+		addExtraProperty(ret, "if", v.If.toKubeOpenAPI())
+	}
+	if v.Then != nil {
+		addExtraProperty(ret, "then", v.Then.toKubeOpenAPI())
+	}
+	if v.Else != nil {
+		addExtraProperty(ret, "else", v.Else.toKubeOpenAPI())
+	}
+	if len(v.DependentRequired) > 0 {
+		addExtraProperty(ret, "dependentRequired", v.DependentRequired)
+	}
+	if v.PropertyNames != nil {
+		addExtraProperty(ret, "propertyNames", v.PropertyNames.toKubeOpenAPI())
+	}
+}
+
+func addExtraProperty(s *spec.Schema, key string, value interface{}) {
+	if s.ExtraProps == nil {
+		s.ExtraProps = map[string]interface{}{}
+	}
+	s.ExtraProps[key] = value
 }
 
 func (vv *NestedValueValidation) toKubeOpenAPI() *spec.Schema {
@@ -147,8 +248,8 @@ func (vv *NestedValueValidation) toKubeOpenAPI() *spec.Schema {
 			ret.Properties[k] = *v.toKubeOpenAPI()
 		}
 	}
-	vv.ForbiddenGenerics.toKubeOpenAPI(ret)   // normally empty. Exception: int-or-string
-	vv.ForbiddenExtensions.toKubeOpenAPI(ret) // shouldn't do anything
+	vv.ForbiddenGenerics.toKubeOpenAPI(ret, nil) // normally empty. Exception: int-or-string
+	vv.ForbiddenExtensions.toKubeOpenAPI(ret)    // shouldn't do anything
 
 	return ret
 }
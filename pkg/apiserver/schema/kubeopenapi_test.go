@@ -55,6 +55,11 @@ func TestStructuralKubeOpenAPIRoundtrip(t *testing.T) {
 				s.Object = int64(42)
 			}
 		},
+		func(s *Structural, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			// definitions are only produced by NewStructural
+			s.Definition = ""
+		},
 	)
 	f.MaxDepth(3)
 	f.NilChance(0.5)
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+// Lister looks up referents.
+type Lister interface {
+	// Exists returns whether the object with the given name of the given resource exists.
+	// namespace is empty for cluster-scoped resources. An error is returned if the object cannot
+	// be looked up, e.g. because the resource has a different scope.
+	Exists(gr schema.GroupResource, namespace, name string) (bool, error)
+}
+
+// IsChecked returns true if e specifies x-kubernetes-reference with a policy other than Ignore.
+// It is meant to be passed to structuralschema.HasExtension.
+func IsChecked(e *structuralschema.Extensions) bool {
+	return e.XReference != nil && checked(e.XReference.Policy)
+}
+
+func checked(policy apiextensions.ReferencePolicy) bool {
+	return policy == apiextensions.ReferencePolicyWarn || policy == apiextensions.ReferencePolicyReject
+}
+
+// Validate returns an error for every reference in obj with policy Reject whose referent does not
+// exist. References of namespaced resources are looked up in namespace, and are skipped if it is
+// empty. If oldObj is not nil, references with the same value at the same path in oldObj are not
+// looked up again. References whose referent cannot be looked up are not rejected, but warned about
+// by Warnings.
+func Validate(fldPath *field.Path, s *structuralschema.Structural, obj, oldObj map[string]interface{}, namespace string, l Lister) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, d := range dangling(fldPath, s, obj, oldObj, namespace, l, apiextensions.ReferencePolicyReject) {
+		if d.err == nil {
+			allErrs = append(allErrs, field.Invalid(d.path, d.name, fmt.Sprintf("must reference an existing %s", d.gr)))
+		}
+	}
+	return allErrs
+}
+
+// Warnings returns a warning for every reference in obj with policy Warn whose referent does not
+// exist, and for every reference with policy Warn or Reject whose referent cannot be looked up, with
+// the same rules as Validate.
+func Warnings(s *structuralschema.Structural, obj, oldObj map[string]interface{}, namespace string, l Lister) []string {
+	var warnings []string
+	for _, policy := range []apiextensions.ReferencePolicy{apiextensions.ReferencePolicyWarn, apiextensions.ReferencePolicyReject} {
+		for _, d := range dangling(nil, s, obj, oldObj, namespace, l, policy) {
+			if d.err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: cannot verify that %s %q exists: %v", d.path, d.gr, d.name, d.err))
+			} else if policy == apiextensions.ReferencePolicyWarn {
+				warnings = append(warnings, fmt.Sprintf("%s: %s %q does not exist", d.path, d.gr, d.name))
+			}
+		}
+	}
+	return warnings
+}
+
+// danglingReference is a reference whose referent does not exist, or could not be looked up.
+type danglingReference struct {
+	path *field.Path
+	gr   schema.GroupResource
+	name string
+	err  error
+}
+
+func dangling(fldPath *field.Path, s *structuralschema.Structural, obj, oldObj map[string]interface{}, namespace string, l Lister, policy apiextensions.ReferencePolicy) []danglingReference {
+	if s == nil || obj == nil || l == nil {
+		return nil
+	}
+
+	var refs []reference
+	collect(fldPath, s, obj, policy, &refs)
+	if len(refs) == 0 {
+		return nil
+	}
+	unchanged := map[string]string{}
+	if oldObj != nil {
+		var oldRefs []reference
+		collect(fldPath, s, oldObj, policy, &oldRefs)
+		for _, r := range oldRefs {
+			unchanged[r.path.String()] = r.name
+		}
+	}
+
+	var ret []danglingReference
+	for _, r := range refs {
+		if old, ok := unchanged[r.path.String()]; ok && old == r.name {
+			continue
+		}
+		ns := ""
+		if r.target.Scope == apiextensions.NamespaceScoped {
+			if len(namespace) == 0 {
+				continue
+			}
+			ns = namespace
+		}
+		gr := schema.GroupResource{Group: r.target.Group, Resource: r.target.Resource}
+		exists, err := l.Exists(gr, ns, r.name)
+		if err != nil || !exists {
+			ret = append(ret, danglingReference{path: r.path, gr: gr, name: r.name, err: err})
+		}
+	}
+	return ret
+}
+
+// reference is a non-empty reference field in an object.
+type reference struct {
+	path   *field.Path
+	target *apiextensions.ReferenceTarget
+	name   string
+}
+
+func collect(fldPath *field.Path, s *structuralschema.Structural, x interface{}, policy apiextensions.ReferencePolicy, refs *[]reference) {
+	if s == nil {
+		return
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				collect(fldPath.Child(k), &prop, v, policy, refs)
+			} else if s.AdditionalProperties != nil {
+				collect(fldPath.Key(k), s.AdditionalProperties.Structural, v, policy, refs)
+			}
+		}
+	case []interface{}:
+		for i, v := range x {
+			collect(fldPath.Index(i), s.Items, v, policy, refs)
+		}
+	case string:
+		if s.XReference != nil && s.XReference.Policy == policy && len(x) > 0 {
+			*refs = append(*refs, reference{path: fldPath, target: s.XReference, name: x})
+		}
+	}
+}
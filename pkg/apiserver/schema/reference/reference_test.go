@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+type fakeLister map[string]bool
+
+func (l fakeLister) Exists(gr schema.GroupResource, namespace, name string) (bool, error) {
+	if name == "error" {
+		return false, fmt.Errorf("cache not synced")
+	}
+	return l[gr.String()+"/"+namespace+"/"+name], nil
+}
+
+func referenceSchema(policy apiextensions.ReferencePolicy) *structuralschema.Structural {
+	return &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"spec": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"secretStoreRef": {
+						Generic: structuralschema.Generic{Type: "object"},
+						Properties: map[string]structuralschema.Structural{
+							"name": {
+								Generic:    structuralschema.Generic{Type: "string"},
+								Extensions: structuralschema.Extensions{XReference: &apiextensions.ReferenceTarget{Group: "example.com", Resource: "secretstores", Scope: apiextensions.NamespaceScoped, Policy: policy}},
+							},
+						},
+					},
+					"issuers": {
+						Generic: structuralschema.Generic{Type: "array"},
+						Items: &structuralschema.Structural{
+							Generic:    structuralschema.Generic{Type: "string"},
+							Extensions: structuralschema.Extensions{XReference: &apiextensions.ReferenceTarget{Group: "example.com", Resource: "clusterissuers", Scope: apiextensions.ClusterScoped, Policy: policy}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	lister := fakeLister{
+		"secretstores.example.com/default/vault":  true,
+		"clusterissuers.example.com//letsencrypt": true,
+	}
+
+	tests := []struct {
+		name       string
+		namespace  string
+		obj, old   string
+		expected   []string
+		unverified int
+	}{
+		{
+			name:      "existing referents",
+			namespace: "default",
+			obj:       `{"spec":{"secretStoreRef":{"name":"vault"},"issuers":["letsencrypt"]}}`,
+		},
+		{
+			name:      "dangling references",
+			namespace: "default",
+			obj:       `{"spec":{"secretStoreRef":{"name":"other"},"issuers":["letsencrypt","selfsigned"]}}`,
+			expected:  []string{"spec.issuers[1]", "spec.secretStoreRef.name"},
+		},
+		{
+			name:      "referent in other namespace",
+			namespace: "kube-system",
+			obj:       `{"spec":{"secretStoreRef":{"name":"vault"}}}`,
+			expected:  []string{"spec.secretStoreRef.name"},
+		},
+		{
+			name: "namespaced referent of cluster-scoped object is not checked",
+			obj:  `{"spec":{"secretStoreRef":{"name":"other"}}}`,
+		},
+		{
+			name:       "lookup error",
+			namespace:  "default",
+			obj:        `{"spec":{"secretStoreRef":{"name":"error"}}}`,
+			unverified: 1,
+		},
+		{
+			name:      "unchanged references are not checked on update",
+			namespace: "default",
+			old:       `{"spec":{"secretStoreRef":{"name":"other"},"issuers":["selfsigned"]}}`,
+			obj:       `{"spec":{"secretStoreRef":{"name":"other"},"issuers":["selfsigned","other"]}}`,
+			expected:  []string{"spec.issuers[1]"},
+		},
+		{
+			name:      "empty reference",
+			namespace: "default",
+			obj:       `{"spec":{"secretStoreRef":{"name":""}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj, old map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.obj), &obj); err != nil {
+				t.Fatal(err)
+			}
+			if len(tt.old) > 0 {
+				if err := json.Unmarshal([]byte(tt.old), &old); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var got []string
+			for _, err := range Validate(nil, referenceSchema(apiextensions.ReferencePolicyReject), obj, old, tt.namespace, lister) {
+				got = append(got, err.Field)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected errors for %v, got %v", tt.expected, got)
+			}
+
+			if warnings := Warnings(referenceSchema(apiextensions.ReferencePolicyReject), obj, old, tt.namespace, lister); len(warnings) != tt.unverified {
+				t.Errorf("expected %d warnings for policy Reject, got %v", tt.unverified, warnings)
+			}
+			if warnings := Warnings(referenceSchema(apiextensions.ReferencePolicyWarn), obj, old, tt.namespace, lister); len(warnings) != len(tt.expected)+tt.unverified {
+				t.Errorf("expected %d warnings for policy Warn, got %v", len(tt.expected)+tt.unverified, warnings)
+			}
+			if errs := Validate(nil, referenceSchema(apiextensions.ReferencePolicyIgnore), obj, old, tt.namespace, lister); len(errs) > 0 {
+				t.Errorf("expected no errors for policy Ignore, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestIsChecked(t *testing.T) {
+	if !structuralschema.HasExtension(referenceSchema(apiextensions.ReferencePolicyWarn), IsChecked) {
+		t.Errorf("expected checked references for policy Warn")
+	}
+	if structuralschema.HasExtension(referenceSchema(apiextensions.ReferencePolicyIgnore), IsChecked) {
+		t.Errorf("expected no checked references for policy Ignore")
+	}
+	if structuralschema.HasExtension(referenceSchema(""), IsChecked) {
+		t.Errorf("expected no checked references without policy")
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"strconv"
+	"strings"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// RedactedValue replaces the values of fields marked with x-kubernetes-sensitive.
+const RedactedValue = "<redacted>"
+
+// Paths is the set of the schema paths of the fields which are or contain fields marked with
+// x-kubernetes-sensitive, as computed by NewPaths. A schema path is relative to the root or to
+// the nearest definition, so that a cyclic schema has finitely many. Paths is empty if there
+// are no sensitive fields.
+type Paths map[string]bool
+
+// NewPaths returns the Paths of s. It walks s, and should be called once per schema.
+func NewPaths(s *structuralschema.Structural) Paths {
+	p := Paths{}
+	if s == nil {
+		return p
+	}
+	// a definition that is being walked does not count as sensitive until the next pass, so
+	// passes are repeated until the set does not grow anymore.
+	for {
+		n := len(p)
+		p.add("", s, map[string]bool{}, map[string]bool{})
+		if len(p) == n {
+			return p
+		}
+	}
+}
+
+// add adds the schema paths of s and its subschemas which are or contain sensitive fields to p,
+// and returns whether s is or contains a sensitive field.
+func (p Paths) add(path string, s *structuralschema.Structural, walking, done map[string]bool) bool {
+	if s == nil {
+		return false
+	}
+	path = schemaPath(path, s)
+	if len(s.Definition) > 0 {
+		if walking[s.Definition] || done[s.Definition] {
+			return p[path]
+		}
+		walking[s.Definition] = true
+		defer func() {
+			delete(walking, s.Definition)
+			done[s.Definition] = true
+		}()
+	}
+
+	found := s.XSensitive
+	for k := range s.Properties {
+		prop := s.Properties[k]
+		if p.add(childPath(path, k), &prop, walking, done) {
+			found = true
+		}
+	}
+	if p.add(childPath(path, "*"), s.Items, walking, done) {
+		found = true
+	}
+	if s.AdditionalProperties != nil && p.add(childPath(path, "*"), s.AdditionalProperties.Structural, walking, done) {
+		found = true
+	}
+	if found {
+		p[path] = true
+	}
+	return found
+}
+
+// schemaPath returns the schema path of s, which is a child of the schema at the given path.
+func schemaPath(path string, s *structuralschema.Structural) string {
+	if len(s.Definition) > 0 {
+		return structuralschema.DefinitionRefPrefix + s.Definition
+	}
+	return path
+}
+
+// Redact returns a deep copy of x with the values of fields marked with x-kubernetes-sensitive
+// in s replaced by RedactedValue. x is returned as is if nothing is redacted, i.e. paths, the
+// Paths of s, is empty.
+func Redact(x interface{}, s *structuralschema.Structural, paths Paths) interface{} {
+	if s == nil || len(paths) == 0 {
+		return x
+	}
+	return redact(runtime.DeepCopyJSONValue(x), s)
+}
+
+func redact(x interface{}, s *structuralschema.Structural) interface{} {
+	if s == nil || x == nil {
+		return x
+	}
+	if s.XSensitive {
+		return RedactedValue
+	}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				x[k] = redact(v, &prop)
+			} else if s.AdditionalProperties != nil {
+				x[k] = redact(v, s.AdditionalProperties.Structural)
+			}
+		}
+	case []interface{}:
+		for i, v := range x {
+			x[i] = redact(v, s.Items)
+		}
+	}
+	return x
+}
+
+// Values returns the non-empty string values of fields marked with x-kubernetes-sensitive in x,
+// including the strings nested in sensitive objects and arrays.
+func Values(x interface{}, s *structuralschema.Structural) []string {
+	if s == nil {
+		return nil
+	}
+	if s.XSensitive {
+		return stringValues(x)
+	}
+
+	var values []string
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				values = append(values, Values(v, &prop)...)
+			} else if s.AdditionalProperties != nil {
+				values = append(values, Values(v, s.AdditionalProperties.Structural)...)
+			}
+		}
+	case []interface{}:
+		for _, v := range x {
+			values = append(values, Values(v, s.Items)...)
+		}
+	}
+	return values
+}
+
+func stringValues(x interface{}) []string {
+	switch x := x.(type) {
+	case string:
+		if len(x) > 0 {
+			return []string{x}
+		}
+	case map[string]interface{}:
+		var values []string
+		for _, v := range x {
+			values = append(values, stringValues(v)...)
+		}
+		return values
+	case []interface{}:
+		var values []string
+		for _, v := range x {
+			values = append(values, stringValues(v)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// RedactString replaces all occurrences of the given sensitive values in str by RedactedValue.
+func RedactString(str string, values []string) string {
+	for _, v := range values {
+		str = strings.ReplaceAll(str, v, RedactedValue)
+	}
+	return str
+}
+
+// node is a value in an object with its schema, the schema path and whether it is sensitive, i.e.
+// is or is nested in a field marked with x-kubernetes-sensitive.
+type node struct {
+	value      interface{}
+	schema     *structuralschema.Structural
+	schemaPath string
+	sensitive  bool
+}
+
+// RedactErrors replaces the values of fields marked with x-kubernetes-sensitive in the bad values
+// and details of the given errors about obj. Errors about a sensitive field get RedactedValue as
+// bad value, errors about an object or array containing sensitive fields get a redacted copy of
+// the bad value. Other errors are returned unchanged. paths are the Paths of s.
+func RedactErrors(errs field.ErrorList, s *structuralschema.Structural, paths Paths, obj map[string]interface{}) field.ErrorList {
+	if len(errs) == 0 || s == nil || len(paths) == 0 {
+		return errs
+	}
+
+	nodes := map[string]node{}
+	index("", "", s, obj, false, nodes)
+
+	ret := make(field.ErrorList, 0, len(errs))
+	for _, err := range errs {
+		n, ok := nodes[normalizePath(err.Field)]
+		if !ok {
+			ret = append(ret, err)
+			continue
+		}
+
+		redacted := *err
+		switch {
+		case n.sensitive:
+			if err.BadValue != nil {
+				redacted.BadValue = RedactedValue
+			}
+			redacted.Detail = RedactString(redacted.Detail, stringValues(n.value))
+		case isCompound(err.BadValue) && paths[n.schemaPath]:
+			redacted.BadValue = redact(runtime.DeepCopyJSONValue(err.BadValue), n.schema)
+		}
+		ret = append(ret, &redacted)
+	}
+	return ret
+}
+
+func isCompound(x interface{}) bool {
+	switch x.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+// index adds the nodes of x and all of its descendants to nodes, keyed by their normalized path.
+func index(path, sPath string, s *structuralschema.Structural, x interface{}, sensitive bool, nodes map[string]node) {
+	if s == nil {
+		return
+	}
+	sPath = schemaPath(sPath, s)
+	sensitive = sensitive || s.XSensitive
+	nodes[path] = node{value: x, schema: s, schemaPath: sPath, sensitive: sensitive}
+
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if prop, ok := s.Properties[k]; ok {
+				index(childPath(path, k), childPath(sPath, k), &prop, v, sensitive, nodes)
+			} else if s.AdditionalProperties != nil {
+				index(childPath(path, k), childPath(sPath, "*"), s.AdditionalProperties.Structural, v, sensitive, nodes)
+			}
+		}
+	case []interface{}:
+		for i, v := range x {
+			index(childPath(path, strconv.Itoa(i)), childPath(sPath, "*"), s.Items, v, sensitive, nodes)
+		}
+	}
+}
+
+func childPath(path, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
+
+// normalizePath converts a field path like spec.list[0].map[key] or spec.list.0.map.key, as
+// used by the OpenAPI schema validator, into the dotted form spec.list.0.map.key. The root
+// path, printed as <nil> for errors about the whole object, is empty.
+func normalizePath(path string) string {
+	if path == (*field.Path)(nil).String() {
+		return ""
+	}
+	path = strings.ReplaceAll(path, "[", ".")
+	path = strings.ReplaceAll(path, "]", "")
+	return strings.TrimPrefix(path, ".")
+}
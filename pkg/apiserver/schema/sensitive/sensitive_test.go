@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var testSchema = &structuralschema.Structural{
+	Generic: structuralschema.Generic{Type: "object"},
+	Properties: map[string]structuralschema.Structural{
+		"spec": {
+			Generic: structuralschema.Generic{Type: "object"},
+			Properties: map[string]structuralschema.Structural{
+				"host": {Generic: structuralschema.Generic{Type: "string"}},
+				"token": {
+					Generic:    structuralschema.Generic{Type: "string"},
+					Extensions: structuralschema.Extensions{XSensitive: true},
+				},
+				"credentials": {
+					Generic:    structuralschema.Generic{Type: "array"},
+					Extensions: structuralschema.Extensions{XSensitive: true},
+					Items: &structuralschema.Structural{
+						Generic: structuralschema.Generic{Type: "string"},
+					},
+				},
+				"servers": {
+					Generic: structuralschema.Generic{Type: "array"},
+					Items: &structuralschema.Structural{
+						Generic: structuralschema.Generic{Type: "object"},
+						Properties: map[string]structuralschema.Structural{
+							"name": {Generic: structuralschema.Generic{Type: "string"}},
+							"password": {
+								Generic:    structuralschema.Generic{Type: "string"},
+								Extensions: structuralschema.Extensions{XSensitive: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+const testObject = `{"spec":{"host":"example.com","token":"s3cr3t","credentials":["a-key","b-key"],"servers":[{"name":"foo","password":"hunter2"}]}}`
+
+func unmarshal(t *testing.T, s string) map[string]interface{} {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &obj); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestNewPaths(t *testing.T) {
+	if got, expected := NewPaths(testSchema), (Paths{"": true, "spec": true, "spec.token": true, "spec.credentials": true, "spec.servers": true, "spec.servers.*": true, "spec.servers.*.password": true}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// a recursive definition with a sensitive field, and one without
+	node := &structuralschema.Structural{Generic: structuralschema.Generic{Type: "object"}, Definition: "node"}
+	node.Properties = map[string]structuralschema.Structural{
+		"secret":   {Generic: structuralschema.Generic{Type: "string"}, Extensions: structuralschema.Extensions{XSensitive: true}},
+		"children": {Generic: structuralschema.Generic{Type: "array"}, Items: node},
+	}
+	plain := &structuralschema.Structural{Generic: structuralschema.Generic{Type: "object"}, Definition: "plain"}
+	plain.Properties = map[string]structuralschema.Structural{
+		"name":     {Generic: structuralschema.Generic{Type: "string"}},
+		"children": {Generic: structuralschema.Generic{Type: "array"}, Items: plain},
+	}
+	s := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"plain": *plain,
+			"tree":  {Generic: structuralschema.Generic{Type: "array"}, Items: node},
+		},
+	}
+	expected := Paths{"": true, "tree": true, "#/definitions/node": true, "#/definitions/node.secret": true, "#/definitions/node.children": true}
+	if got := NewPaths(s); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	obj := unmarshal(t, testObject)
+	expected := unmarshal(t, `{"spec":{"host":"example.com","token":"<redacted>","credentials":"<redacted>","servers":[{"name":"foo","password":"<redacted>"}]}}`)
+
+	if got := Redact(obj, testSchema, NewPaths(testSchema)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if original := unmarshal(t, testObject); !reflect.DeepEqual(obj, original) {
+		t.Errorf("expected input to be unchanged, got %v", obj)
+	}
+}
+
+func TestValues(t *testing.T) {
+	got := Values(unmarshal(t, testObject), testSchema)
+	sort.Strings(got)
+	if expected := []string{"a-key", "b-key", "hunter2", "s3cr3t"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := RedactString(`invalid value "s3cr3t"`, []string{"s3cr3t"}); got != `invalid value "<redacted>"` {
+		t.Errorf("unexpected redacted string %q", got)
+	}
+}
+
+func TestRedactErrors(t *testing.T) {
+	obj := unmarshal(t, testObject)
+	spec := obj["spec"]
+	servers := obj["spec"].(map[string]interface{})["servers"]
+
+	errs := field.ErrorList{
+		field.Invalid(field.NewPath("spec", "host"), "example.com", "host is invalid"),
+		field.Invalid(field.NewPath("spec", "token"), "s3cr3t", `spec.token in body must be of type uri: "s3cr3t"`),
+		field.Invalid(field.NewPath("spec", "servers").Index(0).Child("password"), "string", "must be longer"),
+		field.Invalid(field.NewPath("spec.servers.0.password"), "hunter2", "too short"),
+		field.Invalid(field.NewPath("spec", "credentials").Index(1), "b-key", "invalid key"),
+		field.Invalid(field.NewPath("spec"), spec, "invalid spec"),
+		field.Duplicate(field.NewPath("spec", "servers"), servers),
+		field.Required(field.NewPath("spec", "missing"), ""),
+		field.Invalid(nil, map[string]interface{}{"spec": map[string]interface{}{"token": "s3cr3t"}}, "failed rule"),
+	}
+
+	got := RedactErrors(errs, testSchema, NewPaths(testSchema), obj)
+	expected := []string{
+		`spec.host: Invalid value: "example.com": host is invalid`,
+		`spec.token: Invalid value: "<redacted>": spec.token in body must be of type uri: "<redacted>"`,
+		`spec.servers[0].password: Invalid value: "<redacted>": must be longer`,
+		`spec.servers.0.password: Invalid value: "<redacted>": too short`,
+		`spec.credentials[1]: Invalid value: "<redacted>": invalid key`,
+		`spec: Invalid value: map[string]interface {}{"credentials":"<redacted>", "host":"example.com", "servers":[]interface {}{map[string]interface {}{"name":"foo", "password":"<redacted>"}}, "token":"<redacted>"}: invalid spec`,
+		`spec.servers: Duplicate value: []interface {}{map[string]interface {}{"name":"foo", "password":"<redacted>"}}`,
+		`spec.missing: Required value`,
+		`<nil>: Invalid value: map[string]interface {}{"spec":map[string]interface {}{"token":"<redacted>"}}: failed rule`,
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if got[i].Error() != expected[i] {
+			t.Errorf("error %d: expected %q, got %q", i, expected[i], got[i].Error())
+		}
+	}
+
+	if original := unmarshal(t, testObject); !reflect.DeepEqual(obj, original) {
+		t.Errorf("expected object to be unchanged, got %v", obj)
+	}
+}
@@ -21,8 +21,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-// +k8s:deepcopy-gen=true
-
 // Structural represents a structural schema.
 type Structural struct {
 	Items      *Structural
@@ -32,6 +30,32 @@ type Structural struct {
 	Extensions
 
 	ValueValidation *ValueValidation
+
+	// Definition is the name of the local definition this schema has been
+	// resolved from via $ref, or empty. All schemas resolved from the same
+	// definition share their items, properties and additionalProperties. Hence,
+	// a structural schema with definitions can be cyclic and code walking the
+	// schema (as opposed to walking an object) must descend into the children
+	// of a definition only once.
+	Definition string
+}
+
+// definitionQueue collects the definitions referenced in a structural schema in order to
+// check each of them once, instead of at every place it is referenced from.
+type definitionQueue struct {
+	seen  map[string]bool
+	queue []*Structural
+}
+
+func (q *definitionQueue) add(s *Structural) {
+	if q.seen == nil {
+		q.seen = map[string]bool{}
+	}
+	if q.seen[s.Definition] {
+		return
+	}
+	q.seen[s.Definition] = true
+	q.queue = append(q.queue, s)
 }
 
 // +k8s:deepcopy-gen=true
@@ -131,6 +155,35 @@ type Extensions struct {
 
 	// x-kubernetes-validations describes a list of validation rules for expression validation.
 	XValidations apiextensions.ValidationRules
+
+	// x-kubernetes-value-type marks a string or int-or-string value as a quantity
+	// or a duration. The value is canonicalized on write and typed in validation rules.
+	XValueType *string
+
+	// x-kubernetes-normalize lists the normalizations applied to a string value on write.
+	XNormalize []string
+
+	// x-kubernetes-deprecated is the warning returned when the field is set to a non-default value.
+	XDeprecated *string
+
+	// x-kubernetes-sensitive marks the value as sensitive, to be redacted in errors and tables.
+	XSensitive bool
+
+	// x-kubernetes-write-permission names the subresource a requester must be authorized to
+	// update in order to change the value on update.
+	XWritePermission *string
+
+	// x-kubernetes-conditions marks a list of conditions whose lastTransitionTime, and
+	// optionally observedGeneration, are set by the server.
+	XConditions *string
+
+	// x-kubernetes-embedded-resource-kinds lists the kinds allowed for an embedded resource,
+	// whose schemas the embedded object is pruned, defaulted and validated with.
+	XEmbeddedResourceKinds []apiextensions.EmbeddedResourceKind
+
+	// x-kubernetes-reference marks a string field as the name of an object of the given
+	// group, resource and scope.
+	XReference *apiextensions.ReferenceTarget
 }
 
 // +k8s:deepcopy-gen=true
@@ -157,6 +210,15 @@ type ValueValidation struct {
 	OneOf            []NestedValueValidation
 	AnyOf            []NestedValueValidation
 	Not              *NestedValueValidation
+
+	// Const, If, Then, Else, DependentRequired and PropertyNames are not part of OpenAPI v3.0,
+	// but of later JSON Schema drafts. They are not published in OpenAPI v2.
+	Const             *JSON
+	If                *NestedValueValidation
+	Then              *NestedValueValidation
+	Else              *NestedValueValidation
+	DependentRequired map[string][]string
+	PropertyNames     *NestedValueValidation
 }
 
 // +k8s:deepcopy-gen=true
@@ -22,6 +22,7 @@ import (
 	"regexp"
 	"sort"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -34,6 +35,9 @@ var intOrStringAnyOf = []NestedValueValidation{
 	}},
 }
 
+// metadataProperties are the properties of metadata a structural schema may specify at the root.
+var metadataProperties = sets.NewString("name", "generateName", "labels", "annotations")
+
 type level int
 
 const (
@@ -60,12 +64,24 @@ const (
 // * every specified field or array in s is also specified outside of value validation.
 // * metadata at the root can only restrict the name and generateName, and not be specified at all in nested contexts.
 // * additionalProperties at the root is not allowed.
+//
+// Schemas resolved from a definition are checked once, under the path definitions[<name>].
 func ValidateStructural(fldPath *field.Path, s *Structural) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	allErrs = append(allErrs, validateStructuralInvariants(s, rootLevel, fldPath)...)
+	defs := &definitionQueue{}
+	allErrs = append(allErrs, validateStructuralInvariants(s, rootLevel, fldPath, defs)...)
 	allErrs = append(allErrs, validateStructuralCompleteness(s, fldPath)...)
 
+	// validating a definition can reference more definitions.
+	for i := 0; i < len(defs.queue); i++ {
+		def := *defs.queue[i]
+		def.Definition = ""
+		defPath := fldPath.Child("definitions").Key(defs.queue[i].Definition)
+		allErrs = append(allErrs, validateStructuralInvariants(&def, fieldLevel, defPath, defs)...)
+		allErrs = append(allErrs, validateStructuralCompleteness(&def, defPath)...)
+	}
+
 	// sort error messages. Otherwise, the errors slice will change every time due to
 	// maps in the types and randomized iteration.
 	sort.Slice(allErrs, func(i, j int) bool {
@@ -76,22 +92,26 @@ func ValidateStructural(fldPath *field.Path, s *Structural) field.ErrorList {
 }
 
 // validateStructuralInvariants checks the invariants of a structural schema.
-func validateStructuralInvariants(s *Structural, lvl level, fldPath *field.Path) field.ErrorList {
+func validateStructuralInvariants(s *Structural, lvl level, fldPath *field.Path, defs *definitionQueue) field.ErrorList {
 	if s == nil {
 		return nil
 	}
+	if len(s.Definition) > 0 {
+		defs.add(s)
+		return nil
+	}
 
 	allErrs := field.ErrorList{}
 
 	if s.Type == "array" && s.Items == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("items"), "must be specified"))
 	}
-	allErrs = append(allErrs, validateStructuralInvariants(s.Items, itemLevel, fldPath.Child("items"))...)
+	allErrs = append(allErrs, validateStructuralInvariants(s.Items, itemLevel, fldPath.Child("items"), defs)...)
 
 	for k, v := range s.Properties {
-		allErrs = append(allErrs, validateStructuralInvariants(&v, fieldLevel, fldPath.Child("properties").Key(k))...)
+		allErrs = append(allErrs, validateStructuralInvariants(&v, fieldLevel, fldPath.Child("properties").Key(k), defs)...)
 	}
-	allErrs = append(allErrs, validateGeneric(&s.Generic, lvl, fldPath)...)
+	allErrs = append(allErrs, validateGeneric(&s.Generic, lvl, fldPath, defs)...)
 	allErrs = append(allErrs, validateExtensions(&s.Extensions, fldPath)...)
 
 	// detect the two IntOrString exceptions:
@@ -134,7 +154,7 @@ func validateStructuralInvariants(s *Structural, lvl level, fldPath *field.Path)
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), s.Type, "must be object at the root"))
 	}
 
-	// restrict metadata schemas to name and generateName only
+	// restrict metadata schemas to name, generateName, labels and annotations only
 	if kind, found := s.Properties["kind"]; found && checkMetadata {
 		if kind.Type != "string" {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("properties").Key("kind").Child("type"), kind.Type, "must be string"))
@@ -165,14 +185,23 @@ func validateStructuralMetadataInvariants(s *Structural, checkMetadata bool, lvl
 	}
 
 	if lvl == rootLevel {
-		// metadata is a shallow copy. We can mutate it.
-		_, foundName := s.Properties["name"]
-		_, foundGenerateName := s.Properties["generateName"]
-		if foundName && foundGenerateName && len(s.Properties) == 2 {
-			s.Properties = nil
-		} else if (foundName || foundGenerateName) && len(s.Properties) == 1 {
-			s.Properties = nil
+		for _, k := range []string{"labels", "annotations"} {
+			if m, found := s.Properties[k]; found {
+				allErrs = append(allErrs, validateStructuralMetadataMap(&m, fldPath.Child("properties").Key(k))...)
+			}
+		}
+
+		// metadata is a shallow copy. We can mutate it, but not its properties map.
+		var props map[string]Structural
+		for k, v := range s.Properties {
+			if !metadataProperties.Has(k) {
+				if props == nil {
+					props = map[string]Structural{}
+				}
+				props[k] = v
+			}
 		}
+		s.Properties = props
 		s.Type = ""
 		s.Default.Object = nil // this is checked in API validation (and also tested)
 		if s.ValueValidation == nil {
@@ -180,10 +209,67 @@ func validateStructuralMetadataInvariants(s *Structural, checkMetadata bool, lvl
 		}
 		if !reflect.DeepEqual(*s, Structural{ValueValidation: &ValueValidation{}}) {
 			// TODO: this is actually a field.Invalid error, but we cannot do JSON serialization of metadata here to get a proper message
-			allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify anything other than name and generateName, but metadata is implicitly specified"))
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify anything other than name, generateName, labels and annotations, but metadata is implicitly specified"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateStructuralMetadataMap checks the schema of metadata.labels or metadata.annotations. It may
+// require keys, restrict the key names and validate string values, either per key via properties or
+// for all keys via additionalProperties.
+func validateStructuralMetadataMap(s *Structural, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s.Type != "object" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), s.Type, "must be object"))
+	}
+	if s.Default.Object != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("default"), "must not be specified"))
+	}
+	if s.Nullable {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nullable"), "must be false"))
+	}
+	if !reflect.DeepEqual(s.Extensions, Extensions{}) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify vendor extensions"))
+	}
+	if s.ValueValidation != nil {
+		// only required, minProperties, maxProperties and propertyNames are allowed.
+		vv := *s.ValueValidation
+		vv.Required, vv.MinProperties, vv.MaxProperties, vv.PropertyNames = nil, nil, nil, nil
+		if !reflect.DeepEqual(vv, ValueValidation{}) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify value validations other than required, minProperties, maxProperties and propertyNames"))
 		}
 	}
 
+	for k, v := range s.Properties {
+		allErrs = append(allErrs, validateStructuralMetadataValue(&v, fldPath.Child("properties").Key(k))...)
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+		allErrs = append(allErrs, validateStructuralMetadataValue(s.AdditionalProperties.Structural, fldPath.Child("additionalProperties"))...)
+	}
+
+	return allErrs
+}
+
+// validateStructuralMetadataValue checks the schema of a label or annotation value.
+func validateStructuralMetadataValue(s *Structural, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s.Type != "string" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), s.Type, "must be string"))
+	}
+	if s.Default.Object != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("default"), "must not be specified"))
+	}
+	if s.Nullable {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nullable"), "must be false"))
+	}
+	if !reflect.DeepEqual(s.Extensions, Extensions{}) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify vendor extensions"))
+	}
+
 	return allErrs
 }
 
@@ -202,7 +288,7 @@ func isIntOrStringAllOfPattern(s *Structural) bool {
 }
 
 // validateGeneric checks the generic fields of a structural schema.
-func validateGeneric(g *Generic, lvl level, fldPath *field.Path) field.ErrorList {
+func validateGeneric(g *Generic, lvl level, fldPath *field.Path, defs *definitionQueue) field.ErrorList {
 	if g == nil {
 		return nil
 	}
@@ -214,7 +300,7 @@ func validateGeneric(g *Generic, lvl level, fldPath *field.Path) field.ErrorList
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalProperties"), "must not be used at the root"))
 		}
 		if g.AdditionalProperties.Structural != nil {
-			allErrs = append(allErrs, validateStructuralInvariants(g.AdditionalProperties.Structural, fieldLevel, fldPath.Child("additionalProperties"))...)
+			allErrs = append(allErrs, validateStructuralInvariants(g.AdditionalProperties.Structural, fieldLevel, fldPath.Child("additionalProperties"), defs)...)
 		}
 	}
 
@@ -248,6 +334,9 @@ func validateValueValidation(v *ValueValidation, skipAnyOf, skipFirstAllOfAnyOf
 			allErrs = append(allErrs, validateNestedValueValidation(&v.AnyOf[i], false, false, lvl, fldPath.Child("anyOf").Index(i))...)
 		}
 	}
+	for i := range v.AnyOf {
+		allErrs = append(allErrs, validateNoConditionalsUnderJunctor(&v.AnyOf[i], fldPath.Child("anyOf").Index(i))...)
+	}
 
 	for i := range v.AllOf {
 		skipAnyOf := false
@@ -259,9 +348,45 @@ func validateValueValidation(v *ValueValidation, skipAnyOf, skipFirstAllOfAnyOf
 
 	for i := range v.OneOf {
 		allErrs = append(allErrs, validateNestedValueValidation(&v.OneOf[i], false, false, lvl, fldPath.Child("oneOf").Index(i))...)
+		allErrs = append(allErrs, validateNoConditionalsUnderJunctor(&v.OneOf[i], fldPath.Child("oneOf").Index(i))...)
 	}
 
 	allErrs = append(allErrs, validateNestedValueValidation(v.Not, false, false, lvl, fldPath.Child("not"))...)
+	allErrs = append(allErrs, validateNoConditionalsUnderJunctor(v.Not, fldPath.Child("not"))...)
+
+	allErrs = append(allErrs, validateNestedValueValidation(v.If, false, false, lvl, fldPath.Child("if"))...)
+	allErrs = append(allErrs, validateNestedValueValidation(v.Then, false, false, lvl, fldPath.Child("then"))...)
+	allErrs = append(allErrs, validateNestedValueValidation(v.Else, false, false, lvl, fldPath.Child("else"))...)
+	if v.If == nil {
+		if v.Then != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("then"), "must not be specified without if"))
+		}
+		if v.Else != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("else"), "must not be specified without if"))
+		}
+	}
+
+	if v.PropertyNames != nil {
+		allErrs = append(allErrs, validateNestedValueValidation(v.PropertyNames, false, false, lvl, fldPath.Child("propertyNames"))...)
+		if v.PropertyNames.Items != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("propertyNames", "items"), "must be undefined because property names are strings"))
+		}
+		if len(v.PropertyNames.Properties) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("propertyNames", "properties"), "must be empty because property names are strings"))
+		}
+	}
+
+	for k, deps := range v.DependentRequired {
+		seen := sets.NewString()
+		for i, dep := range deps {
+			if len(dep) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath.Child("dependentRequired").Key(k).Index(i), ""))
+			} else if seen.Has(dep) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("dependentRequired").Key(k).Index(i), dep))
+			}
+			seen.Insert(dep)
+		}
+	}
 
 	if len(v.Pattern) > 0 {
 		if _, err := regexp.Compile(v.Pattern); err != nil {
@@ -272,6 +397,46 @@ func validateValueValidation(v *ValueValidation, skipAnyOf, skipFirstAllOfAnyOf
 	return allErrs
 }
 
+// validateNoConditionalsUnderJunctor checks that const, if, then, else, dependentRequired and propertyNames are not
+// used in v nested under anyOf, oneOf or not. These are enforced separately from the other value validations, which
+// select the branches of these junctors. Deeper junctors are checked when validating v itself.
+func validateNoConditionalsUnderJunctor(v *NestedValueValidation, fldPath *field.Path) field.ErrorList {
+	if v == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if v.Const != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("const"), "must not be used inside anyOf, oneOf or not"))
+	}
+	if v.If != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("if"), "must not be used inside anyOf, oneOf or not"))
+	}
+	if v.Then != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("then"), "must not be used inside anyOf, oneOf or not"))
+	}
+	if v.Else != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("else"), "must not be used inside anyOf, oneOf or not"))
+	}
+	if len(v.DependentRequired) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dependentRequired"), "must not be used inside anyOf, oneOf or not"))
+	}
+	if v.PropertyNames != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("propertyNames"), "must not be used inside anyOf, oneOf or not"))
+	}
+
+	for i := range v.AllOf {
+		allErrs = append(allErrs, validateNoConditionalsUnderJunctor(&v.AllOf[i], fldPath.Child("allOf").Index(i))...)
+	}
+	allErrs = append(allErrs, validateNoConditionalsUnderJunctor(v.Items, fldPath.Child("items"))...)
+	for k, fld := range v.Properties {
+		allErrs = append(allErrs, validateNoConditionalsUnderJunctor(&fld, fldPath.Child("properties").Key(k))...)
+	}
+
+	return allErrs
+}
+
 // validateNestedValueValidation checks the nested value validation under a logic junctor in a structural schema.
 func validateNestedValueValidation(v *NestedValueValidation, skipAnyOf, skipAllOfAnyOf bool, lvl level, fldPath *field.Path) field.ErrorList {
 	if v == nil {
@@ -327,6 +492,30 @@ func validateNestedValueValidation(v *NestedValueValidation, skipAnyOf, skipAllO
 	if len(v.ForbiddenExtensions.XValidations) > 0 {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-validations"), "must be empty to be structural"))
 	}
+	if v.ForbiddenExtensions.XValueType != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-value-type"), "must be undefined to be structural"))
+	}
+	if len(v.ForbiddenExtensions.XNormalize) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-normalize"), "must be empty to be structural"))
+	}
+	if v.ForbiddenExtensions.XDeprecated != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-deprecated"), "must be undefined to be structural"))
+	}
+	if v.ForbiddenExtensions.XSensitive {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-sensitive"), "must be false to be structural"))
+	}
+	if v.ForbiddenExtensions.XWritePermission != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-write-permission"), "must be undefined to be structural"))
+	}
+	if v.ForbiddenExtensions.XConditions != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-conditions"), "must be undefined to be structural"))
+	}
+	if len(v.ForbiddenExtensions.XEmbeddedResourceKinds) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-embedded-resource-kinds"), "must be empty to be structural"))
+	}
+	if v.ForbiddenExtensions.XReference != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("x-kubernetes-reference"), "must be undefined to be structural"))
+	}
 
 	// forbid reasoning about metadata because it can lead to metadata restriction we don't want
 	if _, found := v.Properties["metadata"]; found {
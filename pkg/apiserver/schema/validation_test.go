@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	fuzz "github.com/google/gofuzz"
 )
@@ -146,6 +147,109 @@ func TestValidateStructuralMetadataInvariants(t *testing.T) {
 	}
 }
 
+func TestValidateStructuralMetadataLabelsAndAnnotations(t *testing.T) {
+	stringType := Structural{Generic: Generic{Type: "string"}}
+	tests := []struct {
+		name     string
+		metadata Structural
+		wantErr  []string
+	}{
+		{
+			name: "valid",
+			metadata: Structural{
+				Generic: Generic{Type: "object"},
+				Properties: map[string]Structural{
+					"name": stringType,
+					"labels": {
+						Generic:    Generic{Type: "object"},
+						Properties: map[string]Structural{"team": {Generic: Generic{Type: "string"}, ValueValidation: &ValueValidation{Pattern: "^[a-z]+$"}}},
+						ValueValidation: &ValueValidation{
+							Required:      []string{"team"},
+							MaxProperties: int64Ptr(10),
+							PropertyNames: &NestedValueValidation{ValueValidation: ValueValidation{Pattern: "^example.com/"}},
+						},
+					},
+					"annotations": {
+						Generic: Generic{
+							Type:                 "object",
+							AdditionalProperties: &StructuralOrBool{Bool: true, Structural: &Structural{Generic: Generic{Type: "string"}, ValueValidation: &ValueValidation{Format: "uri"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "other metadata field",
+			metadata: Structural{
+				Generic:    Generic{Type: "object"},
+				Properties: map[string]Structural{"labels": {Generic: Generic{Type: "object"}}, "namespace": stringType},
+			},
+			wantErr: []string{"metadata: Forbidden: must not specify anything other than name, generateName, labels and annotations, but metadata is implicitly specified"},
+		},
+		{
+			name: "labels not an object",
+			metadata: Structural{
+				Generic:    Generic{Type: "object"},
+				Properties: map[string]Structural{"labels": stringType},
+			},
+			wantErr: []string{`metadata.properties[labels].type: Invalid value: "string": must be object`},
+		},
+		{
+			name: "invalid value validation on labels",
+			metadata: Structural{
+				Generic: Generic{Type: "object"},
+				Properties: map[string]Structural{"labels": {
+					Generic:         Generic{Type: "object"},
+					ValueValidation: &ValueValidation{MinProperties: int64Ptr(1), Enum: []JSON{{Object: map[string]interface{}{}}}},
+				}},
+			},
+			wantErr: []string{"metadata.properties[labels]: Forbidden: must not specify value validations other than required, minProperties, maxProperties and propertyNames"},
+		},
+		{
+			name: "non-string annotation value",
+			metadata: Structural{
+				Generic: Generic{Type: "object"},
+				Properties: map[string]Structural{"annotations": {
+					Generic:    Generic{Type: "object"},
+					Properties: map[string]Structural{"count": {Generic: Generic{Type: "integer"}}},
+				}},
+			},
+			wantErr: []string{`metadata.properties[annotations].properties[count].type: Invalid value: "integer": must be string`},
+		},
+		{
+			name: "default and extensions on label value",
+			metadata: Structural{
+				Generic: Generic{Type: "object"},
+				Properties: map[string]Structural{"labels": {
+					Generic: Generic{
+						Type: "object",
+						AdditionalProperties: &StructuralOrBool{Bool: true, Structural: &Structural{
+							Generic:    Generic{Type: "string", Default: JSON{Object: "foo"}},
+							Extensions: Extensions{XNormalize: []string{"lowercase"}},
+						}},
+					},
+				}},
+			},
+			wantErr: []string{
+				"metadata.properties[labels].additionalProperties.default: Forbidden: must not be specified",
+				"metadata.properties[labels].additionalProperties: Forbidden: must not specify vendor extensions",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateStructuralMetadataInvariants(tt.metadata.DeepCopy(), true, rootLevel, field.NewPath("metadata"))
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("expected errors %q, got %q", tt.wantErr, got)
+			}
+		})
+	}
+}
+
 func TestValidateNestedValueValidationComplete(t *testing.T) {
 	fuzzer := fuzz.New()
 	fuzzer.Funcs(
@@ -188,3 +292,75 @@ func TestValidateNestedValueValidationComplete(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateValueValidationConditionals(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       ValueValidation
+		wantErr []string
+	}{
+		{
+			name: "valid",
+			v: ValueValidation{
+				Const:             &JSON{Object: "foo"},
+				If:                &NestedValueValidation{ValueValidation: ValueValidation{Required: []string{"a"}}},
+				Then:              &NestedValueValidation{ValueValidation: ValueValidation{Required: []string{"b"}}},
+				Else:              &NestedValueValidation{ValueValidation: ValueValidation{Required: []string{"c"}}},
+				DependentRequired: map[string][]string{"a": {"b", "c"}},
+				PropertyNames:     &NestedValueValidation{ValueValidation: ValueValidation{Pattern: "^[a-z]+$"}},
+			},
+		},
+		{
+			name: "then and else without if",
+			v: ValueValidation{
+				Then: &NestedValueValidation{},
+				Else: &NestedValueValidation{},
+			},
+			wantErr: []string{"then: Forbidden: must not be specified without if", "else: Forbidden: must not be specified without if"},
+		},
+		{
+			name: "propertyNames with type and properties",
+			v: ValueValidation{
+				PropertyNames: &NestedValueValidation{
+					Properties:        map[string]NestedValueValidation{"a": {}},
+					ForbiddenGenerics: Generic{Type: "string"},
+				},
+			},
+			wantErr: []string{"propertyNames.type: Forbidden: must be empty to be structural", "propertyNames.properties: Forbidden: must be empty because property names are strings"},
+		},
+		{
+			name: "invalid dependentRequired",
+			v: ValueValidation{
+				DependentRequired: map[string][]string{"a": {"b", "", "b"}},
+			},
+			wantErr: []string{"dependentRequired[a][1]: Required value", `dependentRequired[a][2]: Duplicate value: "b"`},
+		},
+		{
+			name: "conditionals under junctors",
+			v: ValueValidation{
+				AnyOf: []NestedValueValidation{{ValueValidation: ValueValidation{Const: &JSON{Object: "foo"}}}},
+				OneOf: []NestedValueValidation{{Items: &NestedValueValidation{ValueValidation: ValueValidation{If: &NestedValueValidation{}}}}},
+				Not: &NestedValueValidation{ValueValidation: ValueValidation{AllOf: []NestedValueValidation{{
+					ValueValidation: ValueValidation{PropertyNames: &NestedValueValidation{}},
+				}}}},
+			},
+			wantErr: []string{
+				"anyOf[0].const: Forbidden: must not be used inside anyOf, oneOf or not",
+				"oneOf[0].items.if: Forbidden: must not be used inside anyOf, oneOf or not",
+				"not.allOf[0].propertyNames: Forbidden: must not be used inside anyOf, oneOf or not",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateValueValidation(&tt.v, false, false, fieldLevel, nil)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("expected errors %q, got %q", tt.wantErr, got)
+			}
+		})
+	}
+}
@@ -30,76 +30,125 @@ type Visitor struct {
 }
 
 // Visit recursively walks through the structural schema and calls the given callbacks
-// at each node of those types.
+// at each node of those types. The callbacks are called for every schema resolved from
+// a definition, but the children of a definition are only walked once.
 func (m *Visitor) Visit(s *Structural) {
-	m.visitStructural(s)
+	m.visitStructural(s, map[string]bool{})
 }
 
-func (m *Visitor) visitStructural(s *Structural) bool {
+// HasExtension returns true if pred returns true for the extensions of s or of any of its
+// subschemas, including those resolved from definitions.
+func HasExtension(s *Structural, pred func(*Extensions) bool) bool {
+	if s == nil {
+		return false
+	}
+	found := false
+	v := Visitor{
+		Structural: func(s *Structural) bool {
+			found = found || pred(&s.Extensions)
+			return false
+		},
+	}
+	v.Visit(s)
+	return found
+}
+
+func (m *Visitor) visitStructural(s *Structural, visited map[string]bool) bool {
 	ret := false
 	if m.Structural != nil {
 		ret = m.Structural(s)
 	}
 
+	if len(s.Definition) > 0 {
+		if visited[s.Definition] {
+			return ret
+		}
+		visited[s.Definition] = true
+	}
+
 	if s.Items != nil {
-		m.visitStructural(s.Items)
+		m.visitStructural(s.Items, visited)
 	}
 	for k, v := range s.Properties {
-		if changed := m.visitStructural(&v); changed {
+		if changed := m.visitStructural(&v, visited); changed {
 			ret = true
 			s.Properties[k] = v
 		}
 	}
 	if s.Generic.AdditionalProperties != nil && s.Generic.AdditionalProperties.Structural != nil {
-		m.visitStructural(s.Generic.AdditionalProperties.Structural)
+		m.visitStructural(s.Generic.AdditionalProperties.Structural, visited)
 	}
 	if s.ValueValidation != nil {
 		for i := range s.ValueValidation.AllOf {
-			m.visitNestedValueValidation(&s.ValueValidation.AllOf[i])
+			m.visitNestedValueValidation(&s.ValueValidation.AllOf[i], visited)
 		}
 		for i := range s.ValueValidation.AnyOf {
-			m.visitNestedValueValidation(&s.ValueValidation.AnyOf[i])
+			m.visitNestedValueValidation(&s.ValueValidation.AnyOf[i], visited)
 		}
 		for i := range s.ValueValidation.OneOf {
-			m.visitNestedValueValidation(&s.ValueValidation.OneOf[i])
+			m.visitNestedValueValidation(&s.ValueValidation.OneOf[i], visited)
 		}
 		if s.ValueValidation.Not != nil {
-			m.visitNestedValueValidation(s.ValueValidation.Not)
+			m.visitNestedValueValidation(s.ValueValidation.Not, visited)
+		}
+		if s.ValueValidation.If != nil {
+			m.visitNestedValueValidation(s.ValueValidation.If, visited)
+		}
+		if s.ValueValidation.Then != nil {
+			m.visitNestedValueValidation(s.ValueValidation.Then, visited)
+		}
+		if s.ValueValidation.Else != nil {
+			m.visitNestedValueValidation(s.ValueValidation.Else, visited)
+		}
+		if s.ValueValidation.PropertyNames != nil {
+			m.visitNestedValueValidation(s.ValueValidation.PropertyNames, visited)
 		}
 	}
 
 	return ret
 }
 
-func (m *Visitor) visitNestedValueValidation(vv *NestedValueValidation) bool {
+func (m *Visitor) visitNestedValueValidation(vv *NestedValueValidation, visited map[string]bool) bool {
 	ret := false
 	if m.NestedValueValidation != nil {
 		ret = m.NestedValueValidation(vv)
 	}
 
 	if vv.Items != nil {
-		m.visitNestedValueValidation(vv.Items)
+		m.visitNestedValueValidation(vv.Items, visited)
 	}
 	for k, v := range vv.Properties {
-		if changed := m.visitNestedValueValidation(&v); changed {
+		if changed := m.visitNestedValueValidation(&v, visited); changed {
 			ret = true
 			vv.Properties[k] = v
 		}
 	}
 	if vv.ForbiddenGenerics.AdditionalProperties != nil && vv.ForbiddenGenerics.AdditionalProperties.Structural != nil {
-		m.visitStructural(vv.ForbiddenGenerics.AdditionalProperties.Structural)
+		m.visitStructural(vv.ForbiddenGenerics.AdditionalProperties.Structural, visited)
 	}
 	for i := range vv.ValueValidation.AllOf {
-		m.visitNestedValueValidation(&vv.ValueValidation.AllOf[i])
+		m.visitNestedValueValidation(&vv.ValueValidation.AllOf[i], visited)
 	}
 	for i := range vv.ValueValidation.AnyOf {
-		m.visitNestedValueValidation(&vv.ValueValidation.AnyOf[i])
+		m.visitNestedValueValidation(&vv.ValueValidation.AnyOf[i], visited)
 	}
 	for i := range vv.ValueValidation.OneOf {
-		m.visitNestedValueValidation(&vv.ValueValidation.OneOf[i])
+		m.visitNestedValueValidation(&vv.ValueValidation.OneOf[i], visited)
 	}
 	if vv.ValueValidation.Not != nil {
-		m.visitNestedValueValidation(vv.ValueValidation.Not)
+		m.visitNestedValueValidation(vv.ValueValidation.Not, visited)
+	}
+	if vv.ValueValidation.If != nil {
+		m.visitNestedValueValidation(vv.ValueValidation.If, visited)
+	}
+	if vv.ValueValidation.Then != nil {
+		m.visitNestedValueValidation(vv.ValueValidation.Then, visited)
+	}
+	if vv.ValueValidation.Else != nil {
+		m.visitNestedValueValidation(vv.ValueValidation.Else, visited)
+	}
+	if vv.ValueValidation.PropertyNames != nil {
+		m.visitNestedValueValidation(vv.ValueValidation.PropertyNames, visited)
 	}
 
 	return ret
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+)
+
+func TestHasExtension(t *testing.T) {
+	// a recursive definition with a sensitive field behind an array
+	node := &Structural{Generic: Generic{Type: "object"}, Definition: "node"}
+	node.Properties = map[string]Structural{
+		"name":     {Generic: Generic{Type: "string"}},
+		"children": {Generic: Generic{Type: "array"}, Items: node},
+		"secrets": {
+			Generic: Generic{
+				Type:                 "object",
+				AdditionalProperties: &StructuralOrBool{Structural: &Structural{Generic: Generic{Type: "string"}, Extensions: Extensions{XSensitive: true}}},
+			},
+		},
+	}
+	s := &Structural{
+		Generic:    Generic{Type: "object"},
+		Properties: map[string]Structural{"tree": *node},
+	}
+
+	sensitive := func(e *Extensions) bool { return e.XSensitive }
+	deprecated := func(e *Extensions) bool { return e.XDeprecated != nil }
+	if !HasExtension(s, sensitive) {
+		t.Errorf("expected the sensitive field to be found")
+	}
+	if HasExtension(s, deprecated) {
+		t.Errorf("expected no deprecated field to be found")
+	}
+	if HasExtension(nil, sensitive) {
+		t.Errorf("expected no sensitive field to be found in a nil schema")
+	}
+}
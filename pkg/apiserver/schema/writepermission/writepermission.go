@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writepermission
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+// Change is the change of the value of a field marked with x-kubernetes-write-permission.
+type Change struct {
+	// Path is the path of the changed field, e.g. spec.tier.
+	Path *field.Path
+	// Subresource is the subresource the requester must be authorized to update, e.g. fields/tier.
+	Subresource string
+}
+
+// Changes returns the fields marked with x-kubernetes-write-permission in s whose values differ
+// between oldObj and newObj, sorted by path. Array items are compared by index. Setting or
+// removing a field counts as a change.
+func Changes(s *structuralschema.Structural, oldObj, newObj map[string]interface{}) []Change {
+	if s == nil {
+		return nil
+	}
+	changes := changesInObject(nil, s, oldObj, newObj)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path.String() < changes[j].Path.String()
+	})
+	return changes
+}
+
+func changes(fldPath *field.Path, s *structuralschema.Structural, oldValue, newValue interface{}) []Change {
+	if s == nil {
+		return nil
+	}
+
+	var ret []Change
+	if s.XWritePermission != nil && !equality.Semantic.DeepEqual(oldValue, newValue) {
+		ret = append(ret, Change{Path: fldPath, Subresource: *s.XWritePermission})
+	}
+
+	switch newValue := newValue.(type) {
+	case map[string]interface{}:
+		oldMap, _ := oldValue.(map[string]interface{})
+		ret = append(ret, changesInObject(fldPath, s, oldMap, newValue)...)
+	case []interface{}:
+		oldList, _ := oldValue.([]interface{})
+		for i := 0; i < len(newValue) || i < len(oldList); i++ {
+			ret = append(ret, changes(fldPath.Index(i), s.Items, index(oldList, i), index(newValue, i))...)
+		}
+	default:
+		// the new value is a scalar or missing. Nested protected fields of the old value are removed.
+		switch oldValue := oldValue.(type) {
+		case map[string]interface{}:
+			ret = append(ret, changesInObject(fldPath, s, oldValue, nil)...)
+		case []interface{}:
+			for i := range oldValue {
+				ret = append(ret, changes(fldPath.Index(i), s.Items, oldValue[i], nil)...)
+			}
+		}
+	}
+	return ret
+}
+
+func changesInObject(fldPath *field.Path, s *structuralschema.Structural, oldObj, newObj map[string]interface{}) []Change {
+	var ret []Change
+	visit := func(k string) {
+		if prop, ok := s.Properties[k]; ok {
+			ret = append(ret, changes(fldPath.Child(k), &prop, oldObj[k], newObj[k])...)
+		} else if s.AdditionalProperties != nil {
+			ret = append(ret, changes(fldPath.Key(k), s.AdditionalProperties.Structural, oldObj[k], newObj[k])...)
+		}
+	}
+	for k := range newObj {
+		visit(k)
+	}
+	for k := range oldObj {
+		if _, ok := newObj[k]; !ok {
+			visit(k)
+		}
+	}
+	return ret
+}
+
+func index(l []interface{}, i int) interface{} {
+	if i < len(l) {
+		return l[i]
+	}
+	return nil
+}
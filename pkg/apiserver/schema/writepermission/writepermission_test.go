@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package writepermission
+
+import (
+	"reflect"
+	"testing"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+func TestChanges(t *testing.T) {
+	tier := "fields/tier"
+	quota := "fields/quota"
+	limits := "fields/limits"
+	schema := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"spec": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"replicas": {Generic: structuralschema.Generic{Type: "integer"}},
+					"tier": {
+						Generic:    structuralschema.Generic{Type: "string"},
+						Extensions: structuralschema.Extensions{XWritePermission: &tier},
+					},
+					"quota": {
+						Generic:    structuralschema.Generic{Type: "object"},
+						Extensions: structuralschema.Extensions{XWritePermission: &quota},
+						Properties: map[string]structuralschema.Structural{
+							"cpu": {Generic: structuralschema.Generic{Type: "string"}},
+							"limits": {
+								Generic:    structuralschema.Generic{Type: "array"},
+								Extensions: structuralschema.Extensions{XWritePermission: &limits},
+								Items:      &structuralschema.Structural{Generic: structuralschema.Generic{Type: "string"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+		expected []string
+	}{
+		{"unchanged", `{"spec":{"tier":"gold","quota":{"cpu":"1"}}}`, `{"spec":{"tier":"gold","quota":{"cpu":"1"}}}`, nil},
+		{"unprotected change", `{"spec":{"tier":"gold","replicas":1}}`, `{"spec":{"tier":"gold","replicas":2}}`, nil},
+		{"changed", `{"spec":{"tier":"gold"}}`, `{"spec":{"tier":"silver"}}`, []string{"spec.tier=fields/tier"}},
+		{"set", `{"spec":{}}`, `{"spec":{"tier":"gold"}}`, []string{"spec.tier=fields/tier"}},
+		{"removed", `{"spec":{"tier":"gold"}}`, `{"spec":{}}`, []string{"spec.tier=fields/tier"}},
+		{"parent removed", `{"spec":{"tier":"gold"}}`, `{}`, []string{"spec.tier=fields/tier"}},
+		{"nested change", `{"spec":{"quota":{"cpu":"1"}}}`, `{"spec":{"quota":{"cpu":"2"}}}`, []string{"spec.quota=fields/quota"}},
+		{"nested permission", `{"spec":{"quota":{"limits":["a"]}}}`, `{"spec":{"quota":{"limits":["a","b"]}}}`, []string{
+			"spec.quota=fields/quota",
+			"spec.quota.limits=fields/limits",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oldObj, newObj map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.old), &oldObj); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.new), &newObj); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, c := range Changes(schema, oldObj, newObj) {
+				got = append(got, c.Path.String()+"="+c.Subresource)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -48,6 +48,41 @@ func (in *Extensions) DeepCopyInto(out *Extensions) {
 		*out = make(apiextensions.ValidationRules, len(*in))
 		copy(*out, *in)
 	}
+	if in.XValueType != nil {
+		in, out := &in.XValueType, &out.XValueType
+		*out = new(string)
+		**out = **in
+	}
+	if in.XNormalize != nil {
+		in, out := &in.XNormalize, &out.XNormalize
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.XDeprecated != nil {
+		in, out := &in.XDeprecated, &out.XDeprecated
+		*out = new(string)
+		**out = **in
+	}
+	if in.XWritePermission != nil {
+		in, out := &in.XWritePermission, &out.XWritePermission
+		*out = new(string)
+		**out = **in
+	}
+	if in.XConditions != nil {
+		in, out := &in.XConditions, &out.XConditions
+		*out = new(string)
+		**out = **in
+	}
+	if in.XEmbeddedResourceKinds != nil {
+		in, out := &in.XEmbeddedResourceKinds, &out.XEmbeddedResourceKinds
+		*out = make([]apiextensions.EmbeddedResourceKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.XReference != nil {
+		in, out := &in.XReference, &out.XReference
+		*out = new(apiextensions.ReferenceTarget)
+		**out = **in
+	}
 	return
 }
 
@@ -114,48 +149,12 @@ func (in *NestedValueValidation) DeepCopy() *NestedValueValidation {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Structural) DeepCopyInto(out *Structural) {
-	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = new(Structural)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Properties != nil {
-		in, out := &in.Properties, &out.Properties
-		*out = make(map[string]Structural, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	in.Generic.DeepCopyInto(&out.Generic)
-	in.Extensions.DeepCopyInto(&out.Extensions)
-	if in.ValueValidation != nil {
-		in, out := &in.ValueValidation, &out.ValueValidation
-		*out = new(ValueValidation)
-		(*in).DeepCopyInto(*out)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Structural.
-func (in *Structural) DeepCopy() *Structural {
-	if in == nil {
-		return nil
-	}
-	out := new(Structural)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StructuralOrBool) DeepCopyInto(out *StructuralOrBool) {
 	*out = *in
 	if in.Structural != nil {
 		in, out := &in.Structural, &out.Structural
-		*out = new(Structural)
-		(*in).DeepCopyInto(*out)
+		*out = (*in).DeepCopy()
 	}
 	return
 }
@@ -256,6 +255,46 @@ func (in *ValueValidation) DeepCopyInto(out *ValueValidation) {
 		*out = new(NestedValueValidation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Const != nil {
+		in, out := &in.Const, &out.Const
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(NestedValueValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Then != nil {
+		in, out := &in.Then, &out.Then
+		*out = new(NestedValueValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		*out = new(NestedValueValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependentRequired != nil {
+		in, out := &in.DependentRequired, &out.DependentRequired
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PropertyNames != nil {
+		in, out := &in.PropertyNames, &out.PropertyNames
+		*out = new(NestedValueValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
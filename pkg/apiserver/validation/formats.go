@@ -17,10 +17,15 @@ limitations under the License.
 package validation
 
 import (
-	"strings"
+	"fmt"
+	"regexp"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
 )
 
 var supportedFormats = sets.NewString(
@@ -50,16 +55,143 @@ var supportedFormats = sets.NewString(
 	"datetime",     // a date time string like "2014-12-15T19:30:20.000Z" as defined by date-time in RFC3339
 )
 
-// StripUnsupportedFormatsPostProcess sets unsupported formats to empty string.
-func StripUnsupportedFormatsPostProcess(s *spec.Schema) error {
-	if len(s.Format) == 0 {
-		return nil
+// semverRegexp matches a semantic version as defined by https://semver.org/spec/v2.0.0.html, without a leading "v".
+var semverRegexp = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// kubernetesFormats are the Kubernetes specific formats supported in addition to supportedFormats.
+var kubernetesFormats = []Format{
+	// a DNS label as defined by RFC 1123
+	{Name: "rfc1123-label", Validate: func(s string) bool { return len(utilvalidation.IsDNS1123Label(s)) == 0 }},
+	// a DNS subdomain as defined by RFC 1123
+	{Name: "rfc1123-subdomain", Validate: func(s string) bool { return len(utilvalidation.IsDNS1123Subdomain(s)) == 0 }},
+	// a DNS label as defined by RFC 1035
+	{Name: "rfc1035-label", Validate: func(s string) bool { return len(utilvalidation.IsDNS1035Label(s)) == 0 }},
+	// an optionally prefixed name like label keys, e.g. "example.com/my-name"
+	{Name: "k8s-qualified-name", Validate: func(s string) bool { return len(utilvalidation.IsQualifiedName(s)) == 0 }},
+	// a label value
+	{Name: "k8s-label-value", Validate: func(s string) bool { return len(utilvalidation.IsValidLabelValue(s)) == 0 }},
+	// a resource quantity like "100m" or "1Gi"
+	{Name: "k8s-quantity", Validate: func(s string) bool {
+		_, err := resource.ParseQuantity(s)
+		return err == nil
+	}},
+	// a semantic version like "1.2.3-beta.1"
+	{Name: "semver", Validate: semverRegexp.MatchString},
+}
+
+// valueTypeFormats maps the values of x-kubernetes-value-type to the format validating their string representation.
+var valueTypeFormats = map[string]string{
+	"quantity": "k8s-quantity",
+	"duration": "duration",
+}
+
+// Format is a string format that can be registered with a FormatRegistry.
+type Format struct {
+	// Name is the name of the format as used in the schema. Dashes in the name are ignored,
+	// i.e. "k8s-quantity" and "k8squantity" denote the same format.
+	Name string
+	// Validate returns true if the given string is of this format.
+	Validate func(string) bool
+}
+
+// FormatRegistry holds the string formats supported in CustomResourceDefinition schemas. Other
+// formats are stripped from the schemas by StripUnsupportedFormatsPostProcess and hence are not
+// validated.
+//
+// The formats beyond the OpenAPI formats, i.e. the Kubernetes specific and registered formats, were
+// stripped before they were supported, so custom resources may have been stored with values not of
+// these formats. ValidateCustomResourceUpdate only enforces them on changed values.
+type FormatRegistry struct {
+	lock     sync.RWMutex
+	names    sets.String
+	registry strfmt.Registry
+}
+
+// DefaultFormatRegistry holds the OpenAPI and Kubernetes specific formats. It is used where no
+// registry of a server is at hand, e.g. to validate the defaults of CustomResourceDefinitions. It must
+// not be extended: embedding servers register further formats through the apiserver's ExtraConfig,
+// which go to a registry of their own.
+var DefaultFormatRegistry = NewFormatRegistry()
+
+// NewFormatRegistry returns a registry with the supported OpenAPI formats and the Kubernetes specific formats.
+func NewFormatRegistry() *FormatRegistry {
+	r := &FormatRegistry{
+		names:    sets.NewString(supportedFormats.List()...),
+		registry: strfmt.NewFormats(),
+	}
+	for _, f := range kubernetesFormats {
+		if err := r.Register(f); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register adds the given format. Formats cannot be redefined, i.e. the name must be neither
+// registered already nor the name of an OpenAPI format, whether supported or not.
+func (r *FormatRegistry) Register(f Format) error {
+	name := strfmt.DefaultNameNormalizer(f.Name)
+	if len(name) == 0 {
+		return fmt.Errorf("format name must not be empty")
+	}
+	if f.Validate == nil {
+		return fmt.Errorf("format %q must have a validation function", f.Name)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.names.Has(name) || r.registry.ContainsName(name) {
+		return fmt.Errorf("format %q is already defined", f.Name)
 	}
+	r.names.Insert(name)
+	r.registry.Add(name, new(customFormat), f.Validate)
+	return nil
+}
 
-	normalized := strings.Replace(s.Format, "-", "", -1) // go-openapi default format name normalization
-	if !supportedFormats.Has(normalized) {
+// Supports returns true if the given format is registered.
+func (r *FormatRegistry) Supports(name string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.names.Has(strfmt.DefaultNameNormalizer(name))
+}
+
+// Validates returns true if the given format is registered and value is of that format.
+func (r *FormatRegistry) Validates(name, value string) bool {
+	return r.Supports(name) && r.registry.Validates(name, value)
+}
+
+// Registry returns the registry to be passed to the OpenAPI schema validator.
+func (r *FormatRegistry) Registry() strfmt.Registry {
+	return r.registry
+}
+
+// StripUnsupportedFormatsPostProcess sets formats unsupported by the registry to empty string.
+func (r *FormatRegistry) StripUnsupportedFormatsPostProcess(s *spec.Schema) error {
+	if len(s.Format) > 0 && !r.Supports(s.Format) {
 		s.Format = ""
 	}
+	return nil
+}
+
+// StripUnsupportedFormatsPostProcess sets formats unsupported by the DefaultFormatRegistry to empty string.
+func StripUnsupportedFormatsPostProcess(s *spec.Schema) error {
+	return DefaultFormatRegistry.StripUnsupportedFormatsPostProcess(s)
+}
+
+// customFormat is the placeholder type registered for formats without a Go representation.
+type customFormat string
+
+func (f customFormat) String() string {
+	return string(f)
+}
+
+func (f customFormat) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
 
+func (f *customFormat) UnmarshalText(text []byte) error {
+	*f = customFormat(text)
 	return nil
 }
@@ -17,9 +17,13 @@ limitations under the License.
 package validation
 
 import (
+	"reflect"
 	"testing"
 
+	"k8s.io/kube-openapi/pkg/validation/spec"
 	"k8s.io/kube-openapi/pkg/validation/strfmt"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 )
 
 func TestRegistryFormats(t *testing.T) {
@@ -29,3 +33,121 @@ func TestRegistryFormats(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatRegistry(t *testing.T) {
+	r := NewFormatRegistry()
+
+	for _, f := range kubernetesFormats {
+		if !r.Supports(f.Name) {
+			t.Errorf("expected Kubernetes format %q to be supported", f.Name)
+		}
+	}
+
+	tests := []struct {
+		format string
+		value  string
+		want   bool
+	}{
+		{"rfc1123-label", "my-name", true},
+		{"rfc1123-label", "My_Name", false},
+		{"rfc1123-subdomain", "example.com", true},
+		{"rfc1035-label", "1abc", false},
+		{"k8s-qualified-name", "example.com/my-name", true},
+		{"k8s-label-value", "", true},
+		{"k8s-label-value", "-foo", false},
+		{"k8s-quantity", "100m", true},
+		{"k8s-quantity", "1Gi", true},
+		{"k8s-quantity", "one", false},
+		{"k8squantity", "1Gi", true},
+		{"semver", "1.2.3-beta.1+build.5", true},
+		{"semver", "v1.2.3", false},
+		{"semver", "01.2.3", false},
+		{"email", "foo@example.com", true},
+		{"unknown", "foo", false},
+	}
+	for _, tt := range tests {
+		if got := r.Validates(tt.format, tt.value); got != tt.want {
+			t.Errorf("Validates(%q, %q) = %v, expected %v", tt.format, tt.value, got, tt.want)
+		}
+	}
+
+	s := &spec.Schema{SchemaProps: spec.SchemaProps{Format: "x-custom"}}
+	if err := r.StripUnsupportedFormatsPostProcess(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Format != "" {
+		t.Errorf("expected unknown format to be stripped, got %q", s.Format)
+	}
+
+	if err := r.Register(Format{Name: "x-custom", Validate: func(s string) bool { return s == "custom" }}); err != nil {
+		t.Fatal(err)
+	}
+	s.Format = "x-custom"
+	if err := r.StripUnsupportedFormatsPostProcess(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Format != "x-custom" {
+		t.Errorf("expected registered format to be kept, got %q", s.Format)
+	}
+	if !r.Validates("x-custom", "custom") || r.Validates("x-custom", "other") {
+		t.Errorf("unexpected validation result for registered format")
+	}
+	if DefaultFormatRegistry.Supports("x-custom") {
+		t.Errorf("expected registration not to leak into the default registry")
+	}
+
+	for _, name := range []string{"x-custom", "xcustom", "uuid", "date-time", "rfc1123-label"} {
+		if err := r.Register(Format{Name: name, Validate: func(string) bool { return true }}); err == nil {
+			t.Errorf("expected error redefining format %q", name)
+		}
+	}
+	if !r.Validates("uuid", "6ba7b810-9dad-11d1-80b4-00c04fd430c8") || r.Validates("uuid", "foo") {
+		t.Errorf("expected the uuid format not to be redefined")
+	}
+
+	if err := r.Register(Format{Name: "-", Validate: func(string) bool { return true }}); err == nil {
+		t.Errorf("expected error for empty format name")
+	}
+	if err := r.Register(Format{Name: "foo"}); err == nil {
+		t.Errorf("expected error for missing validation function")
+	}
+}
+
+func TestValidateCustomResourceUpdateRatchetsFormats(t *testing.T) {
+	validator, _, err := NewSchemaValidator(&apiextensions.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiextensions.JSONSchemaProps{
+				"name":  {Type: "string", Format: "rfc1123-label"},
+				"email": {Type: "string", Format: "email"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := map[string]interface{}{"name": "Bad_Name", "email": "not-an-email"}
+
+	tests := []struct {
+		name     string
+		obj      map[string]interface{}
+		old      interface{}
+		expected []string
+	}{
+		{"unchanged values", map[string]interface{}{"name": "Bad_Name", "email": "not-an-email"}, old, []string{"email"}},
+		{"changed value", map[string]interface{}{"name": "Other_Name", "email": "foo@example.com"}, old, []string{"name"}},
+		{"fixed values", map[string]interface{}{"name": "Bad_Name", "email": "foo@example.com"}, old, nil},
+		{"no old object", map[string]interface{}{"name": "Bad_Name", "email": "foo@example.com"}, nil, []string{"name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields []string
+			for _, err := range ValidateCustomResourceUpdate(nil, tt.obj, tt.old, validator) {
+				fields = append(fields, err.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.expected) {
+				t.Errorf("expected errors for %v, got %v", tt.expected, fields)
+			}
+		})
+	}
+}
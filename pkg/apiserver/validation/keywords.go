@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// validateKeywords validates value against the const, if/then/else, dependentRequired and propertyNames
+// keywords in s and its properties, additionalProperties, items and allOf clauses. These keywords are not
+// known to the openapi validator and are stored as extra properties of the schema. Structural schemas
+// forbid them under anyOf, oneOf and not, which are hence not walked. Nested schemas are validated
+// with the given formats.
+func validateKeywords(fldPath *field.Path, s *spec.Schema, value interface{}, formats strfmt.Registry) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if c, ok := s.ExtraProps["const"]; ok {
+		expected, err := json.Marshal(c)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if actual, err := json.Marshal(value); err != nil || !bytes.Equal(expected, actual) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, value, []string{string(expected)}))
+		}
+	}
+
+	if ifSchema := extraSchema(s, "if"); ifSchema != nil {
+		branch := extraSchema(s, "else")
+		if len(validateSchema(fldPath, ifSchema, value, formats)) == 0 {
+			branch = extraSchema(s, "then")
+		}
+		allErrs = append(allErrs, validateSchema(fldPath, branch, value, formats)...)
+	}
+
+	for _, clause := range s.AllOf {
+		clause := clause
+		allErrs = append(allErrs, validateKeywords(fldPath, &clause, value, formats)...)
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		if dependentRequired, ok := s.ExtraProps["dependentRequired"].(map[string][]string); ok {
+			for _, k := range keys {
+				for _, dep := range dependentRequired[k] {
+					if _, found := value[dep]; !found {
+						allErrs = append(allErrs, field.Required(fldPath.Child(dep), fmt.Sprintf("must be specified if %s is specified", k)))
+					}
+				}
+			}
+		}
+
+		propertyNames := extraSchema(s, "propertyNames")
+		for _, k := range keys {
+			if propertyNames != nil {
+				allErrs = append(allErrs, validateSchema(fldPath.Key(k), propertyNames, k, formats)...)
+			}
+
+			if fldSchema, ok := s.Properties[k]; ok {
+				allErrs = append(allErrs, validateKeywords(fldPath.Child(k), &fldSchema, value[k], formats)...)
+			} else if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+				allErrs = append(allErrs, validateKeywords(fldPath.Key(k), s.AdditionalProperties.Schema, value[k], formats)...)
+			}
+		}
+
+	case []interface{}:
+		if s.Items == nil {
+			break
+		}
+		for i, item := range value {
+			itemSchema := s.Items.Schema
+			if itemSchema == nil && i < len(s.Items.Schemas) {
+				itemSchema = &s.Items.Schemas[i]
+			}
+			allErrs = append(allErrs, validateKeywords(fldPath.Index(i), itemSchema, item, formats)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateSchema validates value against s with both the openapi validator and validateKeywords.
+func validateSchema(fldPath *field.Path, s *spec.Schema, value interface{}, formats strfmt.Registry) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+	// the openapi validator prefixes the error names with the root path, such that they are complete already.
+	root := ""
+	if fldPath != nil {
+		root = fldPath.String()
+	}
+	allErrs := resultErrors(nil, validate.NewSchemaValidator(s, nil, root, formats).Validate(value))
+	return append(allErrs, validateKeywords(fldPath, s, value, formats)...)
+}
+
+// extraSchema returns the schema stored as extra property with the given key in s, or nil.
+func extraSchema(s *spec.Schema, key string) *spec.Schema {
+	ret, _ := s.ExtraProps[key].(*spec.Schema)
+	return ret
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+const definitionRefPrefix = "#/definitions/"
+
+// ResolveLocalReferences replaces every $ref in s pointing to a local definition of the
+// form "#/definitions/<name>" by the definition itself and drops the definitions afterwards.
+// All places referencing the same definition share its properties, items and additionalProperties,
+// such that recursive definitions lead to a cyclic schema. The schema validator supports these
+// because it only descends into properties, items and additionalProperties while validating a value.
+//
+// A $ref at the root is replaced by the definition as well, e.g. for a property schema extracted
+// from its parent together with the definitions. Other references and references as a definition
+// are not supported.
+func ResolveLocalReferences(s *spec.Schema) error {
+	if s == nil {
+		return nil
+	}
+
+	r := &referenceResolver{definitions: s.Definitions}
+	if isRef(s) {
+		name, err := r.definitionName(s)
+		if err != nil {
+			return err
+		}
+		defs := s.Definitions
+		*s = defs[name]
+		s.Definitions = defs
+	}
+	if err := r.walk(s); err != nil {
+		return err
+	}
+	for name, def := range s.Definitions {
+		if isRef(&def) {
+			return fmt.Errorf("definition %q must not be a $ref", name)
+		}
+		if err := r.walk(&def); err != nil {
+			return err
+		}
+	}
+
+	// all containers are shared between the copies of a definition. Hence, the order of
+	// setting the references does not matter.
+	for _, ref := range r.references {
+		ref.set(s.Definitions[ref.name])
+	}
+	s.Definitions = nil
+
+	return nil
+}
+
+type referenceResolver struct {
+	definitions spec.Definitions
+	references  []schemaReference
+}
+
+type schemaReference struct {
+	name string
+	set  func(def spec.Schema)
+}
+
+func isRef(s *spec.Schema) bool {
+	return len(s.Ref.String()) > 0
+}
+
+// definitionName returns the name of the definition the $ref schema s points to.
+func (r *referenceResolver) definitionName(s *spec.Schema) (string, error) {
+	ref := s.Ref.String()
+	name := strings.TrimPrefix(ref, definitionRefPrefix)
+	if !strings.HasPrefix(ref, definitionRefPrefix) || len(name) == 0 {
+		return "", fmt.Errorf("$ref %q must point to a local definition of the form %q", ref, definitionRefPrefix+"<name>")
+	}
+	if _, ok := r.definitions[name]; !ok {
+		return "", fmt.Errorf("$ref %q points to an unknown definition", ref)
+	}
+	return name, nil
+}
+
+// add records the $ref schema s, to be replaced through set.
+func (r *referenceResolver) add(s *spec.Schema, set func(def spec.Schema)) error {
+	name, err := r.definitionName(s)
+	if err != nil {
+		return err
+	}
+	r.references = append(r.references, schemaReference{name: name, set: set})
+	return nil
+}
+
+// walk records all references in s, without following them.
+func (r *referenceResolver) walk(s *spec.Schema) error {
+	// schemas stored by pointer or in slices are replaced in-place
+	pointer := func(s *spec.Schema) error {
+		if s == nil {
+			return nil
+		}
+		if isRef(s) {
+			return r.add(s, func(def spec.Schema) { *s = def })
+		}
+		return r.walk(s)
+	}
+
+	if s.Items != nil {
+		if err := pointer(s.Items.Schema); err != nil {
+			return err
+		}
+		for i := range s.Items.Schemas {
+			if err := pointer(&s.Items.Schemas[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if s.AdditionalProperties != nil {
+		if err := pointer(s.AdditionalProperties.Schema); err != nil {
+			return err
+		}
+	}
+	for _, junctor := range [][]spec.Schema{s.AllOf, s.AnyOf, s.OneOf} {
+		for i := range junctor {
+			if err := pointer(&junctor[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := pointer(s.Not); err != nil {
+		return err
+	}
+
+	// properties are stored by value and replaced in the map
+	for k, v := range s.Properties {
+		if isRef(&v) {
+			properties, k := s.Properties, k
+			if err := r.add(&v, func(def spec.Schema) { properties[k] = def }); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.walk(&v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
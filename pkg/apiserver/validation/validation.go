@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
 	"k8s.io/kube-openapi/pkg/validation/spec"
@@ -30,15 +31,24 @@ import (
 
 // NewSchemaValidator creates an openapi schema validator for the given CRD validation.
 func NewSchemaValidator(customResourceValidation *apiextensions.CustomResourceValidation) (*validate.SchemaValidator, *spec.Schema, error) {
+	return NewSchemaValidatorWithFormats(customResourceValidation, DefaultFormatRegistry)
+}
+
+// NewSchemaValidatorWithFormats creates an openapi schema validator for the given CRD validation,
+// which supports the formats of the given registry.
+func NewSchemaValidatorWithFormats(customResourceValidation *apiextensions.CustomResourceValidation, formats *FormatRegistry) (*validate.SchemaValidator, *spec.Schema, error) {
 	// Convert CRD schema to openapi schema
 	openapiSchema := &spec.Schema{}
 	if customResourceValidation != nil {
 		// TODO: replace with NewStructural(...).ToGoOpenAPI
-		if err := ConvertJSONSchemaPropsWithPostProcess(customResourceValidation.OpenAPIV3Schema, openapiSchema, StripUnsupportedFormatsPostProcess); err != nil {
+		if err := ConvertJSONSchemaPropsWithPostProcess(customResourceValidation.OpenAPIV3Schema, openapiSchema, formats.StripUnsupportedFormatsPostProcess); err != nil {
+			return nil, nil, err
+		}
+		if err := ResolveLocalReferences(openapiSchema); err != nil {
 			return nil, nil, err
 		}
 	}
-	return validate.NewSchemaValidator(openapiSchema, nil, "", strfmt.Default), openapiSchema, nil
+	return validate.NewSchemaValidator(openapiSchema, nil, "", formats.Registry()), openapiSchema, nil
 }
 
 // ValidateCustomResource validates the Custom Resource against the schema in the CustomResourceDefinition.
@@ -48,7 +58,43 @@ func ValidateCustomResource(fldPath *field.Path, customResource interface{}, val
 		return nil
 	}
 
-	result := validator.Validate(customResource)
+	allErrs := resultErrors(fldPath, validator.Validate(customResource))
+	allErrs = append(allErrs, validateKeywords(fldPath, validator.Schema, customResource, validator.KnownFormats)...)
+	return allErrs
+}
+
+// ValidateCustomResourceUpdate validates the updated Custom Resource like ValidateCustomResource, but
+// enforces the formats beyond the OpenAPI formats only on changed values: their errors are dropped
+// if the old Custom Resource has the same errors, i.e. the same invalid values at the same paths.
+func ValidateCustomResourceUpdate(fldPath *field.Path, customResource, old interface{}, validator *validate.SchemaValidator) field.ErrorList {
+	allErrs := ValidateCustomResource(fldPath, customResource, validator)
+	if len(allErrs) == 0 || old == nil {
+		return allErrs
+	}
+
+	// the errors which remain without the formats beyond the OpenAPI formats are always returned
+	openAPIValidator := validate.NewSchemaValidator(validator.Schema, validator.Root, validator.Path, strfmt.Default)
+	openAPIErrs := sets.NewString()
+	for _, err := range ValidateCustomResource(fldPath, customResource, openAPIValidator) {
+		openAPIErrs.Insert(err.Error())
+	}
+	oldErrs := sets.NewString()
+	for _, err := range ValidateCustomResource(fldPath, old, validator) {
+		oldErrs.Insert(err.Error())
+	}
+
+	var ret field.ErrorList
+	for _, err := range allErrs {
+		if msg := err.Error(); !openAPIErrs.Has(msg) && oldErrs.Has(msg) {
+			continue
+		}
+		ret = append(ret, err)
+	}
+	return ret
+}
+
+// resultErrors converts the errors of an openapi validation result into field errors.
+func resultErrors(fldPath *field.Path, result *validate.Result) field.ErrorList {
 	if result.IsValid() {
 		return nil
 	}
@@ -120,6 +166,10 @@ func ConvertJSONSchemaPropsWithPostProcess(in *apiextensions.JSONSchemaProps, ou
 	}
 	out.Nullable = in.Nullable
 	out.Format = in.Format
+	if in.XValueType != nil && len(in.Format) == 0 {
+		// string values of quantities and durations are validated by the corresponding format.
+		out.Format = valueTypeFormats[*in.XValueType]
+	}
 	out.Title = in.Title
 	out.Maximum = in.Maximum
 	out.ExclusiveMaximum = in.ExclusiveMaximum
@@ -227,6 +277,24 @@ func ConvertJSONSchemaPropsWithPostProcess(in *apiextensions.JSONSchemaProps, ou
 		}
 	}
 
+	// keywords unknown to spec.Schema are stored as extra properties and enforced by validateKeywords.
+	if in.Const != nil {
+		addExtraProperty(out, "const", *in.Const)
+	}
+	for key, in := range map[string]*apiextensions.JSONSchemaProps{"if": in.If, "then": in.Then, "else": in.Else, "propertyNames": in.PropertyNames} {
+		if in == nil {
+			continue
+		}
+		schema := new(spec.Schema)
+		if err := ConvertJSONSchemaPropsWithPostProcess(in, schema, postProcess); err != nil {
+			return err
+		}
+		addExtraProperty(out, key, schema)
+	}
+	if len(in.DependentRequired) > 0 {
+		addExtraProperty(out, "dependentRequired", map[string][]string(in.DependentRequired))
+	}
+
 	if in.ExternalDocs != nil {
 		out.ExternalDocs = &spec.ExternalDocumentation{}
 		out.ExternalDocs.Description = in.ExternalDocs.Description
@@ -257,9 +325,40 @@ func ConvertJSONSchemaPropsWithPostProcess(in *apiextensions.JSONSchemaProps, ou
 	if len(in.XValidations) != 0 {
 		out.VendorExtensible.AddExtension("x-kubernetes-validations", in.XValidations)
 	}
+	if in.XValueType != nil {
+		out.VendorExtensible.AddExtension("x-kubernetes-value-type", *in.XValueType)
+	}
+	if len(in.XNormalize) != 0 {
+		out.VendorExtensible.AddExtension("x-kubernetes-normalize", in.XNormalize)
+	}
+	if in.XDeprecated != nil {
+		out.VendorExtensible.AddExtension("x-kubernetes-deprecated", *in.XDeprecated)
+	}
+	if in.XSensitive {
+		out.VendorExtensible.AddExtension("x-kubernetes-sensitive", true)
+	}
+	if in.XWritePermission != nil {
+		out.VendorExtensible.AddExtension("x-kubernetes-write-permission", *in.XWritePermission)
+	}
+	if in.XConditions != nil {
+		out.VendorExtensible.AddExtension("x-kubernetes-conditions", *in.XConditions)
+	}
+	if len(in.XEmbeddedResourceKinds) != 0 {
+		out.VendorExtensible.AddExtension("x-kubernetes-embedded-resource-kinds", in.XEmbeddedResourceKinds)
+	}
+	if in.XReference != nil {
+		out.VendorExtensible.AddExtension("x-kubernetes-reference", in.XReference)
+	}
 	return nil
 }
 
+func addExtraProperty(s *spec.Schema, key string, value interface{}) {
+	if s.ExtraProps == nil {
+		s.ExtraProps = map[string]interface{}{}
+	}
+	s.ExtraProps[key] = value
+}
+
 func convertSliceOfJSONSchemaProps(in *[]apiextensions.JSONSchemaProps, out *[]spec.Schema, postProcess PostProcessFunc) error {
 	if in != nil {
 		for _, jsonSchemaProps := range *in {
@@ -128,6 +128,7 @@ type failingObject struct {
 }
 
 func TestValidateCustomResource(t *testing.T) {
+	nodeRef := "#/definitions/node"
 	tests := []struct {
 		name           string
 		schema         apiextensions.JSONSchemaProps
@@ -197,6 +198,46 @@ func TestValidateCustomResource(t *testing.T) {
 				map[string]interface{}{"field": []interface{}{}},
 			},
 		},
+		{name: "recursive definitions",
+			schema: apiextensions.JSONSchemaProps{
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"tree": {Ref: &nodeRef},
+				},
+				Definitions: apiextensions.JSONSchemaDefinitions{
+					"node": {
+						Type:     "object",
+						Required: []string{"value"},
+						Properties: map[string]apiextensions.JSONSchemaProps{
+							"value": {Type: "string"},
+							"children": {
+								Type:  "array",
+								Items: &apiextensions.JSONSchemaPropsOrArray{Schema: &apiextensions.JSONSchemaProps{Ref: &nodeRef}},
+							},
+						},
+					},
+				},
+			},
+			objects: []interface{}{
+				map[string]interface{}{},
+				map[string]interface{}{"tree": map[string]interface{}{"value": "a"}},
+				map[string]interface{}{"tree": map[string]interface{}{"value": "a", "children": []interface{}{
+					map[string]interface{}{"value": "b", "children": []interface{}{
+						map[string]interface{}{"value": "c"},
+					}},
+				}}},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"tree": map[string]interface{}{"value": 42}}, expectErrs: []string{`tree.value: Invalid value: "integer": tree.value in body must be of type string: "integer"`}},
+				{object: map[string]interface{}{"tree": map[string]interface{}{"value": "a", "children": []interface{}{
+					map[string]interface{}{"children": []interface{}{}},
+				}}}, expectErrs: []string{`tree.children.value: Required value`}},
+				{object: map[string]interface{}{"tree": map[string]interface{}{"value": "a", "children": []interface{}{
+					map[string]interface{}{"value": "b", "children": []interface{}{
+						map[string]interface{}{"value": true},
+					}},
+				}}}, expectErrs: []string{`tree.children.children.value: Invalid value: "boolean": tree.children.children.value in body must be of type string: "boolean"`}},
+			},
+		},
 		{name: "x-kubernetes-int-or-string",
 			schema: apiextensions.JSONSchemaProps{
 				Properties: map[string]apiextensions.JSONSchemaProps{
@@ -395,6 +436,151 @@ func TestValidateCustomResource(t *testing.T) {
 				}},
 			},
 		},
+		{name: "const",
+			schema: apiextensions.JSONSchemaProps{
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"kind":    {Type: "string", Const: jsonPtr("Foo")},
+					"replica": {Type: "integer", Const: jsonPtr(int64(1))},
+				},
+			},
+			objects: []interface{}{
+				map[string]interface{}{},
+				map[string]interface{}{"kind": "Foo", "replica": int64(1)},
+				map[string]interface{}{"replica": float64(1)},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"kind": "Bar", "replica": int64(2)}, expectErrs: []string{
+					`kind: Unsupported value: "Bar": supported values: "\"Foo\""`,
+					`replica: Unsupported value: 2: supported values: "1"`,
+				}},
+			},
+		},
+		{name: "if then else",
+			schema: apiextensions.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"type":   {Type: "string"},
+					"url":    {Type: "string"},
+					"secret": {Type: "string"},
+				},
+				If: &apiextensions.JSONSchemaProps{
+					Required: []string{"type"},
+					Properties: map[string]apiextensions.JSONSchemaProps{
+						"type": {Enum: []apiextensions.JSON{"http"}},
+					},
+				},
+				Then: &apiextensions.JSONSchemaProps{Required: []string{"url"}},
+				Else: &apiextensions.JSONSchemaProps{Required: []string{"secret"}},
+			},
+			objects: []interface{}{
+				map[string]interface{}{"type": "http", "url": "http://example.com"},
+				map[string]interface{}{"type": "file", "secret": "foo"},
+				map[string]interface{}{"secret": "foo"},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"type": "http", "secret": "foo"}, expectErrs: []string{`url: Required value`}},
+				{object: map[string]interface{}{"type": "file", "url": "http://example.com"}, expectErrs: []string{`secret: Required value`}},
+			},
+		},
+		{name: "dependentRequired",
+			schema: apiextensions.JSONSchemaProps{
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"field": {
+						Type: "object",
+						Properties: map[string]apiextensions.JSONSchemaProps{
+							"username": {Type: "string"},
+							"password": {Type: "string"},
+							"realm":    {Type: "string"},
+						},
+						DependentRequired: apiextensions.JSONSchemaDependentRequired{
+							"username": {"password", "realm"},
+						},
+					},
+				},
+			},
+			objects: []interface{}{
+				map[string]interface{}{"field": map[string]interface{}{}},
+				map[string]interface{}{"field": map[string]interface{}{"password": "bar"}},
+				map[string]interface{}{"field": map[string]interface{}{"username": "foo", "password": "bar", "realm": "baz"}},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"field": map[string]interface{}{"username": "foo"}}, expectErrs: []string{
+					`field.password: Required value: must be specified if username is specified`,
+					`field.realm: Required value: must be specified if username is specified`,
+				}},
+			},
+		},
+		{name: "propertyNames",
+			schema: apiextensions.JSONSchemaProps{
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"field": {
+						Type: "object",
+						AdditionalProperties: &apiextensions.JSONSchemaPropsOrBool{
+							Allows: true,
+							Schema: &apiextensions.JSONSchemaProps{Type: "integer"},
+						},
+						PropertyNames: &apiextensions.JSONSchemaProps{
+							Pattern:   "^[a-z]+$",
+							MaxLength: int64Ptr(5),
+						},
+					},
+				},
+			},
+			objects: []interface{}{
+				map[string]interface{}{"field": map[string]interface{}{}},
+				map[string]interface{}{"field": map[string]interface{}{"foo": int64(1), "bar": int64(2)}},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"field": map[string]interface{}{"Foo": int64(1), "foobar": int64(2)}}, expectErrs: []string{
+					`field[Foo]: Invalid value: "Foo": field[Foo] in body should match '^[a-z]+$'`,
+					`field[foobar]: Invalid value: "foobar": field[foobar] in body should be at most 5 chars long`,
+				}},
+			},
+		},
+		{name: "labels and annotations",
+			schema: apiextensions.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"metadata": {
+						Type: "object",
+						Properties: map[string]apiextensions.JSONSchemaProps{
+							"labels": {
+								Type:       "object",
+								Required:   []string{"team"},
+								Properties: map[string]apiextensions.JSONSchemaProps{"team": {Type: "string", Enum: []apiextensions.JSON{"red", "blue"}}},
+							},
+							"annotations": {
+								Type: "object",
+								AdditionalProperties: &apiextensions.JSONSchemaPropsOrBool{
+									Allows: true,
+									Schema: &apiextensions.JSONSchemaProps{Type: "string", Format: "uri"},
+								},
+								PropertyNames: &apiextensions.JSONSchemaProps{Pattern: "^example.com/"},
+							},
+						},
+					},
+				},
+			},
+			objects: []interface{}{
+				map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"team": "red", "other": "foo"}}},
+				map[string]interface{}{"metadata": map[string]interface{}{
+					"labels":      map[string]interface{}{"team": "blue"},
+					"annotations": map[string]interface{}{"example.com/docs": "https://example.com/docs"},
+				}},
+			},
+			failingObjects: []failingObject{
+				{object: map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"other": "foo"}}}, expectErrs: []string{
+					`metadata.labels.team: Required value`,
+				}},
+				{object: map[string]interface{}{"metadata": map[string]interface{}{
+					"labels":      map[string]interface{}{"team": "green"},
+					"annotations": map[string]interface{}{"docs": "https://example.com/docs"},
+				}}, expectErrs: []string{
+					`metadata.labels.team: Unsupported value: "green": supported values: "red", "blue"`,
+					`metadata.annotations[docs]: Invalid value: "docs": metadata.annotations[docs] in body should match '^example.com/'`,
+				}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -484,3 +670,12 @@ func TestItemsProperty(t *testing.T) {
 		})
 	}
 }
+
+func jsonPtr(x interface{}) *apiextensions.JSON {
+	ret := apiextensions.JSON(x)
+	return &ret
+}
+
+func int64Ptr(x int64) *int64 {
+	return &x
+}
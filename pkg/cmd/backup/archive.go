@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A backup is a directory or a tarball with the layout
+//
+//	customresourcedefinitions/<crd name>.json
+//	customresources/<crd name>/<namespace>/<name>.json
+//
+// Cluster-scoped custom resources are in the clusterScopedDir namespace directory, which is
+// not a valid namespace name.
+const (
+	crdDir           = "customresourcedefinitions"
+	crDir            = "customresources"
+	clusterScopedDir = "_cluster"
+)
+
+// crdFile returns the path of a CustomResourceDefinition in a backup.
+func crdFile(crdName string) string {
+	return path.Join(crdDir, crdName+".json")
+}
+
+// crFile returns the path of a custom resource in a backup.
+func crFile(crdName, namespace, name string) string {
+	if len(namespace) == 0 {
+		namespace = clusterScopedDir
+	}
+	return path.Join(crDir, crdName, namespace, name+".json")
+}
+
+// isTarball returns true if p is the path of a tarball, and whether it is gzipped.
+func isTarball(p string) (tarball, gzipped bool) {
+	switch {
+	case strings.HasSuffix(p, ".tar.gz"), strings.HasSuffix(p, ".tgz"):
+		return true, true
+	case strings.HasSuffix(p, ".tar"):
+		return true, false
+	}
+	return false, false
+}
+
+// archiveWriter writes the files of a backup.
+type archiveWriter interface {
+	WriteFile(name string, data []byte) error
+	Close() error
+}
+
+// newArchiveWriter returns a writer of a tarball at p if it ends with .tar, .tar.gz or .tgz,
+// and of a directory otherwise.
+func newArchiveWriter(p string) (archiveWriter, error) {
+	tarball, gzipped := isTarball(p)
+	if !tarball {
+		if err := os.MkdirAll(p, 0755); err != nil {
+			return nil, err
+		}
+		return dirWriter(p), nil
+	}
+
+	f, err := os.Create(p)
+	if err != nil {
+		return nil, err
+	}
+	w := &tarWriter{file: f}
+	if gzipped {
+		w.gzip = gzip.NewWriter(f)
+		w.tar = tar.NewWriter(w.gzip)
+	} else {
+		w.tar = tar.NewWriter(f)
+	}
+	return w, nil
+}
+
+type dirWriter string
+
+func (d dirWriter) WriteFile(name string, data []byte) error {
+	p := filepath.Join(string(d), filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, data, 0644)
+}
+
+func (d dirWriter) Close() error {
+	return nil
+}
+
+type tarWriter struct {
+	file *os.File
+	gzip *gzip.Writer
+	tar  *tar.Writer
+}
+
+func (w *tarWriter) WriteFile(name string, data []byte) error {
+	if err := w.tar.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := w.tar.Write(data)
+	return err
+}
+
+func (w *tarWriter) Close() error {
+	if err := w.tar.Close(); err != nil {
+		w.file.Close()
+		return err
+	}
+	if w.gzip != nil {
+		if err := w.gzip.Close(); err != nil {
+			w.file.Close()
+			return err
+		}
+	}
+	return w.file.Close()
+}
+
+// readArchive returns the files of the directory or the tarball at p, by their slash-separated path.
+func readArchive(p string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	if tarball, gzipped := isTarball(p); tarball {
+		f, err := os.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		var r io.Reader = f
+		if gzipped {
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				return nil, err
+			}
+			defer gz.Close()
+			r = gz
+		}
+		tr := tar.NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return files, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag != tar.TypeReg {
+				continue
+			}
+			data, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			files[path.Clean(hdr.Name)] = data
+		}
+	}
+
+	err := filepath.Walk(p, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(p, file)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	return files, err
+}
+
+// archiveFiles returns the paths of files in dir, sorted.
+func archiveFiles(files map[string][]byte, dir string) []string {
+	var ret []string
+	for name := range files {
+		if strings.HasPrefix(name, dir+"/") && strings.HasSuffix(name, ".json") {
+			ret = append(ret, name)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// crdNameOfFile returns the name of the CustomResourceDefinition of a custom resource file.
+func crdNameOfFile(name string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(name, crDir+"/"), "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("unexpected file %s in backup", name)
+	}
+	return parts[0], nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		crdFile("foos.example.com"):                    []byte(`{"kind":"CustomResourceDefinition"}`),
+		crFile("foos.example.com", "default", "a"):     []byte(`{"kind":"Foo"}`),
+		crFile("foos.example.com", "", "cluster-wide"): []byte(`{"kind":"Foo"}`),
+	}
+	for _, name := range []string{"dir", "backup.tar", "backup.tar.gz", "backup.tgz"} {
+		t.Run(name, func(t *testing.T) {
+			p := filepath.Join(dir, name)
+			w, err := newArchiveWriter(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, data := range files {
+				if err := w.WriteFile(name, data); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := readArchive(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, files) {
+				t.Errorf("unexpected files: %v", got)
+			}
+
+			if got, expected := archiveFiles(got, crDir), []string{
+				"customresources/foos.example.com/_cluster/cluster-wide.json",
+				"customresources/foos.example.com/default/a.json",
+			}; !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected custom resource files %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+func TestCRDNameOfFile(t *testing.T) {
+	if name, err := crdNameOfFile(crFile("foos.example.com", "default", "a")); err != nil || name != "foos.example.com" {
+		t.Errorf("unexpected name %q, error %v", name, err)
+	}
+	if _, err := crdNameOfFile("customresources/foos.example.com/a.json"); err == nil {
+		t.Errorf("expected error for file without namespace directory")
+	}
+}
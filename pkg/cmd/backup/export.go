@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// exportPageSize is the limit of the list requests of an export.
+const exportPageSize = 500
+
+// ExportOptions describes an export of the CustomResourceDefinitions of a group and of their
+// custom resources.
+type ExportOptions struct {
+	Kubeconfig string
+	// Group is the API group of the exported CustomResourceDefinitions.
+	Group string
+	// Output is the directory or the tarball to write, see newArchiveWriter.
+	Output string
+
+	Out io.Writer
+}
+
+// NewExportCommand returns the export command.
+func NewExportCommand(out io.Writer) *cobra.Command {
+	o := &ExportOptions{Out: out}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the CustomResourceDefinitions of a group and their custom resources",
+		Long: "Export the CustomResourceDefinitions of a group and all their custom resources in their storage version " +
+			"to a directory, or to a tarball if the output ends with .tar, .tar.gz or .tgz.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			crdClient, dynamicClient, err := newClients(o.Kubeconfig)
+			if err != nil {
+				return err
+			}
+			return o.Run(c.Context(), crdClient, dynamicClient)
+		},
+	}
+	o.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags adds the export flags to the flagset.
+func (o *ExportOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "The kubeconfig file of the server. If empty, the default loading rules apply.")
+	fs.StringVar(&o.Group, "group", o.Group, "The API group of the CustomResourceDefinitions to export.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, "The directory or tarball to write.")
+}
+
+// Validate validates the export options.
+func (o *ExportOptions) Validate() error {
+	if len(o.Group) == 0 {
+		return fmt.Errorf("--group is required")
+	}
+	if len(o.Output) == 0 {
+		return fmt.Errorf("--output is required")
+	}
+	return nil
+}
+
+// Run exports the CustomResourceDefinitions of the group and their custom resources.
+func (o *ExportOptions) Run(ctx context.Context, crdClient clientset.Interface, dynamicClient dynamic.Interface) error {
+	crds, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	w, err := newArchiveWriter(o.Output)
+	if err != nil {
+		return err
+	}
+	exported := 0
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if crd.Spec.Group != o.Group {
+			continue
+		}
+		count, err := o.exportCRD(ctx, w, dynamicClient, crd)
+		if err != nil {
+			w.Close()
+			return fmt.Errorf("failed to export %s: %v", crd.Name, err)
+		}
+		fmt.Fprintf(o.Out, "exported customresourcedefinition %s with %d objects\n", crd.Name, count)
+		exported++
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if exported == 0 {
+		return fmt.Errorf("no CustomResourceDefinitions found in group %s", o.Group)
+	}
+	return nil
+}
+
+// exportCRD writes crd and its custom resources, and returns the number of custom resources.
+func (o *ExportOptions) exportCRD(ctx context.Context, w archiveWriter, dynamicClient dynamic.Interface, crd *apiextensionsv1.CustomResourceDefinition) (int, error) {
+	storageVersion, err := apihelpers.GetCRDStorageVersion(crd)
+	if err != nil {
+		return 0, err
+	}
+
+	exportedCRD := &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: apiextensionsv1.SchemeGroupVersion.String(), Kind: "CustomResourceDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: crd.Name, Labels: crd.Labels, Annotations: crd.Annotations},
+		Spec:       crd.Spec,
+	}
+	data, err := json.MarshalIndent(exportedCRD, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := w.WriteFile(crdFile(crd.Name), data); err != nil {
+		return 0, err
+	}
+
+	// listing the storage version returns the objects as they are stored, after conversion of
+	// objects not migrated to the storage version yet
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: storageVersion, Resource: crd.Spec.Names.Plural}
+	count := 0
+	opts := metav1.ListOptions{Limit: exportPageSize}
+	for {
+		list, err := dynamicClient.Resource(gvr).List(ctx, opts)
+		if err != nil {
+			return count, err
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			cleanObject(obj)
+			data, err := json.MarshalIndent(obj.Object, "", "  ")
+			if err != nil {
+				return count, err
+			}
+			if err := w.WriteFile(crFile(crd.Name, obj.GetNamespace(), obj.GetName()), data); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(list.GetContinue()) == 0 {
+			return count, nil
+		}
+		opts.Continue = list.GetContinue()
+	}
+}
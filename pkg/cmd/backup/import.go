@@ -0,0 +1,367 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+// ImportOptions describes an import of a backup written by an export.
+type ImportOptions struct {
+	Kubeconfig string
+	// Input is the directory or the tarball to read.
+	Input string
+	// DryRun sends all writes as server-side dry runs. Custom resources of CustomResourceDefinitions
+	// which do not exist yet are only checked by the client.
+	DryRun bool
+	// Diff prints the differences between the backup and the server instead of importing.
+	Diff bool
+	// EstablishTimeout is how long to wait for CustomResourceDefinitions to become established.
+	EstablishTimeout time.Duration
+
+	Out io.Writer
+}
+
+// NewImportCommand returns the import command.
+func NewImportCommand(out io.Writer) *cobra.Command {
+	o := &ImportOptions{Out: out, EstablishTimeout: time.Minute}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import CustomResourceDefinitions and their custom resources from an export",
+		Long: "Create the CustomResourceDefinitions of an export, wait for them to become established, and create " +
+			"their custom resources with owners before their dependents. Existing objects are not changed.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			crdClient, dynamicClient, err := newClients(o.Kubeconfig)
+			if err != nil {
+				return err
+			}
+			return o.Run(c.Context(), crdClient, dynamicClient)
+		},
+	}
+	o.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags adds the import flags to the flagset.
+func (o *ImportOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "The kubeconfig file of the server. If empty, the default loading rules apply.")
+	fs.StringVarP(&o.Input, "input", "i", o.Input, "The directory or tarball written by export.")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Send all writes as server-side dry runs.")
+	fs.BoolVar(&o.Diff, "diff", o.Diff, "Print the differences between the backup and the server instead of importing.")
+	fs.DurationVar(&o.EstablishTimeout, "establish-timeout", o.EstablishTimeout, "How long to wait for each CustomResourceDefinition to become established.")
+}
+
+// Validate validates the import options.
+func (o *ImportOptions) Validate() error {
+	if len(o.Input) == 0 {
+		return fmt.Errorf("--input is required")
+	}
+	if o.DryRun && o.Diff {
+		return fmt.Errorf("--dry-run and --diff are mutually exclusive")
+	}
+	return nil
+}
+
+// imported is the content of a backup.
+type imported struct {
+	crds []*apiextensionsv1.CustomResourceDefinition
+	// objects are the custom resources by CustomResourceDefinition name.
+	objects map[string][]*unstructured.Unstructured
+}
+
+// readBackup reads and decodes the backup at p.
+func readBackup(p string) (*imported, error) {
+	files, err := readArchive(p)
+	if err != nil {
+		return nil, err
+	}
+	ret := &imported{objects: map[string][]*unstructured.Unstructured{}}
+	for _, name := range archiveFiles(files, crdDir) {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := json.Unmarshal(files[name], crd); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", name, err)
+		}
+		ret.crds = append(ret.crds, crd)
+		ret.objects[crd.Name] = nil
+	}
+	for _, name := range archiveFiles(files, crDir) {
+		crdName, err := crdNameOfFile(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ret.objects[crdName]; !ok {
+			return nil, fmt.Errorf("%s belongs to customresourcedefinition %s, which is not in the backup", name, crdName)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(files[name]); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %v", name, err)
+		}
+		ret.objects[crdName] = append(ret.objects[crdName], obj)
+	}
+	if len(ret.crds) == 0 {
+		return nil, fmt.Errorf("no CustomResourceDefinitions found in %s", p)
+	}
+	return ret, nil
+}
+
+// storageResource returns the resource of the storage version of crd.
+func storageResource(crd *apiextensionsv1.CustomResourceDefinition) (schema.GroupVersionResource, error) {
+	version, err := apihelpers.GetCRDStorageVersion(crd)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return schema.GroupVersionResource{Group: crd.Spec.Group, Version: version, Resource: crd.Spec.Names.Plural}, nil
+}
+
+// Run imports the backup, or prints its differences to the server.
+func (o *ImportOptions) Run(ctx context.Context, crdClient clientset.Interface, dynamicClient dynamic.Interface) error {
+	backup, err := readBackup(o.Input)
+	if err != nil {
+		return err
+	}
+	if o.Diff {
+		return o.diff(ctx, backup, crdClient, dynamicClient)
+	}
+
+	var dryRun []string
+	if o.DryRun {
+		dryRun = []string{metav1.DryRunAll}
+	}
+
+	// existing are the CustomResourceDefinitions which exist on the server
+	existing := map[string]bool{}
+	crds := map[string]*apiextensionsv1.CustomResourceDefinition{}
+	for _, crd := range backup.crds {
+		crds[crd.Name] = crd
+		_, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, crd, metav1.CreateOptions{DryRun: dryRun})
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			fmt.Fprintf(o.Out, "customresourcedefinition/%s exists\n", crd.Name)
+			existing[crd.Name] = true
+		case err != nil:
+			return fmt.Errorf("failed to create customresourcedefinition %s: %v", crd.Name, err)
+		default:
+			fmt.Fprintf(o.Out, "customresourcedefinition/%s created%s\n", crd.Name, o.dryRunSuffix())
+			existing[crd.Name] = !o.DryRun
+		}
+		if existing[crd.Name] {
+			if err := o.waitForEstablished(ctx, crdClient, crd.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	// owners are restored before their dependents, across all CustomResourceDefinitions
+	var all []*unstructured.Unstructured
+	crdOf := map[*unstructured.Unstructured]string{}
+	for _, crd := range backup.crds {
+		for _, obj := range backup.objects[crd.Name] {
+			all = append(all, obj)
+			crdOf[obj] = crd.Name
+		}
+	}
+	uids := newRestoredUIDs(all)
+
+	var errs []error
+	created, skipped := 0, 0
+	for _, obj := range sortByOwners(all) {
+		crd := crds[crdOf[obj]]
+		desc := fmt.Sprintf("%s %s", crd.Name, objectName(obj))
+		if !existing[crd.Name] {
+			fmt.Fprintf(o.Out, "%s created (client dry run)\n", desc)
+			created++
+			continue
+		}
+
+		exportedUID, dropped := uids.prepare(obj)
+		for _, ref := range dropped {
+			fmt.Fprintf(o.Out, "%s: dropped owner reference to %s %s, which was not restored\n", desc, ref.Kind, ref.Name)
+		}
+		restored, err := o.createObject(ctx, dynamicClient, crd, obj, dryRun)
+		if apierrors.IsAlreadyExists(err) {
+			fmt.Fprintf(o.Out, "%s exists\n", desc)
+			skipped++
+			if restored != nil {
+				uids.add(exportedUID, restored.GetUID())
+			}
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create %s: %v", desc, err))
+			continue
+		}
+		uids.add(exportedUID, restored.GetUID())
+		fmt.Fprintf(o.Out, "%s created%s\n", desc, o.dryRunSuffix())
+		created++
+	}
+	fmt.Fprintf(o.Out, "%d objects created%s, %d existing, %d failed\n", created, o.dryRunSuffix(), skipped, len(errs))
+	return utilerrors.NewAggregate(errs)
+}
+
+// createObject creates obj, and restores its status if the storage version of crd has the status
+// subresource. If obj exists, it returns the existing object with an AlreadyExists error.
+func (o *ImportOptions) createObject(ctx context.Context, dynamicClient dynamic.Interface, crd *apiextensionsv1.CustomResourceDefinition, obj *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error) {
+	gvr, err := storageResource(crd)
+	if err != nil {
+		return nil, err
+	}
+	client := dynamicClient.Resource(gvr).Namespace(obj.GetNamespace())
+
+	created, err := client.Create(ctx, obj, metav1.CreateOptions{DryRun: dryRun})
+	if apierrors.IsAlreadyExists(err) {
+		existing, getErr := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if getErr != nil {
+			return nil, err
+		}
+		return existing, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	status, hasStatus := obj.Object["status"]
+	subresources, err := apihelpers.GetSubresourcesForVersion(crd, gvr.Version)
+	if err != nil || !hasStatus || subresources == nil || subresources.Status == nil || o.DryRun {
+		return created, err
+	}
+	created.Object["status"] = status
+	return client.UpdateStatus(ctx, created, metav1.UpdateOptions{})
+}
+
+func (o *ImportOptions) waitForEstablished(ctx context.Context, crdClient clientset.Interface, name string) error {
+	err := wait.PollImmediate(100*time.Millisecond, o.EstablishTimeout, func() (bool, error) {
+		crd, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if apihelpers.IsCRDConditionFalse(crd, apiextensionsv1.NamesAccepted) {
+			cond := apihelpers.FindCRDCondition(crd, apiextensionsv1.NamesAccepted)
+			return false, fmt.Errorf("names not accepted: %s", cond.Message)
+		}
+		return apihelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established), nil
+	})
+	if err != nil {
+		return fmt.Errorf("customresourcedefinition %s did not become established: %v", name, err)
+	}
+	return nil
+}
+
+// diff prints the differences between the backup and the server.
+func (o *ImportOptions) diff(ctx context.Context, backup *imported, crdClient clientset.Interface, dynamicClient dynamic.Interface) error {
+	for _, crd := range backup.crds {
+		live, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crd.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			fmt.Fprintf(o.Out, "customresourcedefinition/%s: missing, with %d objects\n", crd.Name, len(backup.objects[crd.Name]))
+			continue
+		} else if err != nil {
+			return err
+		}
+		if d := cmp.Diff(crd.Spec, live.Spec); len(d) > 0 {
+			fmt.Fprintf(o.Out, "customresourcedefinition/%s: changed (-backup +server):\n%s", crd.Name, d)
+		}
+
+		// the objects are compared at the storage version of the backup
+		gvr, err := storageResource(crd)
+		if err != nil {
+			return err
+		}
+		liveObjects := map[string]*unstructured.Unstructured{}
+		opts := metav1.ListOptions{Limit: exportPageSize}
+		for {
+			list, err := dynamicClient.Resource(gvr).List(ctx, opts)
+			if err != nil {
+				return fmt.Errorf("failed to list %s: %v", crd.Name, err)
+			}
+			for i := range list.Items {
+				obj := &list.Items[i]
+				cleanObject(obj)
+				liveObjects[objectName(obj)] = obj
+			}
+			if len(list.GetContinue()) == 0 {
+				break
+			}
+			opts.Continue = list.GetContinue()
+		}
+
+		for _, obj := range backup.objects[crd.Name] {
+			name := objectName(obj)
+			live, ok := liveObjects[name]
+			if !ok {
+				fmt.Fprintf(o.Out, "%s %s: missing\n", crd.Name, name)
+				continue
+			}
+			delete(liveObjects, name)
+			if d := cmp.Diff(withoutUIDs(obj).Object, withoutUIDs(live).Object); len(d) > 0 {
+				fmt.Fprintf(o.Out, "%s %s: changed (-backup +server):\n%s", crd.Name, name, d)
+			}
+		}
+		for name := range liveObjects {
+			fmt.Fprintf(o.Out, "%s %s: not in backup\n", crd.Name, name)
+		}
+	}
+	return nil
+}
+
+func (o *ImportOptions) dryRunSuffix() string {
+	if o.DryRun {
+		return " (server dry run)"
+	}
+	return ""
+}
+
+// withoutUIDs returns a copy of obj without its uid and the uids of its owner references, which
+// differ between the exported objects and their restored copies.
+func withoutUIDs(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	obj.SetUID("")
+	refs := obj.GetOwnerReferences()
+	for i := range refs {
+		refs[i].UID = ""
+	}
+	if len(refs) > 0 {
+		obj.SetOwnerReferences(refs)
+	}
+	return obj
+}
+
+// objectName returns namespace/name, or name of cluster-scoped objects.
+func objectName(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); len(ns) > 0 {
+		return ns + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
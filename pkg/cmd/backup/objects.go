@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// serverSetMetadata are the metadata fields set by the server, which are not exported. The uid
+// is exported to restore owner references.
+var serverSetMetadata = []string{
+	"resourceVersion",
+	"selfLink",
+	"creationTimestamp",
+	"generation",
+	"managedFields",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+}
+
+// cleanObject removes the metadata fields set by the server from obj.
+func cleanObject(obj *unstructured.Unstructured) {
+	for _, f := range serverSetMetadata {
+		unstructured.RemoveNestedField(obj.Object, "metadata", f)
+	}
+}
+
+// sortByOwners returns objs ordered such that owners come before their dependents. Otherwise the
+// order is kept. Owners are identified by the uid exported with them.
+func sortByOwners(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	index := make(map[types.UID]int, len(objs))
+	for i, obj := range objs {
+		if uid := obj.GetUID(); len(uid) > 0 {
+			index[uid] = i
+		}
+	}
+
+	ret := make([]*unstructured.Unstructured, 0, len(objs))
+	// 0: not visited, 1: visiting, 2: visited
+	state := make([]int, len(objs))
+	var visit func(i int)
+	visit = func(i int) {
+		if state[i] != 0 {
+			// visited, or an ownership cycle which is broken here
+			return
+		}
+		state[i] = 1
+		for _, ref := range objs[i].GetOwnerReferences() {
+			if owner, ok := index[ref.UID]; ok {
+				visit(owner)
+			}
+		}
+		state[i] = 2
+		ret = append(ret, objs[i])
+	}
+	for i := range objs {
+		visit(i)
+	}
+	return ret
+}
+
+// restoredUIDs maps the uids of exported objects to those of their restored copies.
+type restoredUIDs struct {
+	// exported are the uids of all objects in the backup.
+	exported map[types.UID]bool
+	// restored are the uids of the restored objects, by exported uid.
+	restored map[types.UID]types.UID
+}
+
+func newRestoredUIDs(objs []*unstructured.Unstructured) *restoredUIDs {
+	ret := &restoredUIDs{exported: map[types.UID]bool{}, restored: map[types.UID]types.UID{}}
+	for _, obj := range objs {
+		if uid := obj.GetUID(); len(uid) > 0 {
+			ret.exported[uid] = true
+		}
+	}
+	return ret
+}
+
+// prepare removes the uid of obj and rewrites its owner references to objects in the backup to
+// their restored copies. References to owners in the backup which were not restored are dropped,
+// because the garbage collector would delete obj otherwise. References to other owners are kept.
+// It returns the exported uid of obj, and the dropped owner references.
+func (u *restoredUIDs) prepare(obj *unstructured.Unstructured) (types.UID, []metav1.OwnerReference) {
+	uid := obj.GetUID()
+	obj.SetUID("")
+
+	refs := obj.GetOwnerReferences()
+	if len(refs) == 0 {
+		return uid, nil
+	}
+	var kept, dropped []metav1.OwnerReference
+	for _, ref := range refs {
+		if !u.exported[ref.UID] {
+			kept = append(kept, ref)
+		} else if restored, ok := u.restored[ref.UID]; ok {
+			ref.UID = restored
+			kept = append(kept, ref)
+		} else {
+			dropped = append(dropped, ref)
+		}
+	}
+	obj.SetOwnerReferences(kept)
+	return uid, dropped
+}
+
+// add records the uid of the restored copy of the object exported with the given uid.
+func (u *restoredUIDs) add(exported, restored types.UID) {
+	if len(exported) > 0 && len(restored) > 0 {
+		u.restored[exported] = restored
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newObject(name string, owners ...string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("example.com/v1")
+	obj.SetKind("Foo")
+	obj.SetName(name)
+	obj.SetUID(types.UID(name + "-uid"))
+	var refs []metav1.OwnerReference
+	for _, owner := range owners {
+		refs = append(refs, metav1.OwnerReference{APIVersion: "example.com/v1", Kind: "Foo", Name: owner, UID: types.UID(owner + "-uid")})
+	}
+	obj.SetOwnerReferences(refs)
+	return obj
+}
+
+func names(objs []*unstructured.Unstructured) []string {
+	var ret []string
+	for _, obj := range objs {
+		ret = append(ret, obj.GetName())
+	}
+	return ret
+}
+
+func TestCleanObject(t *testing.T) {
+	obj := newObject("a")
+	obj.SetResourceVersion("42")
+	obj.SetGeneration(3)
+	obj.SetCreationTimestamp(metav1.Now())
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "test"}})
+	obj.SetLabels(map[string]string{"a": "b"})
+
+	cleanObject(obj)
+
+	expected := newObject("a")
+	expected.SetLabels(map[string]string{"a": "b"})
+	if !reflect.DeepEqual(obj.Object, expected.Object) {
+		t.Errorf("unexpected object: %v", obj.Object)
+	}
+}
+
+func TestSortByOwners(t *testing.T) {
+	tests := []struct {
+		name     string
+		objs     []*unstructured.Unstructured
+		expected []string
+	}{
+		{
+			name:     "no owners",
+			objs:     []*unstructured.Unstructured{newObject("a"), newObject("b")},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "chain",
+			objs:     []*unstructured.Unstructured{newObject("c", "b"), newObject("b", "a"), newObject("a")},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "owner outside of backup",
+			objs:     []*unstructured.Unstructured{newObject("b", "x"), newObject("a")},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "cycle",
+			objs:     []*unstructured.Unstructured{newObject("a", "b"), newObject("b", "a")},
+			expected: []string{"b", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(sortByOwners(tt.objs)); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRestoredUIDs(t *testing.T) {
+	owner, restoredDependent, droppedDependent, external := newObject("owner"), newObject("dependent", "owner"), newObject("orphan", "missing"), newObject("external", "x")
+	missing := newObject("missing")
+	uids := newRestoredUIDs([]*unstructured.Unstructured{owner, restoredDependent, droppedDependent, external, missing})
+
+	uid, dropped := uids.prepare(owner)
+	if uid != "owner-uid" || len(dropped) != 0 || len(owner.GetUID()) != 0 {
+		t.Fatalf("unexpected uid %q, dropped %v, object uid %q", uid, dropped, owner.GetUID())
+	}
+	uids.add(uid, "restored-owner-uid")
+
+	if _, dropped := uids.prepare(restoredDependent); len(dropped) != 0 {
+		t.Errorf("unexpected dropped references: %v", dropped)
+	}
+	if refs := restoredDependent.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != "restored-owner-uid" {
+		t.Errorf("expected owner reference to the restored owner, got %v", refs)
+	}
+
+	if _, dropped := uids.prepare(droppedDependent); len(dropped) != 1 || dropped[0].Name != "missing" {
+		t.Errorf("expected the reference to the unrestored owner to be dropped, got %v", dropped)
+	}
+	if refs := droppedDependent.GetOwnerReferences(); len(refs) != 0 {
+		t.Errorf("unexpected owner references: %v", refs)
+	}
+
+	if _, dropped := uids.prepare(external); len(dropped) != 0 {
+		t.Errorf("unexpected dropped references: %v", dropped)
+	}
+	if refs := external.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != "x-uid" {
+		t.Errorf("expected the owner reference outside of the backup to be kept, got %v", refs)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/apiextensions-apiserver/pkg/apiserver"
+	"k8s.io/apiextensions-apiserver/pkg/storage/local"
+	"k8s.io/apiextensions-apiserver/pkg/storage/local/sqlite"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+)
+
+// LocalStorageOptions describes a storage replacing etcd, for tests and self-contained servers.
+type LocalStorageOptions struct {
+	// Backend is memory or sqlite. If empty, etcd is used.
+	Backend string
+	// SQLitePath is the path of the SQLite database file.
+	SQLitePath string
+	// HistorySize is the number of writes kept in memory to serve watches and lists at older
+	// resource versions.
+	HistorySize int
+}
+
+// Enabled returns true if the local storage is used instead of etcd.
+func (o *LocalStorageOptions) Enabled() bool {
+	return o != nil && len(o.Backend) > 0
+}
+
+// AddFlags adds the local storage flags to the flagset.
+func (o *LocalStorageOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	fs.StringVar(&o.Backend, "local-storage-backend", o.Backend, ""+
+		"The storage to use instead of etcd: 'memory' or 'sqlite'. The etcd flags are ignored if set. "+
+		"The local storage serves a single server. The content of 'memory' is lost when the server exits.")
+	fs.StringVar(&o.SQLitePath, "local-storage-sqlite-path", o.SQLitePath,
+		"The path of the database file of the 'sqlite' local storage. It is created if it does not exist.")
+	fs.IntVar(&o.HistorySize, "local-storage-history-size", o.HistorySize, ""+
+		"The number of writes kept in memory to serve watches and lists at older resource versions. "+
+		"If 0, a default is used.")
+}
+
+// Validate validates the local storage options.
+func (o *LocalStorageOptions) Validate() []error {
+	if !o.Enabled() {
+		return nil
+	}
+	var errs []error
+	switch o.Backend {
+	case local.StorageTypeMemory:
+		if len(o.SQLitePath) > 0 {
+			errs = append(errs, fmt.Errorf("--local-storage-sqlite-path is only allowed with --local-storage-backend=%s", local.StorageTypeSQLite))
+		}
+	case local.StorageTypeSQLite:
+		if len(o.SQLitePath) == 0 {
+			errs = append(errs, fmt.Errorf("--local-storage-sqlite-path is required with --local-storage-backend=%s", local.StorageTypeSQLite))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("--local-storage-backend invalid, allowed values: %s, %s", local.StorageTypeMemory, local.StorageTypeSQLite))
+	}
+	if o.HistorySize < 0 {
+		errs = append(errs, fmt.Errorf("--local-storage-history-size must not be negative"))
+	}
+	return errs
+}
+
+// ApplyTo configures the storage of CustomResourceDefinitions and custom resources in config to use
+// the local storage. Prefixes and codecs are taken from etcdOptions.
+func (o *LocalStorageOptions) ApplyTo(config *apiserver.Config, etcdOptions genericoptions.EtcdOptions) error {
+	if !o.Enabled() {
+		return nil
+	}
+
+	backend := local.NewMemoryBackend()
+	if o.Backend == local.StorageTypeSQLite {
+		var err error
+		if backend, err = sqlite.NewBackend(o.SQLitePath); err != nil {
+			return err
+		}
+	}
+	// the database is not closed, because requests are served until the process exits. Writes
+	// to SQLite are committed transactions, so they survive that.
+	db, err := local.NewDatabase(backend, o.HistorySize)
+	if err != nil {
+		return fmt.Errorf("error opening the %s local storage: %v", o.Backend, err)
+	}
+
+	getter := apiserver.CRDRESTOptionsGetter{
+		StorageConfig:           etcdOptions.StorageConfig,
+		StoragePrefix:           etcdOptions.StorageConfig.Prefix,
+		EnableGarbageCollection: etcdOptions.EnableGarbageCollection,
+		DeleteCollectionWorkers: etcdOptions.DeleteCollectionWorkers,
+		StorageDecorator:        db.NewStorage,
+	}
+	getter.StorageConfig.Type = o.Backend
+	// CustomResourceDefinitions are stored with the codec of the etcd options
+	config.GenericConfig.RESTOptionsGetter = getter
+
+	getter.StorageConfig.Codec = unstructured.UnstructuredJSONScheme
+	config.ExtraConfig.CRDRESTOptionsGetter = getter
+	return nil
+}
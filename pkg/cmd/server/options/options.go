@@ -46,6 +46,8 @@ type CustomResourceDefinitionsServerOptions struct {
 	ServerRunOptions   *options.ServerRunOptions
 	RecommendedOptions *genericoptions.RecommendedOptions
 	APIEnablement      *genericoptions.APIEnablementOptions
+	StorageOverrides   *CRDStorageOverridesOptions
+	LocalStorage       *LocalStorageOptions
 
 	StdOut io.Writer
 	StdErr io.Writer
@@ -59,7 +61,9 @@ func NewCustomResourceDefinitionsServerOptions(out, errOut io.Writer) *CustomRes
 			defaultEtcdPathPrefix,
 			apiserver.Codecs.LegacyCodec(v1beta1.SchemeGroupVersion, v1.SchemeGroupVersion),
 		),
-		APIEnablement: genericoptions.NewAPIEnablementOptions(),
+		APIEnablement:    genericoptions.NewAPIEnablementOptions(),
+		StorageOverrides: &CRDStorageOverridesOptions{},
+		LocalStorage:     &LocalStorageOptions{},
 
 		StdOut: out,
 		StdErr: errOut,
@@ -73,14 +77,17 @@ func (o CustomResourceDefinitionsServerOptions) AddFlags(fs *pflag.FlagSet) {
 	o.ServerRunOptions.AddUniversalFlags(fs)
 	o.RecommendedOptions.AddFlags(fs)
 	o.APIEnablement.AddFlags(fs)
+	o.StorageOverrides.AddFlags(fs)
+	o.LocalStorage.AddFlags(fs)
 }
 
 // Validate validates the apiextensions-apiserver options.
 func (o CustomResourceDefinitionsServerOptions) Validate() error {
 	errors := []error{}
 	errors = append(errors, o.ServerRunOptions.Validate()...)
-	errors = append(errors, o.RecommendedOptions.Validate()...)
+	errors = append(errors, o.recommendedOptions().Validate()...)
 	errors = append(errors, o.APIEnablement.Validate(apiserver.Scheme)...)
+	errors = append(errors, o.LocalStorage.Validate()...)
 	return utilerrors.NewAggregate(errors)
 }
 
@@ -100,7 +107,7 @@ func (o CustomResourceDefinitionsServerOptions) Config() (*apiserver.Config, err
 	if err := o.ServerRunOptions.ApplyTo(&serverConfig.Config); err != nil {
 		return nil, err
 	}
-	if err := o.RecommendedOptions.ApplyTo(serverConfig); err != nil {
+	if err := o.recommendedOptions().ApplyTo(serverConfig); err != nil {
 		return nil, err
 	}
 	if err := o.APIEnablement.ApplyTo(&serverConfig.Config, apiserver.DefaultAPIResourceConfigSource(), apiserver.Scheme); err != nil {
@@ -115,9 +122,56 @@ func (o CustomResourceDefinitionsServerOptions) Config() (*apiserver.Config, err
 			AuthResolverWrapper:  webhook.NewDefaultAuthenticationInfoResolverWrapper(nil, nil, serverConfig.LoopbackClientConfig, nil),
 		},
 	}
+	if err := o.LocalStorage.ApplyTo(config, *o.RecommendedOptions.Etcd); err != nil {
+		return nil, err
+	}
+	if err := o.StorageOverrides.ApplyTo(&config.ExtraConfig); err != nil {
+		return nil, err
+	}
 	return config, nil
 }
 
+// recommendedOptions returns the recommended options without etcd if the local storage is used.
+func (o CustomResourceDefinitionsServerOptions) recommendedOptions() *genericoptions.RecommendedOptions {
+	if !o.LocalStorage.Enabled() {
+		return o.RecommendedOptions
+	}
+	ret := *o.RecommendedOptions
+	ret.Etcd = nil
+	return &ret
+}
+
+// CRDStorageOverridesOptions describes the per-CRD storage overrides of an apiextensions-apiserver.
+type CRDStorageOverridesOptions struct {
+	// File maps <resource>.<group> to storage overrides. It is reloaded while the server runs.
+	File string
+}
+
+// AddFlags adds the storage override flags to the flagset.
+func (o *CRDStorageOverridesOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	fs.StringVar(&o.File, "custom-resource-storage-overrides-file", o.File, ""+
+		"A YAML or JSON file mapping <resource>.<group> of custom resources to storage overrides: "+
+		"enableWatchCache, watchCacheSize (only 0, which disables the watch cache), maxPageSize and storagePrefix. "+
+		"The file is reloaded periodically, and the storage of a custom resource is re-created when its overrides change. "+
+		"Storage prefixes cannot be changed by reloading.")
+}
+
+// ApplyTo loads the storage overrides into config.
+func (o *CRDStorageOverridesOptions) ApplyTo(config *apiserver.ExtraConfig) error {
+	if o == nil || len(o.File) == 0 {
+		return nil
+	}
+	overrides, err := apiserver.NewCRDStorageOverridesFromFile(o.File)
+	if err != nil {
+		return fmt.Errorf("error loading the storage overrides of custom resources: %v", err)
+	}
+	config.CRDStorageOverrides = overrides
+	return nil
+}
+
 // NewCRDRESTOptionsGetter create a RESTOptionsGetter for CustomResources.
 func NewCRDRESTOptionsGetter(etcdOptions genericoptions.EtcdOptions) genericregistry.RESTOptionsGetter {
 	ret := apiserver.CRDRESTOptionsGetter{
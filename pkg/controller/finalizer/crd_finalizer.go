@@ -75,6 +75,20 @@ type ListerCollectionDeleter interface {
 	rest.CollectionDeleter
 }
 
+// DeletedPurger is implemented by ListerCollectionDeleters of custom resources which may have
+// retained deleted custom resources.
+type DeletedPurger interface {
+	// PurgeDeleted removes all retained deleted custom resources.
+	PurgeDeleted(ctx context.Context) error
+}
+
+// HistoryPurger is implemented by ListerCollectionDeleters of custom resources which may have kept
+// revisions of custom resources.
+type HistoryPurger interface {
+	// PurgeHistory removes the revisions of all custom resources.
+	PurgeHistory(ctx context.Context) error
+}
+
 // CRClientGetter knows how to get a ListerCollectionDeleter for a given CRD UID.
 type CRClientGetter interface {
 	// GetCustomResourceListerCollectionDeleter gets the ListerCollectionDeleter for the given CRD
@@ -251,6 +265,30 @@ func (c *CRDFinalizer) deleteInstances(crd *apiextensionsv1.CustomResourceDefini
 			Message: fmt.Sprintf("could not confirm zero CustomResources remaining: %v", err),
 		}, err
 	}
+
+	// custom resources deleted above are retained if retention is enabled, and older ones may be
+	// retained from before retention was disabled
+	if purger, ok := crClient.(DeletedPurger); ok {
+		if err := purger.PurgeDeleted(ctx); err != nil {
+			return apiextensionsv1.CustomResourceDefinitionCondition{
+				Type:    apiextensionsv1.Terminating,
+				Status:  apiextensionsv1.ConditionTrue,
+				Reason:  "InstanceDeletionFailed",
+				Message: fmt.Sprintf("could not purge deleted instances: %v", err),
+			}, err
+		}
+	}
+	// likewise, revisions may be kept from before history was disabled
+	if purger, ok := crClient.(HistoryPurger); ok {
+		if err := purger.PurgeHistory(ctx); err != nil {
+			return apiextensionsv1.CustomResourceDefinitionCondition{
+				Type:    apiextensionsv1.Terminating,
+				Status:  apiextensionsv1.ConditionTrue,
+				Reason:  "InstanceDeletionFailed",
+				Message: fmt.Sprintf("could not purge the history of instances: %v", err),
+			}, err
+		}
+	}
 	return apiextensionsv1.CustomResourceDefinitionCondition{
 		Type:    apiextensionsv1.Terminating,
 		Status:  apiextensionsv1.ConditionFalse,
@@ -19,6 +19,7 @@ package builder
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 
@@ -187,6 +188,13 @@ func generateBuilder(crd *apiextensionsv1.CustomResourceDefinition, version stri
 		routes = append(routes, b.buildRoute(root, "/{name}/scale", "PUT", "put", "replace", scale).Reads(scale))
 		routes = append(routes, b.buildRoute(root, "/{name}/scale", "PATCH", "patch", "patch", scale).Reads(patch))
 	}
+	if subresources != nil {
+		for _, custom := range subresources.Custom {
+			routes = append(routes, b.buildRoute(root, "/{name}/"+custom.Name, "GET", "get", "read", sample))
+			routes = append(routes, b.buildRoute(root, "/{name}/"+custom.Name, "PUT", "put", "replace", sample).Reads(sample))
+			routes = append(routes, b.buildRoute(root, "/{name}/"+custom.Name, "PATCH", "patch", "patch", sample).Reads(patch))
+		}
+	}
 
 	for _, route := range routes {
 		b.ws.Route(route)
@@ -237,6 +245,9 @@ type builder struct {
 	listSchema *spec.Schema
 	ws         *restful.WebService
 
+	// definitions holds the schema definitions of the CRD by canonical type name.
+	definitions map[string]spec.Schema
+
 	group    string
 	version  string
 	kind     string
@@ -401,9 +412,19 @@ func (b *builder) buildKubeNative(schema *structuralschema.Structural, opts Opti
 		}
 
 		ret = schema.ToKubeOpenAPI()
-		ret.SetProperty("metadata", *spec.RefSchema(refForOpenAPIVersion(objectMetaSchemaRef, opts.V2)).WithDescription(swaggerPartialObjectMetadataDescriptions["metadata"]))
+		addDeprecationNotices(ret)
+		for name, def := range ret.Definitions {
+			addDeprecationNotices(&def)
+			ret.Definitions[name] = def
+		}
+		b.hoistDefinitions(ret, opts.V2)
+		ret.SetProperty("metadata", objectMetaSchema(ret, opts))
 		addTypeMetaProperties(ret, opts.V2)
 		addEmbeddedProperties(ret, opts)
+		for name, def := range b.definitions {
+			addEmbeddedProperties(&def, opts)
+			b.definitions[name] = def
+		}
 	}
 	ret.AddExtension(endpoints.ROUTE_META_GVK, []interface{}{
 		map[string]interface{}{
@@ -416,6 +437,75 @@ func (b *builder) buildKubeNative(schema *structuralschema.Structural, opts Opti
 	return ret
 }
 
+// hoistDefinitions moves the local schema definitions of s into top-level definitions named
+// after the kind, and rewrites the references accordingly.
+func (b *builder) hoistDefinitions(s *spec.Schema, v2 bool) {
+	if len(s.Definitions) == 0 {
+		return
+	}
+
+	b.definitions = make(map[string]spec.Schema, len(s.Definitions))
+	for name, def := range s.Definitions {
+		b.rewriteDefinitionRefs(&def, v2)
+		b.definitions[b.definitionName(name)] = def
+	}
+	s.Definitions = nil
+	b.rewriteDefinitionRefs(s, v2)
+}
+
+// definitionName returns the canonical type name of the given local schema definition.
+func (b *builder) definitionName(name string) string {
+	return fmt.Sprintf("%s/%s.%s.%s", b.group, b.version, b.kind, name)
+}
+
+func (b *builder) rewriteDefinitionRefs(s *spec.Schema, v2 bool) {
+	if s == nil {
+		return
+	}
+
+	if ref := s.Ref.String(); strings.HasPrefix(ref, structuralschema.DefinitionRefPrefix) {
+		name := strings.TrimPrefix(ref, structuralschema.DefinitionRefPrefix)
+		restFriendlyName := util.ToRESTFriendlyName(fmt.Sprintf("%s/%s/%s.%s", b.group, b.version, b.kind, name))
+		s.Ref = spec.MustCreateRef(refForOpenAPIVersion(definitionPrefix+restFriendlyName, v2))
+		return
+	}
+
+	for k := range s.Properties {
+		v := s.Properties[k]
+		b.rewriteDefinitionRefs(&v, v2)
+		s.Properties[k] = v
+	}
+	if s.Items != nil {
+		b.rewriteDefinitionRefs(s.Items.Schema, v2)
+	}
+	if s.AdditionalProperties != nil {
+		b.rewriteDefinitionRefs(s.AdditionalProperties.Schema, v2)
+	}
+}
+
+// addDeprecationNotices prefixes the description of the fields marked with x-kubernetes-deprecated
+// with their deprecation message, such that it shows up in kubectl explain.
+func addDeprecationNotices(s *spec.Schema) {
+	if s == nil {
+		return
+	}
+
+	if msg, ok := s.Extensions.GetString("x-kubernetes-deprecated"); ok {
+		s.Description = strings.TrimSpace(fmt.Sprintf("DEPRECATED: %s %s", msg, s.Description))
+	}
+	for k := range s.Properties {
+		v := s.Properties[k]
+		addDeprecationNotices(&v)
+		s.Properties[k] = v
+	}
+	if s.Items != nil {
+		addDeprecationNotices(s.Items.Schema)
+	}
+	if s.AdditionalProperties != nil {
+		addDeprecationNotices(s.AdditionalProperties.Schema)
+	}
+}
+
 func addEmbeddedProperties(s *spec.Schema, opts Options) {
 	if s == nil {
 		return
@@ -445,7 +535,7 @@ func addEmbeddedProperties(s *spec.Schema, opts Options) {
 		s.SetProperty("kind", withDescription(getDefinition(typeMetaType, opts.V2).SchemaProps.Properties["kind"],
 			"kind is a string value representing the type of this object. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 		))
-		s.SetProperty("metadata", *spec.RefSchema(refForOpenAPIVersion(objectMetaSchemaRef, opts.V2)).WithDescription(swaggerPartialObjectMetadataDescriptions["metadata"]))
+		s.SetProperty("metadata", objectMetaSchema(s, opts))
 
 		req := sets.NewString(s.Required...)
 		if !req.Has("kind") {
@@ -457,6 +547,34 @@ func addEmbeddedProperties(s *spec.Schema, opts Options) {
 	}
 }
 
+// objectMetaSchema returns the metadata schema of the resource with schema s, i.e. a reference to ObjectMeta.
+// In OpenAPI v3, the labels and annotations schemas specified in s are published alongside. OpenAPI v2 only
+// gets the reference because kubectl does not understand allOf around references.
+func objectMetaSchema(s *spec.Schema, opts Options) spec.Schema {
+	ref := spec.RefSchema(refForOpenAPIVersion(objectMetaSchemaRef, opts.V2))
+	if opts.V2 {
+		return *ref.WithDescription(swaggerPartialObjectMetadataDescriptions["metadata"])
+	}
+
+	metadata := s.Properties["metadata"]
+	props := map[string]spec.Schema{}
+	for _, k := range []string{"labels", "annotations"} {
+		if prop, ok := metadata.Properties[k]; ok {
+			props[k] = prop
+		}
+	}
+	if len(props) == 0 {
+		return *ref.WithDescription(swaggerPartialObjectMetadataDescriptions["metadata"])
+	}
+	return spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Description: swaggerPartialObjectMetadataDescriptions["metadata"],
+			AllOf:       []spec.Schema{*ref},
+			Properties:  props,
+		},
+	}
+}
+
 // getDefinition gets definition for given Kubernetes type. This function is extracted from
 // kube-openapi builder logic
 func getDefinition(name string, v2 bool) spec.Schema {
@@ -533,9 +651,21 @@ func (b *builder) getOpenAPIConfig(v2 bool) *common.Config {
 		},
 		GetDefinitions: func(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 			def := utilopenapi.GetOpenAPIDefinitionsWithoutDisabledFeatures(generatedopenapi.GetOpenAPIDefinitions)(ref)
+			dependencies := []string{objectMetaType}
+			for name := range b.definitions {
+				dependencies = append(dependencies, name)
+			}
+			sort.Strings(dependencies[1:])
 			def[fmt.Sprintf("%s/%s.%s", b.group, b.version, b.kind)] = common.OpenAPIDefinition{
 				Schema:       *b.schema,
-				Dependencies: []string{objectMetaType},
+				Dependencies: dependencies,
+			}
+			for name, schema := range b.definitions {
+				// definitions can embed resources, referencing ObjectMeta, and reference each other
+				def[name] = common.OpenAPIDefinition{
+					Schema:       schema,
+					Dependencies: dependencies,
+				}
 			}
 			def[fmt.Sprintf("%s/%s.%s", b.group, b.version, b.listKind)] = common.OpenAPIDefinition{
 				Schema: *b.listSchema,
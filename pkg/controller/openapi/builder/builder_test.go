@@ -18,6 +18,7 @@ package builder
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -632,6 +633,13 @@ func TestBuildOpenAPIV2(t *testing.T) {
 			`{"type":"object","properties":{"apiVersion":{"type":"string"},"kind":{"type":"string"},"metadata":{"$ref":"#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},"foo":{"type":"string","oneOf":[{"pattern":"a"},{"pattern":"b"}]}},"x-kubernetes-group-version-kind":[{"group":"bar.k8s.io","kind":"Foo","version":"v1"}]}`,
 			Options{V2: true, SkipFilterSchemaForKubectlOpenAPIV2Validation: true},
 		},
+		{
+			"with definitions",
+			`{"type":"object","properties":{"spec":{"$ref":"#/definitions/node"}},"definitions":{"node":{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/node"}}}}}}`,
+			nil,
+			`{"type":"object","properties":{"apiVersion":{"type":"string"},"kind":{"type":"string"},"metadata":{"$ref":"#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},"spec":{"$ref":"#/definitions/io.k8s.bar.v1.Foo.node"}},"x-kubernetes-group-version-kind":[{"group":"bar.k8s.io","kind":"Foo","version":"v1"}]}`,
+			Options{V2: true},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -678,6 +686,18 @@ func TestBuildOpenAPIV2(t *testing.T) {
 			}
 
 			gotSchema := got.Definitions["io.k8s.bar.v1.Foo"]
+			for name, def := range got.Definitions {
+				if ref := def.Ref.String(); len(ref) > 0 {
+					t.Errorf("unexpected $ref %q as definition %q", ref, name)
+				}
+			}
+			for _, prop := range gotSchema.Properties {
+				if ref := prop.Ref.String(); len(ref) > 0 {
+					if _, found := got.Definitions[strings.TrimPrefix(ref, "#/definitions/")]; !found {
+						t.Errorf("unresolvable $ref %q", ref)
+					}
+				}
+			}
 			gotProperties := properties(gotSchema.Properties)
 			wantedProperties := properties(wantedSchema.Properties)
 			if !gotProperties.Equal(wantedProperties) {
@@ -736,6 +756,20 @@ func TestBuildOpenAPIV3(t *testing.T) {
 			`{"type":"object","properties":{"apiVersion":{"type":"string"},"kind":{"type":"string"},"metadata":{"$ref":"#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},"spec":{"type":"object","properties":{"field":{"type":"string","default":"foo"}}},"status":{"type":"object"}},"x-kubernetes-group-version-kind":[{"group":"bar.k8s.io","kind":"Foo","version":"v1"}]}`,
 			Options{},
 		},
+		{
+			"with labels schema",
+			`{"type":"object","properties":{"metadata":{"type":"object","properties":{"labels":{"type":"object","required":["team"],"additionalProperties":{"type":"string","maxLength":63}}}}}}`,
+			nil,
+			`{"type":"object","properties":{"apiVersion":{"type":"string"},"kind":{"type":"string"},"metadata":{"allOf":[{"$ref":"#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}],"properties":{"labels":{"type":"object","required":["team"],"additionalProperties":{"type":"string","maxLength":63}}}}},"x-kubernetes-group-version-kind":[{"group":"bar.k8s.io","kind":"Foo","version":"v1"}]}`,
+			Options{},
+		},
+		{
+			"with deprecated field",
+			`{"type":"object","properties":{"spec":{"type":"object","properties":{"old":{"type":"string","description":"Old field.","x-kubernetes-deprecated":"use new instead"}}}}}`,
+			nil,
+			`{"type":"object","properties":{"apiVersion":{"type":"string"},"kind":{"type":"string"},"metadata":{"$ref":"#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},"spec":{"type":"object","properties":{"old":{"type":"string","description":"DEPRECATED: use new instead Old field.","x-kubernetes-deprecated":"use new instead"}}}},"x-kubernetes-group-version-kind":[{"group":"bar.k8s.io","kind":"Foo","version":"v1"}]}`,
+			Options{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -50,6 +50,17 @@ func ToStructuralOpenAPIV2(in *structuralschema.Structural) *structuralschema.St
 					s.ValueValidation.Not = nil
 					changed = true
 				}
+				if s.ValueValidation.Const != nil || s.ValueValidation.If != nil || s.ValueValidation.Then != nil || s.ValueValidation.Else != nil ||
+					s.ValueValidation.DependentRequired != nil || s.ValueValidation.PropertyNames != nil {
+					// not supported by OpenAPI v2
+					s.ValueValidation.Const = nil
+					s.ValueValidation.If = nil
+					s.ValueValidation.Then = nil
+					s.ValueValidation.Else = nil
+					s.ValueValidation.DependentRequired = nil
+					s.ValueValidation.PropertyNames = nil
+					changed = true
+				}
 			}
 
 			// https://github.com/kubernetes/kube-openapi/pull/143/files#diff-ce77fea74b9dd098045004410023e0c3R219
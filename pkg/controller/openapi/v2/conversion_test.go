@@ -446,6 +446,31 @@ func Test_ConvertJSONSchemaPropsToOpenAPIv2SchemaByType(t *testing.T) {
 			// 	},
 			// },
 		},
+		{
+			name: "const, if, then, else, dependentRequired and propertyNames",
+			in: &apiextensions.JSONSchemaProps{
+				Type:  "object",
+				Const: &testApiextensionsJSON,
+				If: &apiextensions.JSONSchemaProps{
+					Required: []string{"foo"},
+				},
+				Then: &apiextensions.JSONSchemaProps{
+					Required: []string{"bar"},
+				},
+				Else: &apiextensions.JSONSchemaProps{
+					Required: []string{"baz"},
+				},
+				DependentRequired: apiextensions.JSONSchemaDependentRequired{
+					"foo": {"bar"},
+				},
+				PropertyNames: &apiextensions.JSONSchemaProps{
+					Pattern: "^[a-z]+$",
+				},
+			},
+			// not supported by openapi v2
+			expected: new(spec.Schema).
+				Typed("object", ""),
+		},
 		{
 			name: "nested logic",
 			in: &apiextensions.JSONSchemaProps{
@@ -622,6 +647,22 @@ func Test_ConvertJSONSchemaPropsToOpenAPIv2SchemaByType(t *testing.T) {
 			},
 			expectError: true, // rejected by kube validation and NewStructural
 		},
+		{
+			name: "referenced definitions",
+			in: &apiextensions.JSONSchemaProps{
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					testStr: {Ref: pointer.StringPtr("#/definitions/" + testStr)},
+				},
+				Definitions: apiextensions.JSONSchemaDefinitions{
+					testStr: apiextensions.JSONSchemaProps{Type: "boolean"},
+				},
+			},
+			expected: func() *spec.Schema {
+				s := new(spec.Schema).SetProperty(testStr, *spec.RefSchema("#/definitions/" + testStr))
+				s.Definitions = spec.Definitions{testStr: *spec.BooleanProperty()}
+				return s
+			}(),
+		},
 		{
 			name: "externalDocs",
 			in: &apiextensions.JSONSchemaProps{
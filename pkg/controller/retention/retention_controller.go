@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	informers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions/apiextensions/v1"
+	listers "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// purgeInterval is how often expired deleted custom resources are purged.
+const purgeInterval = time.Minute
+
+// Purger removes retained deleted custom resources.
+type Purger interface {
+	// Purge removes the custom resources deleted before the given time, and returns how many were removed.
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+// TrashGetter knows how to get the Purger of the retained deleted custom resources of a CRD.
+type TrashGetter interface {
+	// GetCustomResourceTrash returns the Purger of the retained deleted custom resources of the given CRD.
+	GetCustomResourceTrash(crd *apiextensionsv1.CustomResourceDefinition) (Purger, error)
+}
+
+// RetentionController purges deleted custom resources which were retained longer than the TTL of
+// their CRD. In HA setups every server purges, which is harmless.
+type RetentionController struct {
+	crdLister listers.CustomResourceDefinitionLister
+	crdSynced cache.InformerSynced
+
+	trashGetter TrashGetter
+
+	// To allow injection for testing.
+	now func() time.Time
+}
+
+// NewRetentionController creates a new RetentionController.
+func NewRetentionController(crdInformer informers.CustomResourceDefinitionInformer, trashGetter TrashGetter) *RetentionController {
+	return &RetentionController{
+		crdLister:   crdInformer.Lister(),
+		crdSynced:   crdInformer.Informer().HasSynced,
+		trashGetter: trashGetter,
+		now:         time.Now,
+	}
+}
+
+// Run purges expired deleted custom resources periodically until stopCh is closed.
+func (c *RetentionController) Run(stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+
+	klog.Info("Starting RetentionController")
+	defer klog.Info("Shutting down RetentionController")
+
+	if !cache.WaitForCacheSync(stopCh, c.crdSynced) {
+		return
+	}
+
+	wait.Until(c.purge, purgeInterval, stopCh)
+}
+
+// purge purges the expired deleted custom resources of all established CRDs with retention.
+func (c *RetentionController) purge() {
+	crds, err := c.crdLister.List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	ctx := genericapirequest.NewContext()
+	for _, crd := range crds {
+		if crd.Spec.Retention == nil ||
+			!apihelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established) ||
+			apihelpers.IsCRDConditionTrue(crd, apiextensionsv1.Terminating) {
+			continue
+		}
+		trash, err := c.trashGetter.GetCustomResourceTrash(crd)
+		if err != nil {
+			// the storage of the CRD is being re-created for a changed spec
+			klog.V(4).Infof("Skipping purge of deleted %s: %v", crd.Name, err)
+			continue
+		}
+		deletedBefore := c.now().Add(-time.Duration(crd.Spec.Retention.TTLSeconds) * time.Second)
+		purged, err := trash.Purge(ctx, deletedBefore)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to purge deleted %s: %v", crd.Name, err))
+		}
+		if purged > 0 {
+			klog.V(2).Infof("Purged %d expired deleted %s", purged, crd.Name)
+		}
+	}
+}
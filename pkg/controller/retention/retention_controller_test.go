@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	listers "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakePurger struct {
+	deletedBefore []time.Time
+}
+
+func (p *fakePurger) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	p.deletedBefore = append(p.deletedBefore, deletedBefore)
+	return 1, nil
+}
+
+type fakeTrashGetter map[string]*fakePurger
+
+func (g fakeTrashGetter) GetCustomResourceTrash(crd *apiextensionsv1.CustomResourceDefinition) (Purger, error) {
+	p, ok := g[crd.Name]
+	if !ok {
+		return nil, fmt.Errorf("no trash for %s", crd.Name)
+	}
+	return p, nil
+}
+
+func newCRD(name string, retention *apiextensionsv1.CustomResourceRetention, conditions ...apiextensionsv1.CustomResourceDefinitionConditionType) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Retention: retention},
+	}
+	for _, c := range conditions {
+		crd.Status.Conditions = append(crd.Status.Conditions, apiextensionsv1.CustomResourceDefinitionCondition{Type: c, Status: apiextensionsv1.ConditionTrue})
+	}
+	return crd
+}
+
+func TestPurge(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	hour := &apiextensionsv1.CustomResourceRetention{TTLSeconds: 3600}
+
+	crdIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, crd := range []*apiextensionsv1.CustomResourceDefinition{
+		newCRD("retained.example.com", hour, apiextensionsv1.Established),
+		newCRD("unretained.example.com", nil, apiextensionsv1.Established),
+		newCRD("notestablished.example.com", hour),
+		newCRD("terminating.example.com", hour, apiextensionsv1.Established, apiextensionsv1.Terminating),
+		newCRD("nostorage.example.com", hour, apiextensionsv1.Established),
+	} {
+		crdIndexer.Add(crd)
+	}
+	getter := fakeTrashGetter{
+		"retained.example.com":       &fakePurger{},
+		"unretained.example.com":     &fakePurger{},
+		"notestablished.example.com": &fakePurger{},
+		"terminating.example.com":    &fakePurger{},
+	}
+
+	c := &RetentionController{
+		crdLister:   listers.NewCustomResourceDefinitionLister(crdIndexer),
+		trashGetter: getter,
+		now:         func() time.Time { return now },
+	}
+	c.purge()
+
+	for name, p := range getter {
+		if name == "retained.example.com" {
+			if len(p.deletedBefore) != 1 || !p.deletedBefore[0].Equal(now.Add(-time.Hour)) {
+				t.Errorf("expected one purge of %s of objects deleted before %v, got %v", name, now.Add(-time.Hour), p.deletedBefore)
+			}
+		} else if len(p.deletedBefore) != 0 {
+			t.Errorf("unexpected purge of %s", name)
+		}
+	}
+}
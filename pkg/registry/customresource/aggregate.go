@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// AggregateByNamespace groups custom resources by namespace.
+	AggregateByNamespace = "namespace"
+	// AggregateByLabelPrefix followed by a label key groups custom resources by the value of the label.
+	AggregateByLabelPrefix = "label:"
+)
+
+// AggregateResult counts the custom resources matching a list request by group.
+type AggregateResult struct {
+	// GroupBy lists the keys the custom resources are grouped by.
+	GroupBy []string `json:"groupBy"`
+	// Groups lists the groups ordered by their values, like sorted lists.
+	Groups []AggregateGroup `json:"groups"`
+	// Total is the number of custom resources matching the list request.
+	Total int64 `json:"total"`
+	// ResourceVersion is the resourceVersion the custom resources were counted at.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// AggregateGroup counts the custom resources with the same values.
+type AggregateGroup struct {
+	// Values holds the value of each key of groupBy, or null for custom resources without it.
+	Values []interface{} `json:"values"`
+	// Count is the number of custom resources in the group.
+	Count int64 `json:"count"`
+}
+
+// Aggregate counts the custom resources matching the label and field selectors of options in the
+// namespace of ctx, grouped by the values of the keys of groupBy. A key is `namespace`, `label:`
+// followed by a label key, or the path of a printer column. Like in lists, the custom resources are
+// filtered by the CEL selector of ctx if any. They are read from the watch cache unless options has a
+// resourceVersion.
+func (e *REST) Aggregate(ctx context.Context, options *metainternalversion.ListOptions, groupBy []string) (*AggregateResult, error) {
+	selector, err := e.celSelectors.fromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupBy) == 0 {
+		return nil, apierrors.NewBadRequest("at least one key to group by is required")
+	}
+	if len(options.Continue) > 0 || options.Limit > 0 {
+		return nil, apierrors.NewBadRequest("aggregate list requests cannot be paged")
+	}
+	values := make([]func(u *unstructured.Unstructured) interface{}, 0, len(groupBy))
+	for _, key := range groupBy {
+		switch {
+		case key == AggregateByNamespace:
+			if !e.NamespaceScoped() {
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("cannot group by %s: the resource is cluster-scoped", key))
+			}
+			values = append(values, func(u *unstructured.Unstructured) interface{} {
+				return u.GetNamespace()
+			})
+		case strings.HasPrefix(key, AggregateByLabelPrefix):
+			label := strings.TrimPrefix(key, AggregateByLabelPrefix)
+			if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("cannot group by %s: %s", key, strings.Join(errs, "; ")))
+			}
+			values = append(values, func(u *unstructured.Unstructured) interface{} {
+				if value, ok := u.GetLabels()[label]; ok {
+					return value
+				}
+				return nil
+			})
+		default:
+			fields, ok := e.columnPaths[key]
+			if !ok {
+				return nil, e.unknownColumnPath("group", key)
+			}
+			values = append(values, func(u *unstructured.Unstructured) interface{} {
+				value, _, _ := unstructured.NestedFieldNoCopy(u.Object, fields...)
+				return value
+			})
+		}
+	}
+
+	listOptions := options.DeepCopy()
+	if len(listOptions.ResourceVersion) == 0 {
+		// all custom resources are read to be counted, so they are read from the watch cache
+		listOptions.ResourceVersion = "0"
+		listOptions.ResourceVersionMatch = ""
+	}
+	l, err := e.Store.List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	ul, ok := l.(*unstructured.UnstructuredList)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("unexpected list type %T", l))
+	}
+	if selector != nil {
+		if err := filterCELSelector(selector, ul); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &AggregateResult{GroupBy: groupBy, Groups: []AggregateGroup{}, ResourceVersion: ul.GetResourceVersion()}
+	groups := map[string]int{}
+	for i := range ul.Items {
+		groupValues := make([]interface{}, len(values))
+		for j, value := range values {
+			groupValues[j] = value(&ul.Items[i])
+		}
+		encoded, err := json.Marshal(groupValues)
+		if err != nil {
+			return nil, apierrors.NewInternalError(err)
+		}
+		index, ok := groups[string(encoded)]
+		if !ok {
+			index = len(result.Groups)
+			groups[string(encoded)] = index
+			result.Groups = append(result.Groups, AggregateGroup{Values: groupValues})
+		}
+		result.Groups[index].Count++
+		result.Total++
+	}
+	sort.Slice(result.Groups, func(i, j int) bool {
+		for k := range groupBy {
+			if c := compareSortKeys(result.Groups[i].Values[k], result.Groups[j].Values[k], false); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return result, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource_test
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+func TestAggregate(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.CustomResource.Store.DestroyFunc()
+
+	for _, cr := range []struct {
+		name     string
+		replicas int64
+		tier     string
+	}{
+		{"a", 1, "web"},
+		{"b", 2, "web"},
+		{"c", 1, "db"},
+		{"d", 1, ""},
+	} {
+		obj := validNewCustomResource()
+		obj.SetName(cr.name)
+		unstructured.SetNestedField(obj.Object, cr.replicas, "spec", "replicas")
+		if len(cr.tier) > 0 {
+			obj.SetLabels(map[string]string{"tier": cr.tier, "app": "x"})
+		}
+		if _, err := createCustomResource(storage.CustomResource, *obj, t); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault)
+	result, err := storage.CustomResource.Aggregate(ctx, &metainternalversion.ListOptions{}, []string{"label:tier", ".spec.replicas"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []customresource.AggregateGroup{
+		{Values: []interface{}{"db", int64(1)}, Count: 1},
+		{Values: []interface{}{"web", int64(1)}, Count: 1},
+		{Values: []interface{}{"web", int64(2)}, Count: 1},
+		{Values: []interface{}{nil, int64(1)}, Count: 1},
+	}
+	if result.Total != 4 || !reflect.DeepEqual(result.Groups, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{"app": "x"})
+	result, err = storage.CustomResource.Aggregate(ctx, &metainternalversion.ListOptions{LabelSelector: selector}, []string{"namespace"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []customresource.AggregateGroup{{Values: []interface{}{metav1.NamespaceDefault}, Count: 3}}
+	if result.Total != 3 || !reflect.DeepEqual(result.Groups, expected) {
+		t.Errorf("expected %v for the selected objects, got %v", expected, result)
+	}
+
+	for _, groupBy := range [][]string{nil, {".spec.stringList"}, {"label:-invalid"}} {
+		if _, err := storage.CustomResource.Aggregate(ctx, &metainternalversion.ListOptions{}, groupBy); !apierrors.IsBadRequest(err) {
+			t.Errorf("expected BadRequest grouping by %v, got %v", groupBy, err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/watch"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+const (
+	// celSelectorCacheSize is the number of compiled CEL selectors cached for each version.
+	celSelectorCacheSize = 100
+	// celSelectorCacheTTL is how long a compiled CEL selector is cached after its last compilation.
+	celSelectorCacheTTL = 10 * time.Minute
+	// celSelectorTimeout bounds the evaluation of a CEL selector against one custom resource.
+	celSelectorTimeout = 10 * time.Millisecond
+)
+
+type celSelectorKeyType int
+
+const celSelectorKey celSelectorKeyType = iota
+
+// WithCELSelector returns a copy of ctx in which the custom resources listed or watched through REST
+// are filtered by a CEL expression over the custom resource, `self`, evaluating to a bool.
+func WithCELSelector(ctx context.Context, expression string) context.Context {
+	return context.WithValue(ctx, celSelectorKey, expression)
+}
+
+// celSelectors compiles the CEL selectors over the custom resources of one version, and caches the
+// compiled selectors by expression.
+type celSelectors struct {
+	structural *structuralschema.Structural
+	formats    *apiservervalidation.FormatRegistry
+	cache      *utilcache.LRUExpireCache
+}
+
+func newCELSelectors(structural *structuralschema.Structural, formats *apiservervalidation.FormatRegistry) *celSelectors {
+	return &celSelectors{
+		structural: structural,
+		formats:    formats,
+		cache:      utilcache.NewLRUExpireCache(celSelectorCacheSize),
+	}
+}
+
+// fromContext returns the compiled CEL selector of ctx, or nil if ctx has none.
+func (c *celSelectors) fromContext(ctx context.Context) (*cel.Selector, error) {
+	expression, ok := ctx.Value(celSelectorKey).(string)
+	if !ok {
+		return nil, nil
+	}
+	if selector, ok := c.cache.Get(expression); ok {
+		return selector.(*cel.Selector), nil
+	}
+	if c.structural == nil {
+		return nil, apierrors.NewBadRequest("invalid celSelector: the version has no structural schema")
+	}
+	selector, err := cel.CompileSelector(c.structural, expression, c.formats)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid celSelector: %v", err))
+	}
+	c.cache.Add(expression, selector, celSelectorCacheTTL)
+	return selector, nil
+}
+
+// matchesCELSelector evaluates selector against u within the time limit of one evaluation.
+func matchesCELSelector(selector *cel.Selector, u *unstructured.Unstructured) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), celSelectorTimeout)
+	defer cancel()
+	matches, err := selector.Matches(ctx, u.Object)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return false, apierrors.NewBadRequest(fmt.Sprintf("celSelector exceeded its evaluation time limit of %v", celSelectorTimeout))
+	}
+	return matches, err
+}
+
+// filterCELSelector removes the custom resources which do not match selector from ul.
+func filterCELSelector(selector *cel.Selector, ul *unstructured.UnstructuredList) error {
+	items := ul.Items[:0]
+	for i := range ul.Items {
+		matches, err := matchesCELSelector(selector, &ul.Items[i])
+		if err != nil {
+			return err
+		}
+		if matches {
+			items = append(items, ul.Items[i])
+		}
+	}
+	ul.Items = items
+	return nil
+}
+
+// Watch watches the custom resources matching options. If ctx has a CEL selector, only events of
+// custom resources matching it are sent: custom resources starting to match are added, and those
+// which stop matching are deleted, like with label selectors.
+func (e *REST) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	selector, err := e.celSelectors.fromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if selector == nil {
+		return e.Store.Watch(ctx, options)
+	}
+
+	// selected holds the namespace and name of the custom resources the client knows to match
+	selected := map[string]bool{}
+	if rv := options.ResourceVersion; len(rv) > 0 && rv != "0" {
+		// the watch does not start with the existing custom resources, which the client listed
+		// before, so they are listed again at the resourceVersion the watch resumes from to know
+		// which of them match. Later versions would miss the deletions of the custom resources
+		// which stop matching in between.
+		l, err := e.Store.List(ctx, &metainternalversion.ListOptions{
+			LabelSelector:        options.LabelSelector,
+			FieldSelector:        options.FieldSelector,
+			ResourceVersion:      rv,
+			ResourceVersionMatch: metav1.ResourceVersionMatchExact,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ul, ok := l.(*unstructured.UnstructuredList); ok {
+			if err := filterCELSelector(selector, ul); err != nil {
+				return nil, err
+			}
+			for i := range ul.Items {
+				selected[ul.Items[i].GetNamespace()+"/"+ul.Items[i].GetName()] = true
+			}
+		}
+	}
+
+	w, err := e.Store.Watch(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	// the filter is called sequentially, so it owns selected
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		u, ok := in.Object.(*unstructured.Unstructured)
+		if !ok || (in.Type != watch.Added && in.Type != watch.Modified && in.Type != watch.Deleted) {
+			return in, true
+		}
+		key := u.GetNamespace() + "/" + u.GetName()
+		if in.Type == watch.Deleted {
+			if !selected[key] {
+				return in, false
+			}
+			delete(selected, key)
+			return in, true
+		}
+
+		matches, err := matchesCELSelector(selector, u)
+		if err != nil {
+			status := apierrors.NewInternalError(err).Status()
+			if statusErr, ok := err.(*apierrors.StatusError); ok {
+				status = statusErr.Status()
+			}
+			return watch.Event{Type: watch.Error, Object: &status}, true
+		}
+		switch {
+		case matches && selected[key]:
+			in.Type = watch.Modified
+		case matches:
+			selected[key] = true
+			in.Type = watch.Added
+		case selected[key]:
+			delete(selected, key)
+			in.Type = watch.Deleted
+		default:
+			return in, false
+		}
+		return in, true
+	}), nil
+}
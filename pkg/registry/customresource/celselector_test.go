@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource_test
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+func TestCELSelectorWithoutStructuralSchema(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.CustomResource.Store.DestroyFunc()
+
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault)
+	ctx = customresource.WithCELSelector(ctx, "self.spec.replicas > 1")
+	if _, err := storage.CustomResource.List(ctx, &metainternalversion.ListOptions{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected BadRequest listing with a celSelector without structural schema, got %v", err)
+	}
+	if _, err := storage.CustomResource.Watch(ctx, &metainternalversion.ListOptions{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected BadRequest watching with a celSelector without structural schema, got %v", err)
+	}
+	if _, err := storage.CustomResource.Aggregate(ctx, &metainternalversion.ListOptions{}, []string{customresource.AggregateByNamespace}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected BadRequest aggregating with a celSelector without structural schema, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+// customSubresourceStrategy implements the update behavior of a custom subresource: only the value
+// at the JSON path of the subresource can be changed.
+type customSubresourceStrategy struct {
+	customResourceStrategy
+	subresource apiextensions.CustomResourceSubresourceCustom
+	path        []string
+}
+
+func NewCustomSubresourceStrategy(strategy customResourceStrategy, subresource apiextensions.CustomResourceSubresourceCustom) customSubresourceStrategy {
+	return customSubresourceStrategy{
+		customResourceStrategy: strategy,
+		subresource:            subresource,
+		path:                   strings.Split(strings.TrimPrefix(subresource.JSONPath, "."), "."),
+	}
+}
+
+// GetResetFields returns the set of fields that get reset by the strategy
+// and should not be modified by the user.
+func (a customSubresourceStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	// Like for the status subresource, only top level fields are reset. Other fields
+	// next to the JSON path are also removed by PrepareForUpdate, but are not
+	// part of the reset fields.
+	var paths []fieldpath.Path
+	for _, name := range []string{"spec", "status"} {
+		if name != a.path[0] {
+			paths = append(paths, fieldpath.MakePathOrDie(name))
+		}
+	}
+
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		fieldpath.APIVersion(a.customResourceStrategy.kind.GroupVersion().String()): fieldpath.NewSet(paths...),
+	}
+}
+
+// PrepareForUpdate keeps only the value at the JSON path of the subresource from obj, and
+// everything else from old.
+func (a customSubresourceStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newCustomResourceObject := obj.(*unstructured.Unstructured)
+	oldCustomResourceObject := old.(*unstructured.Unstructured)
+	value, found, _ := unstructured.NestedFieldNoCopy(newCustomResourceObject.Object, a.path...)
+
+	// managedFields must be preserved since it's been modified to
+	// track changed fields in the subresource update.
+	managedFields := newCustomResourceObject.GetManagedFields()
+
+	// copy old object into new object
+	// overridding the resourceVersion in metadata is safe here, we have already checked that
+	// new object and old object have the same resourceVersion.
+	*newCustomResourceObject = *oldCustomResourceObject.DeepCopy()
+
+	// set the value at the JSON path
+	newCustomResourceObject.SetManagedFields(managedFields)
+	if found {
+		// intermediate values which are not objects are replaced by objects. Validation reports
+		// errors about them.
+		setNestedField(newCustomResourceObject.Object, value, a.path)
+	} else {
+		unstructured.RemoveNestedField(newCustomResourceObject.Object, a.path...)
+	}
+
+	a.pruneAndDefaultEmbeddedResources(obj)
+	a.setConditionTransitionTimes(old, obj)
+
+	// changes at the JSON path increment the generation like changes through the main
+	// resource, i.e. changes to status do not if the status subresource is enabled.
+	oldContent, newContent := oldCustomResourceObject.Object, newCustomResourceObject.Object
+	if a.status != nil {
+		oldContent = withoutField(oldContent, []string{"status"})
+		newContent = withoutField(newContent, []string{"status"})
+	}
+	if a.incrementsGeneration(oldContent, newContent) {
+		newCustomResourceObject.SetGeneration(oldCustomResourceObject.GetGeneration() + 1)
+	}
+}
+
+// setNestedField sets the value at path in obj, creating or replacing intermediate objects.
+func setNestedField(obj map[string]interface{}, value interface{}, path []string) {
+	m := obj
+	for _, field := range path[:len(path)-1] {
+		child, ok := m[field].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			m[field] = child
+		}
+		m = child
+	}
+	m[path[len(path)-1]] = value
+}
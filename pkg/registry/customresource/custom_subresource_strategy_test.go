@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+func TestCustomSubresourcePrepareForUpdate(t *testing.T) {
+	tcs := []struct {
+		name          string
+		jsonPath      string
+		statusEnabled bool
+		old           *unstructured.Unstructured
+		obj           *unstructured.Unstructured
+		expected      *unstructured.Unstructured
+	}{
+		{
+			name:     "changes outside of the path are ignored, changes at the path increment generation",
+			jsonPath: ".spec.approval",
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "pending", "replicas": int64(1)},
+					"status":   "old",
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "approved", "replicas": int64(2)},
+					"status":   "new",
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation2(),
+					"spec":     map[string]interface{}{"approval": "approved", "replicas": int64(1)},
+					"status":   "old",
+				},
+			},
+		},
+		{
+			name:     "unchanged value does not increment generation",
+			jsonPath: ".spec.approval",
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "pending", "replicas": int64(1)},
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "pending", "replicas": int64(2)},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "pending", "replicas": int64(1)},
+				},
+			},
+		},
+		{
+			name:     "value is removed",
+			jsonPath: ".spec.approval",
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"approval": "pending", "replicas": int64(1)},
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation2(),
+					"spec":     map[string]interface{}{"replicas": int64(1)},
+				},
+			},
+		},
+		{
+			name:     "value is set in a missing parent",
+			jsonPath: ".spec.config.mode",
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"config": map[string]interface{}{"mode": "fast"}},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation2(),
+					"spec":     map[string]interface{}{"config": map[string]interface{}{"mode": "fast"}},
+				},
+			},
+		},
+		{
+			name:          "/status is enabled, changes to status do not increment generation",
+			jsonPath:      ".status.approval",
+			statusEnabled: true,
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"status":   map[string]interface{}{"approval": "pending"},
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"status":   map[string]interface{}{"approval": "approved"},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"status":   map[string]interface{}{"approval": "approved"},
+				},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy := customResourceStrategy{}
+			if tc.statusEnabled {
+				strategy.status = &apiextensions.CustomResourceSubresourceStatus{}
+			}
+			customStrategy := NewCustomSubresourceStrategy(strategy, apiextensions.CustomResourceSubresourceCustom{Name: "custom", JSONPath: tc.jsonPath})
+			customStrategy.PrepareForUpdate(context.TODO(), tc.obj, tc.old)
+			if !reflect.DeepEqual(tc.obj, tc.expected) {
+				t.Errorf("expected: %v, got %v", tc.expected, tc.obj)
+			}
+		})
+	}
+}
+
+func TestCustomSubresourceGetResetFields(t *testing.T) {
+	strategy := customResourceStrategy{kind: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Foo"}}
+	customStrategy := NewCustomSubresourceStrategy(strategy, apiextensions.CustomResourceSubresourceCustom{Name: "approval", JSONPath: ".spec.approval"})
+
+	expected := fieldpath.NewSet(fieldpath.MakePathOrDie("status"))
+	got := customStrategy.GetResetFields()[fieldpath.APIVersion("example.com/v1")]
+	if got == nil || !got.Equals(expected) {
+		t.Errorf("expected reset fields %v, got %v", expected, got)
+	}
+}
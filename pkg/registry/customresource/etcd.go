@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -28,27 +29,50 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apiserver/pkg/endpoints/handlers/fieldmanager"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 )
 
-// CustomResourceStorage includes dummy storage for CustomResources, and their Status and Scale subresources.
+// CustomResourceStorage includes dummy storage for CustomResources, and their Status, Scale, Lint, custom,
+// trash and history subresources.
 type CustomResourceStorage struct {
 	CustomResource *REST
 	Status         *StatusREST
 	Scale          *ScaleREST
+	Lint           *LintREST
+	// Custom holds the custom subresources by name
+	Custom map[string]*CustomSubresourceREST
+	// Trash serves the deleted and restore subresources if deleted custom resources are retained
+	Trash *Trash
+	// History serves the history subresource if revisions of custom resources are kept
+	History *History
 }
 
-func NewStorage(resource schema.GroupResource, kind, listKind schema.GroupVersionKind, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, categories []string, tableConvertor rest.TableConvertor, replicasPathMapping fieldmanager.ResourcePathMappings) CustomResourceStorage {
-	customResourceREST, customResourceStatusREST := newREST(resource, kind, listKind, strategy, optsGetter, categories, tableConvertor)
+// NewStorage returns the storage of the custom resources of one version. limits may be nil, and
+// must be shared by the storages of all versions otherwise. If retainDeleted is true, deleted
+// custom resources are retained in the trash. If maxRevisions is positive, up to that many revisions
+// of the spec of each custom resource are kept in the history. Lists can be sorted and grouped by the
+// columnPaths in the dot notation.
+func NewStorage(resource schema.GroupResource, kind, listKind schema.GroupVersionKind, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, categories []string, tableConvertor rest.TableConvertor, replicasPathMapping fieldmanager.ResourcePathMappings, limits *ObjectLimits, retainDeleted bool, maxRevisions int32, columnPaths []string) CustomResourceStorage {
+	customResourceREST, customResourceStatusREST := newREST(resource, kind, listKind, strategy, optsGetter, categories, tableConvertor, limits, retainDeleted, maxRevisions, columnPaths)
 
 	s := CustomResourceStorage{
 		CustomResource: customResourceREST,
 	}
 
+	if retainDeleted {
+		s.Trash = customResourceREST.trash
+	}
+	if maxRevisions > 0 {
+		s.History = customResourceREST.history
+	}
+
 	if strategy.status != nil {
 		s.Status = customResourceStatusREST
 	}
@@ -69,6 +93,17 @@ func NewStorage(resource schema.GroupResource, kind, listKind schema.GroupVersio
 		}
 	}
 
+	if len(strategy.custom) > 0 {
+		s.Custom = make(map[string]*CustomSubresourceREST, len(strategy.custom))
+		for _, custom := range strategy.custom {
+			customStore := *customResourceREST.Store
+			customStrategy := NewCustomSubresourceStrategy(strategy, custom)
+			customStore.UpdateStrategy = customStrategy
+			customStore.ResetFieldsStrategy = customStrategy
+			s.Custom[custom.Name] = &CustomSubresourceREST{store: &customStore}
+		}
+	}
+
 	return s
 }
 
@@ -76,10 +111,24 @@ func NewStorage(resource schema.GroupResource, kind, listKind schema.GroupVersio
 type REST struct {
 	*genericregistry.Store
 	categories []string
+	// trash holds the retained deleted custom resources. It exists without retention too, to
+	// purge the custom resources retained before retention was disabled.
+	trash *Trash
+	// history holds the revisions of the custom resources. Like the trash, it exists without history
+	// too, to purge the revisions kept before history was disabled.
+	history *History
+	// columnPaths holds the fields of the paths lists can be sorted and grouped by
+	columnPaths map[string][]string
+	// celSelectors compiles the CEL selectors of list and watch requests
+	celSelectors *celSelectors
+	// sortSnapshots holds the sorted lists whose continue pages are still to be served
+	sortSnapshots *utilcache.LRUExpireCache
+	// structural is the structural schema of the version, which field projections are validated against
+	structural *structuralschema.Structural
 }
 
 // newREST returns a RESTStorage object that will work against API services.
-func newREST(resource schema.GroupResource, kind, listKind schema.GroupVersionKind, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, categories []string, tableConvertor rest.TableConvertor) (*REST, *StatusREST) {
+func newREST(resource schema.GroupResource, kind, listKind schema.GroupVersionKind, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, categories []string, tableConvertor rest.TableConvertor, limits *ObjectLimits, retainDeleted bool, maxRevisions int32, columnPaths []string) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc: func() runtime.Object {
 			// set the expected group/version/kind in the new object as a signal to the versioning decoder
@@ -103,24 +152,99 @@ func newREST(resource schema.GroupResource, kind, listKind schema.GroupVersionKi
 
 		TableConvertor: tableConvertor,
 	}
+	if limits != nil {
+		store.BeginCreate = limits.BeginCreate
+		store.BeginUpdate = limits.BeginUpdate
+	}
 	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: strategy.GetAttrs}
 	if err := store.CompleteWithOptions(options); err != nil {
 		panic(err) // TODO: Propagate error up
 	}
+	trash := newTrash(resource, strategy, optsGetter, store)
+	if retainDeleted {
+		store.AfterDelete = trash.retain
+	}
+	history := newHistory(resource, strategy, optsGetter, store, maxRevisions)
+	if maxRevisions > 0 {
+		store.AfterCreate = history.afterCreate
+		store.AfterUpdate = history.afterUpdate
+		afterDelete := store.AfterDelete
+		store.AfterDelete = func(obj runtime.Object, options *metav1.DeleteOptions) {
+			if afterDelete != nil {
+				afterDelete(obj, options)
+			}
+			history.forget(obj, options)
+		}
+	}
+	// the usage of deprecated fields is counted after writes, unlike their warnings, which are only
+	// returned for new or changed values
+	afterCreate, afterUpdate := store.AfterCreate, store.AfterUpdate
+	store.AfterCreate = func(obj runtime.Object, options *metav1.CreateOptions) {
+		if options == nil {
+			options = &metav1.CreateOptions{}
+		}
+		strategy.countDeprecatedFields(obj, options.DryRun)
+		if afterCreate != nil {
+			afterCreate(obj, options)
+		}
+	}
+	store.AfterUpdate = func(obj runtime.Object, options *metav1.UpdateOptions) {
+		if options == nil {
+			options = &metav1.UpdateOptions{}
+		}
+		strategy.countDeprecatedFields(obj, options.DryRun)
+		if afterUpdate != nil {
+			afterUpdate(obj, options)
+		}
+	}
+	destroy := store.DestroyFunc
+	store.DestroyFunc = func() {
+		if limits != nil {
+			limits.stop()
+		}
+		trash.destroyStorage()
+		history.destroyStorage()
+		if destroy != nil {
+			destroy()
+		}
+	}
+	if limits != nil {
+		limits.start(store)
+	}
 
 	statusStore := *store
 	statusStrategy := NewStatusStrategy(strategy)
 	statusStore.UpdateStrategy = statusStrategy
 	statusStore.ResetFieldsStrategy = statusStrategy
-	return &REST{store, categories}, &StatusREST{store: &statusStore}
+	return &REST{store, categories, trash, history, splitColumnPaths(columnPaths), newCELSelectors(strategy.structuralSchemas[kind.Version], strategy.formats), utilcache.NewLRUExpireCache(sortSnapshotCacheSize), strategy.structuralSchemas[kind.Version]}, &StatusREST{store: &statusStore}
 }
 
 // Implement CategoriesProvider
 var _ rest.CategoriesProvider = &REST{}
 
 // List returns a list of items matching labels and field according to the store's PredicateFunc.
+// The items are filtered if ctx has a CEL selector, sorted if ctx has sort options, and projected if
+// ctx has a field projection.
 func (e *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
-	l, err := e.Store.List(ctx, options)
+	selector, err := e.celSelectors.fromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projection, err := e.fieldProjectionFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var l runtime.Object
+	if sortOptions, ok := sortOptionsFrom(ctx); ok {
+		l, err = e.sortedList(ctx, options, sortOptions, selector)
+	} else {
+		l, err = e.Store.List(ctx, options)
+		if ul, ok := l.(*unstructured.UnstructuredList); ok && err == nil && selector != nil {
+			// the remaining items are not counted after filtering, like with selectors in etcd
+			ul.SetRemainingItemCount(nil)
+			err = filterCELSelector(selector, ul)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +253,11 @@ func (e *REST) List(ctx context.Context, options *metainternalversion.ListOption
 	// implicitly shallow copy ObjectMeta. The generic store sets the self-link for each item. So this is necessary
 	// to avoid mutation of the objects from the cache.
 	if ul, ok := l.(*unstructured.UnstructuredList); ok {
+		if projection != nil && len(projection.as) == 0 {
+			// the projection copies the metadata it keeps
+			projection.projectList(ul)
+			return l, nil
+		}
 		for i := range ul.Items {
 			shallowCopyObjectMeta(&ul.Items[i])
 		}
@@ -137,12 +266,20 @@ func (e *REST) List(ctx context.Context, options *metainternalversion.ListOption
 	return l, nil
 }
 
+// Get returns the custom resource with the given name, projected if ctx has a field projection.
 func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	projection, err := r.fieldProjectionFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
 	o, err := r.Store.Get(ctx, name, options)
 	if err != nil {
 		return nil, err
 	}
 	if u, ok := o.(*unstructured.Unstructured); ok {
+		if projection != nil && len(projection.as) == 0 {
+			return projection.project(u), nil
+		}
 		shallowCopyObjectMeta(u)
 	}
 	return o, nil
@@ -176,6 +313,17 @@ func (r *REST) Categories() []string {
 	return r.categories
 }
 
+// PurgeDeleted removes all retained deleted custom resources.
+func (r *REST) PurgeDeleted(ctx context.Context) error {
+	_, err := r.trash.Purge(ctx, time.Now())
+	return err
+}
+
+// PurgeHistory removes the revisions of all custom resources.
+func (r *REST) PurgeHistory(ctx context.Context) error {
+	return r.history.Purge(ctx)
+}
+
 // StatusREST implements the REST endpoint for changing the status of a CustomResource
 type StatusREST struct {
 	store *genericregistry.Store
@@ -211,6 +359,40 @@ func (r *StatusREST) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
 	return r.store.GetResetFields()
 }
 
+// CustomSubresourceREST implements the REST endpoint for changing the value at the JSON path of a custom subresource of a CustomResource
+type CustomSubresourceREST struct {
+	store *genericregistry.Store
+}
+
+var _ = rest.Patcher(&CustomSubresourceREST{})
+
+func (r *CustomSubresourceREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *CustomSubresourceREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	o, err := r.store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		shallowCopyObjectMeta(u)
+	}
+	return o, nil
+}
+
+// Update alters the value at the JSON path of the custom subresource of an object.
+func (r *CustomSubresourceREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// subresources should never allow create on update.
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// GetResetFields implements rest.ResetFieldsStrategy
+func (r *CustomSubresourceREST) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return r.store.GetResetFields()
+}
+
 type ScaleREST struct {
 	store               *genericregistry.Store
 	specReplicasPath    string
@@ -43,12 +43,17 @@ import (
 	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	"k8s.io/apiextensions-apiserver/pkg/crdserverscheme"
 	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
 	"k8s.io/apiextensions-apiserver/pkg/registry/customresource/tableconvertor"
 )
 
 func newStorage(t *testing.T) (customresource.CustomResourceStorage, *etcd3testing.EtcdTestServer) {
+	return newStorageWithOptions(t, false, 0)
+}
+
+func newStorageWithOptions(t *testing.T, retainDeleted bool, maxRevisions int32) (customresource.CustomResourceStorage, *etcd3testing.EtcdTestServer) {
 	server, etcdStorage := etcd3testing.NewUnsecuredEtcd3TestClientServer(t)
 	etcdStorage.Codec = unstructured.UnstructuredJSONScheme
 	groupResource := schema.GroupResource{Group: "mygroup.example.com", Resource: "noxus"}
@@ -89,7 +94,11 @@ func newStorage(t *testing.T) (customresource.CustomResourceStorage, *etcd3testi
 		{Name: "Float64", Type: "number", JSONPath: ".spec.float64"},
 		{Name: "Bool", Type: "boolean", JSONPath: ".spec.bool"},
 	}
-	table, _ := tableconvertor.New(headers)
+	table, _ := tableconvertor.New(headers, nil)
+	var columnPaths []string
+	for _, header := range headers {
+		columnPaths = append(columnPaths, header.JSONPath)
+	}
 
 	storage := customresource.NewStorage(
 		groupResource,
@@ -104,11 +113,20 @@ func newStorage(t *testing.T) (customresource.CustomResourceStorage, *etcd3testi
 			nil,
 			status,
 			scale,
+			nil,
+			nil,
+			nil,
+			nil,
+			apiservervalidation.DefaultFormatRegistry,
 		),
 		restOptions,
 		[]string{"all"},
 		table,
 		fieldmanager.ResourcePathMappings{},
+		nil,
+		retainDeleted,
+		maxRevisions,
+		columnPaths,
 	)
 
 	return storage, server
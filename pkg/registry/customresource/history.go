@@ -0,0 +1,507 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/util/dryrun"
+)
+
+const (
+	// historyPrefixSuffix is appended to the resource prefix of the custom resources to get the prefix
+	// of their revisions.
+	historyPrefixSuffix = ".history"
+	// historyIndexPrefixSuffix is appended to the resource prefix of the custom resources to get the
+	// prefix of the indexes of their revisions.
+	historyIndexPrefixSuffix = ".historyindex"
+
+	// historyRevisionsAnnotation is set on the index of the revisions of a custom resource to the
+	// comma-separated resourceVersions of the revisions, the oldest first.
+	historyRevisionsAnnotation = "history.apiextensions.k8s.io/revisions"
+)
+
+const (
+	// HistoryResourceVersionAnnotation is set on a revision to the resourceVersion of the custom
+	// resource written with its spec.
+	HistoryResourceVersionAnnotation = "history.apiextensions.k8s.io/resource-version"
+	// HistoryFieldManagerAnnotation is set on a revision to the field manager of the write, if known.
+	HistoryFieldManagerAnnotation = "history.apiextensions.k8s.io/field-manager"
+)
+
+// History keeps the last revisions of the spec of the custom resources of one version of a
+// CustomResourceDefinition with history. A revision is a custom resource holding the spec, with
+// metadata.creationTimestamp set to the time of the write and annotations for its resourceVersion
+// and field manager. Revisions are keyed by namespace, name, uid and resourceVersion, and are
+// recorded on writes which change the spec. An index per custom resource lists the resourceVersions
+// of its revisions, so that writes find the latest revision and the revisions beyond the maximum
+// without listing all of them.
+//
+// Like the trash, the histories of all versions share their keys, and the storage of a history is
+// created on first use.
+type History struct {
+	resource        schema.GroupResource
+	namespaceScoped bool
+	maxRevisions    int
+	newListFunc     func() runtime.Object
+	// store is the storage of the custom resources. Revisions are restored through it.
+	store   *genericregistry.Store
+	storage *sideStorage
+	index   *sideStorage
+}
+
+// HistoryDiff is the difference between the specs of two revisions of a custom resource.
+type HistoryDiff struct {
+	// From is the resourceVersion of the older revision.
+	From string `json:"from"`
+	// To is the resourceVersion of the newer revision.
+	To string `json:"to"`
+	// Changes lists the changed values of the spec, in the order of their paths.
+	Changes []HistoryChange `json:"changes"`
+}
+
+// HistoryChange is a value of the spec which differs between two revisions. Objects are compared
+// field by field, other values as a whole.
+type HistoryChange struct {
+	// Path is the JSON path of the value, e.g. `.spec.replicas`.
+	Path string `json:"path"`
+	// From is the value in the older revision, and is omitted if the value was added.
+	From interface{} `json:"from,omitempty"`
+	// To is the value in the newer revision, and is omitted if the value was removed.
+	To interface{} `json:"to,omitempty"`
+}
+
+func newHistory(resource schema.GroupResource, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, store *genericregistry.Store, maxRevisions int32) *History {
+	h := &History{
+		resource:        resource,
+		namespaceScoped: strategy.NamespaceScoped(),
+		maxRevisions:    int(maxRevisions),
+		newListFunc:     store.NewListFunc,
+		store:           store,
+	}
+	h.storage = newSideStorage(resource, historyPrefixSuffix, strategy, optsGetter, store, h.keyOf)
+	h.index = newSideStorage(resource, historyIndexPrefixSuffix, strategy, optsGetter, store, h.indexKeyOf)
+	return h
+}
+
+// destroyStorage destroys the storages of the history if they were created.
+func (h *History) destroyStorage() {
+	h.storage.destroyStorage()
+	h.index.destroyStorage()
+}
+
+func (h *History) uidKey(prefix, namespace, name string, uid types.UID) string {
+	return nameKey(prefix, h.namespaceScoped, namespace, name) + "/" + string(uid)
+}
+
+func (h *History) keyOf(prefix string, obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return h.uidKey(prefix, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID()) + "/" + accessor.GetAnnotations()[HistoryResourceVersionAnnotation], nil
+}
+
+func (h *History) indexKeyOf(prefix string, obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return h.uidKey(prefix, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID()), nil
+}
+
+// afterCreate implements the AfterCreate hook of the store of the custom resources.
+func (h *History) afterCreate(obj runtime.Object, options *metav1.CreateOptions) {
+	if options == nil {
+		options = &metav1.CreateOptions{}
+	}
+	if !dryrun.IsDryRun(options.DryRun) {
+		h.record(obj, options.FieldManager)
+	}
+}
+
+// afterUpdate implements the AfterUpdate hook of the store of the custom resources, which is shared
+// with the status and scale subresources.
+func (h *History) afterUpdate(obj runtime.Object, options *metav1.UpdateOptions) {
+	if options == nil {
+		options = &metav1.UpdateOptions{}
+	}
+	if !dryrun.IsDryRun(options.DryRun) {
+		h.record(obj, options.FieldManager)
+	}
+}
+
+// forget implements the AfterDelete hook of the store of the custom resources. It removes the
+// revisions of obj, which has just been removed from storage.
+func (h *History) forget(obj runtime.Object, options *metav1.DeleteOptions) {
+	if options != nil && dryrun.IsDryRun(options.DryRun) {
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	ctx := context.TODO()
+	s, prefix, err := h.storage.get()
+	if err == nil {
+		err = h.remove(ctx, s, prefix, h.uidKey(prefix, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID()), h.keyOf)
+	}
+	if err == nil {
+		var is storage.Interface
+		if is, prefix, err = h.index.get(); err == nil {
+			err = is.Delete(ctx, h.uidKey(prefix, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID()), h.store.NewFunc(), nil, storage.ValidateAllObjectFunc, nil)
+		}
+		if storage.IsNotFound(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to remove the history of deleted %s %s: %v", h.resource, accessor.GetName(), err))
+	}
+}
+
+// record adds a revision for obj, which has just been written, unless its spec is that of the
+// latest revision. The oldest revisions beyond the maximum are removed.
+func (h *History) record(obj runtime.Object, fieldManager string) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	if err := h.recordRevision(context.TODO(), u, fieldManager); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to record the history of %s %s: %v", h.resource, u.GetName(), err))
+	}
+}
+
+func (h *History) recordRevision(ctx context.Context, u *unstructured.Unstructured, fieldManager string) error {
+	s, prefix, err := h.storage.get()
+	if err != nil {
+		return err
+	}
+	is, indexPrefix, err := h.index.get()
+	if err != nil {
+		return err
+	}
+	uidKey := h.uidKey(prefix, u.GetNamespace(), u.GetName(), u.GetUID())
+	indexKey := h.uidKey(indexPrefix, u.GetNamespace(), u.GetName(), u.GetUID())
+
+	index := &unstructured.Unstructured{}
+	if err := is.Get(ctx, indexKey, storage.GetOptions{IgnoreNotFound: true}, index); err != nil {
+		return err
+	}
+	spec, hasSpec := u.Object["spec"]
+	if rvs := indexedRevisions(index); len(rvs) > 0 {
+		latest := &unstructured.Unstructured{}
+		if err := s.Get(ctx, uidKey+"/"+rvs[len(rvs)-1], storage.GetOptions{IgnoreNotFound: true}, latest); err != nil {
+			return err
+		}
+		latestSpec, latestHasSpec := latest.Object["spec"]
+		if latest.Object != nil && hasSpec == latestHasSpec && reflect.DeepEqual(spec, latestSpec) {
+			return nil
+		}
+	}
+
+	if len(fieldManager) == 0 {
+		fieldManager = latestFieldManager(u)
+	}
+	revision := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	revision.SetGroupVersionKind(u.GroupVersionKind())
+	revision.SetNamespace(u.GetNamespace())
+	revision.SetName(u.GetName())
+	revision.SetUID(u.GetUID())
+	revision.SetCreationTimestamp(metav1.Now())
+	annotations := map[string]string{HistoryResourceVersionAnnotation: u.GetResourceVersion()}
+	if len(fieldManager) > 0 {
+		annotations[HistoryFieldManagerAnnotation] = fieldManager
+	}
+	revision.SetAnnotations(annotations)
+	if hasSpec {
+		revision.Object["spec"] = runtime.DeepCopyJSONValue(spec)
+	}
+	if err := s.Create(ctx, uidKey+"/"+u.GetResourceVersion(), revision, h.store.NewFunc(), 0); err != nil && !storage.IsExist(err) {
+		return err
+	}
+
+	// writes of several servers are merged into the index by retrying on conflicts
+	var removed []string
+	err = is.GuaranteedUpdate(ctx, indexKey, &unstructured.Unstructured{}, true, nil, func(input runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		current := input.(*unstructured.Unstructured)
+		rvs := insertRevision(indexedRevisions(current), u.GetResourceVersion())
+		n := len(rvs) - minInt(len(rvs), h.maxRevisions)
+		removed = rvs[:n]
+
+		updated := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		if current.Object != nil {
+			updated = current.DeepCopy()
+		}
+		updated.SetGroupVersionKind(u.GroupVersionKind())
+		updated.SetNamespace(u.GetNamespace())
+		updated.SetName(u.GetName())
+		updated.SetUID(u.GetUID())
+		updated.SetAnnotations(map[string]string{historyRevisionsAnnotation: strings.Join(rvs[n:], ",")})
+		return updated, nil, nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, rv := range removed {
+		if err := s.Delete(ctx, uidKey+"/"+rv, h.store.NewFunc(), nil, storage.ValidateAllObjectFunc, nil); err != nil && !storage.IsNotFound(err) {
+			utilruntime.HandleError(fmt.Errorf("failed to remove an old revision of %s %s: %v", h.resource, u.GetName(), err))
+		}
+	}
+	return nil
+}
+
+// indexedRevisions returns the resourceVersions of the revisions in index, the oldest first.
+func indexedRevisions(index *unstructured.Unstructured) []string {
+	rvs := index.GetAnnotations()[historyRevisionsAnnotation]
+	if len(rvs) == 0 {
+		return nil
+	}
+	return strings.Split(rvs, ",")
+}
+
+// insertRevision inserts rv into the ordered resourceVersions rvs, unless it is contained already.
+func insertRevision(rvs []string, rv string) []string {
+	i := sort.Search(len(rvs), func(i int) bool { return !resourceVersionLess(rvs[i], rv) })
+	if i < len(rvs) && rvs[i] == rv {
+		return rvs
+	}
+	rvs = append(rvs, "")
+	copy(rvs[i+1:], rvs[i:])
+	rvs[i] = rv
+	return rvs
+}
+
+// revisions returns the revisions of a custom resource, the oldest first.
+func (h *History) revisions(ctx context.Context, s storage.Interface, prefix, namespace, name string, uid types.UID) ([]unstructured.Unstructured, error) {
+	list := h.newListFunc()
+	if err := s.List(ctx, h.uidKey(prefix, namespace, name, uid), storage.ListOptions{Predicate: storage.Everything}, list); err != nil {
+		return nil, err
+	}
+	items := list.(*unstructured.UnstructuredList).Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return resourceVersionLess(revisionOf(&items[i]), revisionOf(&items[j]))
+	})
+	return items, nil
+}
+
+// current returns the named custom resource in the namespace of ctx and its revisions, the oldest first.
+func (h *History) current(ctx context.Context, name string) (*unstructured.Unstructured, []unstructured.Unstructured, error) {
+	obj, err := h.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	u := obj.(*unstructured.Unstructured)
+	s, prefix, err := h.storage.get()
+	if err != nil {
+		return nil, nil, apierrors.NewInternalError(err)
+	}
+	revisions, err := h.revisions(ctx, s, prefix, genericapirequest.NamespaceValue(ctx), name, u.GetUID())
+	if err != nil {
+		return nil, nil, apierrors.NewInternalError(err)
+	}
+	return u, revisions, nil
+}
+
+// List returns the revisions of the named custom resource in the namespace of ctx, the most recent first.
+func (h *History) List(ctx context.Context, name string) (runtime.Object, error) {
+	_, revisions, err := h.current(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	list := h.newListFunc().(*unstructured.UnstructuredList)
+	for i := len(revisions) - 1; i >= 0; i-- {
+		list.Items = append(list.Items, revisions[i])
+	}
+	return list, nil
+}
+
+// Diff compares the specs of two revisions of the named custom resource in the namespace of ctx,
+// given by their resourceVersions. If to is empty, from is compared to the current spec.
+func (h *History) Diff(ctx context.Context, name, from, to string) (*HistoryDiff, error) {
+	current, revisions, err := h.current(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	fromRevision, err := findRevision(revisions, name, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision := current
+	if len(to) > 0 {
+		if toRevision, err = findRevision(revisions, name, to); err != nil {
+			return nil, err
+		}
+	} else {
+		to = current.GetResourceVersion()
+	}
+	return &HistoryDiff{
+		From:    from,
+		To:      to,
+		Changes: diffValues(".spec", fromRevision.Object["spec"], toRevision.Object["spec"], nil),
+	}, nil
+}
+
+// Restorable returns the named custom resource in the namespace of ctx with the spec of the revision
+// with the given resourceVersion, to be written by an update. The rest of the custom resource is
+// kept, including its resourceVersion, so that the update fails with a conflict if the custom
+// resource is written concurrently. The update is recorded as a new revision.
+func (h *History) Restorable(ctx context.Context, name, revision string) (*unstructured.Unstructured, error) {
+	current, revisions, err := h.current(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	restored, err := findRevision(revisions, name, revision)
+	if err != nil {
+		return nil, err
+	}
+	obj := current.DeepCopy()
+	if spec, ok := restored.Object["spec"]; ok {
+		obj.Object["spec"] = runtime.DeepCopyJSONValue(spec)
+	} else {
+		delete(obj.Object, "spec")
+	}
+	return obj, nil
+}
+
+// Purge removes the revisions of all custom resources.
+func (h *History) Purge(ctx context.Context) error {
+	s, prefix, err := h.storage.get()
+	if err != nil {
+		return err
+	}
+	if err := h.remove(ctx, s, prefix, prefix, h.keyOf); err != nil {
+		return err
+	}
+	is, indexPrefix, err := h.index.get()
+	if err != nil {
+		return err
+	}
+	return h.remove(ctx, is, indexPrefix, indexPrefix, h.indexKeyOf)
+}
+
+// remove deletes the objects below key, whose keys are given by keyOf.
+func (h *History) remove(ctx context.Context, s storage.Interface, prefix, key string, keyOf func(prefix string, obj runtime.Object) (string, error)) error {
+	list := &unstructured.UnstructuredList{}
+	if err := s.List(ctx, key, storage.ListOptions{Predicate: storage.Everything}, list); err != nil {
+		return err
+	}
+	var errs []error
+	for i := range list.Items {
+		itemKey, err := keyOf(prefix, &list.Items[i])
+		if err == nil {
+			err = s.Delete(ctx, itemKey, h.store.NewFunc(), nil, storage.ValidateAllObjectFunc, nil)
+		}
+		if err != nil && !storage.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func findRevision(revisions []unstructured.Unstructured, name, resourceVersion string) (*unstructured.Unstructured, error) {
+	for i := range revisions {
+		if revisionOf(&revisions[i]) == resourceVersion {
+			return &revisions[i], nil
+		}
+	}
+	return nil, apierrors.NewBadRequest(fmt.Sprintf("no revision with resourceVersion %q in the history of %q", resourceVersion, name))
+}
+
+// revisionOf returns the resourceVersion of the custom resource a revision was recorded from.
+func revisionOf(revision *unstructured.Unstructured) string {
+	return revision.GetAnnotations()[HistoryResourceVersionAnnotation]
+}
+
+// resourceVersionLess orders resourceVersions numerically if possible.
+func resourceVersionLess(a, b string) bool {
+	x, errA := strconv.ParseUint(a, 10, 64)
+	y, errB := strconv.ParseUint(b, 10, 64)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return x < y
+}
+
+// latestFieldManager returns the manager of the most recently changed managed fields of obj.
+func latestFieldManager(obj *unstructured.Unstructured) string {
+	var manager string
+	var latest *metav1.Time
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Time != nil && (latest == nil || latest.Before(entry.Time)) {
+			manager, latest = entry.Manager, entry.Time
+		}
+	}
+	return manager
+}
+
+// diffValues appends the differences between the JSON values a and b at path to changes.
+func diffValues(path string, a, b interface{}, changes []HistoryChange) []HistoryChange {
+	aMap, aIsMap := a.(map[string]interface{})
+	bMap, bIsMap := b.(map[string]interface{})
+	if !aIsMap || !bIsMap {
+		if !reflect.DeepEqual(a, b) {
+			changes = append(changes, HistoryChange{Path: path, From: a, To: b})
+		}
+		return changes
+	}
+
+	keys := make([]string, 0, len(aMap)+len(bMap))
+	for k := range aMap {
+		keys = append(keys, k)
+	}
+	for k := range bMap {
+		if _, ok := aMap[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		childPath := path + "." + k
+		if strings.ContainsAny(k, ".[]'") {
+			childPath = path + "['" + k + "']"
+		}
+		changes = diffValues(childPath, aMap[k], bMap[k], changes)
+	}
+	return changes
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
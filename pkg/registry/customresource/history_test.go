@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource_test
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+func TestHistory(t *testing.T) {
+	storage, server := newStorageWithOptions(t, false, 2)
+	defer server.Terminate(t)
+	defer storage.CustomResource.Store.DestroyFunc()
+
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault)
+	revisions := func() []string {
+		list, err := storage.History.List(ctx, "foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rvs []string
+		for _, item := range list.(*unstructured.UnstructuredList).Items {
+			rvs = append(rvs, item.GetAnnotations()[customresource.HistoryResourceVersionAnnotation])
+		}
+		return rvs
+	}
+	setReplicas := func(replicas int64, options *metav1.UpdateOptions) *unstructured.Unstructured {
+		obj, err := storage.CustomResource.Get(ctx, "foo", &metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := obj.(*unstructured.Unstructured)
+		if err := unstructured.SetNestedField(u.Object, replicas, "spec", "replicas"); err != nil {
+			t.Fatal(err)
+		}
+		out, _, err := storage.CustomResource.Update(ctx, "foo", rest.DefaultUpdatedObjectInfo(u), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out.(*unstructured.Unstructured)
+	}
+
+	created, _ := createCustomResource(storage.CustomResource, *validNewCustomResource(), t)
+	if rvs := revisions(); !reflect.DeepEqual(rvs, []string{created.GetResourceVersion()}) {
+		t.Fatalf("expected the created revision, got %v", rvs)
+	}
+
+	setReplicas(7, &metav1.UpdateOptions{})
+	setReplicas(8, &metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	if rvs := revisions(); len(rvs) != 1 {
+		t.Fatalf("expected updates without spec change and dry runs not to be recorded, got %v", rvs)
+	}
+
+	updated := setReplicas(8, &metav1.UpdateOptions{FieldManager: "tester"})
+	latest := setReplicas(9, &metav1.UpdateOptions{})
+	if rvs := revisions(); !reflect.DeepEqual(rvs, []string{latest.GetResourceVersion(), updated.GetResourceVersion()}) {
+		t.Fatalf("expected the last two revisions, the most recent first, got %v", rvs)
+	}
+	list, _ := storage.History.List(ctx, "foo")
+	if manager := list.(*unstructured.UnstructuredList).Items[1].GetAnnotations()[customresource.HistoryFieldManagerAnnotation]; manager != "tester" {
+		t.Errorf("expected the field manager of the update to be recorded, got %q", manager)
+	}
+
+	diff, err := storage.History.Diff(ctx, "foo", updated.GetResourceVersion(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []customresource.HistoryChange{{Path: ".spec.replicas", From: int64(8), To: int64(9)}}
+	if diff.To != latest.GetResourceVersion() || !reflect.DeepEqual(diff.Changes, expected) {
+		t.Errorf("expected %v compared to %s, got %#v", expected, latest.GetResourceVersion(), diff)
+	}
+	if _, err := storage.History.Diff(ctx, "foo", created.GetResourceVersion(), ""); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected BadRequest comparing a removed revision, got %v", err)
+	}
+
+	restorable, err := storage.History.Restorable(ctx, "foo", updated.GetResourceVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, _, err := storage.CustomResource.Update(ctx, "foo", rest.DefaultUpdatedObjectInfo(restorable), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := storage.CustomResource.Update(ctx, "foo", rest.DefaultUpdatedObjectInfo(restorable), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); !apierrors.IsConflict(err) {
+		t.Errorf("expected a conflict restoring from a stale object, got %v", err)
+	}
+	if replicas, _, _ := unstructured.NestedInt64(restored.(*unstructured.Unstructured).Object, "spec", "replicas"); replicas != 8 {
+		t.Errorf("expected the spec of the revision to be restored, got %d replicas", replicas)
+	}
+	if rvs := revisions(); len(rvs) != 2 || rvs[0] != restored.(*unstructured.Unstructured).GetResourceVersion() {
+		t.Errorf("expected the restore to be recorded, got %v", rvs)
+	}
+
+	if _, _, err := storage.CustomResource.Delete(ctx, "foo", rest.ValidateAllObjectFunc, &metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	createCustomResource(storage.CustomResource, *validNewCustomResource(), t)
+	if rvs := revisions(); len(rvs) != 1 {
+		t.Errorf("expected the history of the deleted object to be removed, got %v", rvs)
+	}
+	if err := storage.CustomResource.PurgeHistory(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if rvs := revisions(); len(rvs) != 0 {
+		t.Errorf("expected the history to be purged, got %v", rvs)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/klog/v2"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+)
+
+// ObjectLimits enforces the limits of a CustomResourceDefinition on the number and the size of
+// its custom resources. It is shared by the storages of all versions. The number of custom resources
+// is counted from a watch of the first storage it is started with, which is served from the watch cache.
+type ObjectLimits struct {
+	resource schema.GroupResource
+	limits   apiextensions.CustomResourceLimits
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+
+	lock       sync.RWMutex
+	synced     bool
+	total      int64
+	namespaces map[string]int64
+}
+
+// NewObjectLimits returns the enforcement of the given limits, or nil if limits is nil.
+func NewObjectLimits(resource schema.GroupResource, limits *apiextensions.CustomResourceLimits) *ObjectLimits {
+	if limits == nil {
+		return nil
+	}
+	return &ObjectLimits{
+		resource:   resource,
+		limits:     *limits,
+		stopCh:     make(chan struct{}),
+		namespaces: map[string]int64{},
+	}
+}
+
+// countsObjects returns true if there is a limit on the number of objects.
+func (l *ObjectLimits) countsObjects() bool {
+	return l.limits.MaxObjects != nil || l.limits.MaxObjectsPerNamespace != nil
+}
+
+// start starts counting the objects in store, if there is a limit on the number of objects and
+// counting was not started yet.
+func (l *ObjectLimits) start(store *genericregistry.Store) {
+	if !l.countsObjects() {
+		return
+	}
+	l.startOnce.Do(func() {
+		go wait.Until(func() { l.listAndWatch(store) }, time.Second, l.stopCh)
+	})
+}
+
+// stop stops counting the objects.
+func (l *ObjectLimits) stop() {
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+		customResourceObjectsGauge.DeleteLabelValues(l.resource.Group, l.resource.Resource)
+	})
+}
+
+// listAndWatch counts the objects in store until the watch ends. The counts stay in use until the
+// next list replaces them.
+func (l *ObjectLimits) listAndWatch(store *genericregistry.Store) {
+	ctx, cancel := context.WithCancel(genericapirequest.NewContext())
+	defer cancel()
+	go func() {
+		select {
+		case <-l.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// a resourceVersion of 0 is served from the watch cache
+	list, err := store.List(ctx, &metainternalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		klog.Warningf("failed to list %s to count them: %v", l.resource, err)
+		return
+	}
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		klog.Warningf("failed to count %s: %v", l.resource, err)
+		return
+	}
+	namespaces := map[string]int64{}
+	total := int64(0)
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		namespaces[accessor.GetNamespace()]++
+		total++
+		return nil
+	}); err != nil {
+		klog.Warningf("failed to count %s: %v", l.resource, err)
+		return
+	}
+
+	w, err := store.Watch(ctx, &metainternalversion.ListOptions{ResourceVersion: listAccessor.GetResourceVersion(), Watch: true})
+	if err != nil {
+		klog.Warningf("failed to watch %s to count them: %v", l.resource, err)
+		return
+	}
+	defer w.Stop()
+
+	l.lock.Lock()
+	l.synced, l.total, l.namespaces = true, total, namespaces
+	l.lock.Unlock()
+	customResourceObjectsGauge.WithLabelValues(l.resource.Group, l.resource.Resource).Set(float64(total))
+
+	for event := range w.ResultChan() {
+		delta := int64(0)
+		switch event.Type {
+		case watch.Added:
+			delta = 1
+		case watch.Deleted:
+			delta = -1
+		case watch.Error:
+			klog.V(4).Infof("restarting the watch of %s to count them: %v", l.resource, apierrors.FromObject(event.Object))
+			return
+		default:
+			continue
+		}
+		accessor, err := meta.Accessor(event.Object)
+		if err != nil {
+			continue
+		}
+		l.lock.Lock()
+		l.namespaces[accessor.GetNamespace()] += delta
+		if l.namespaces[accessor.GetNamespace()] <= 0 {
+			delete(l.namespaces, accessor.GetNamespace())
+		}
+		l.total += delta
+		total = l.total
+		l.lock.Unlock()
+		customResourceObjectsGauge.WithLabelValues(l.resource.Group, l.resource.Resource).Set(float64(total))
+	}
+}
+
+// BeginCreate implements genericregistry.BeginCreateFunc. It forbids creates exceeding the limits.
+func (l *ObjectLimits) BeginCreate(ctx context.Context, obj runtime.Object, options *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
+	if err := l.validateJSONSize(obj); err != nil {
+		return nil, err
+	}
+	if err := l.validateCount(obj); err != nil {
+		return nil, err
+	}
+	return finishNothing, nil
+}
+
+// BeginUpdate implements genericregistry.BeginUpdateFunc. It forbids updates exceeding the size limit.
+func (l *ObjectLimits) BeginUpdate(ctx context.Context, obj, old runtime.Object, options *metav1.UpdateOptions) (genericregistry.FinishFunc, error) {
+	if err := l.validateJSONSize(obj); err != nil {
+		return nil, err
+	}
+	return finishNothing, nil
+}
+
+func finishNothing(context.Context, bool) {}
+
+// validateJSONSize forbids objects whose JSON serialization exceeds maxObjectSizeBytes. This is
+// not the size in storage, and system metadata like uid is not set yet on create.
+func (l *ObjectLimits) validateJSONSize(obj runtime.Object) error {
+	if l.limits.MaxObjectSizeBytes == nil {
+		return nil
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if max := *l.limits.MaxObjectSizeBytes; int64(len(data)) > max {
+		return l.forbidden("maxObjectSizeBytes", accessor.GetName(), fmt.Errorf("the object has %d bytes serialized as JSON, exceeding the limit of %d bytes", len(data), max))
+	}
+	return nil
+}
+
+// validateCount forbids creates beyond maxObjects and maxObjectsPerNamespace. It is best-effort: the
+// counts are updated by the watch only after creates succeed, so concurrent creates which all see a
+// count below the limit are all allowed.
+func (l *ObjectLimits) validateCount(obj runtime.Object) error {
+	if !l.countsObjects() {
+		return nil
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	namespace := accessor.GetNamespace()
+
+	l.lock.RLock()
+	synced, total, inNamespace := l.synced, l.total, l.namespaces[namespace]
+	l.lock.RUnlock()
+
+	if !synced {
+		return apierrors.NewServiceUnavailable(fmt.Sprintf("the number of %s is not known yet", l.resource))
+	}
+	if max := l.limits.MaxObjects; max != nil && total >= *max {
+		return l.forbidden("maxObjects", accessor.GetName(), fmt.Errorf("exceeded the limit of %d objects", *max))
+	}
+	if max := l.limits.MaxObjectsPerNamespace; max != nil && len(namespace) > 0 && inNamespace >= *max {
+		return l.forbidden("maxObjectsPerNamespace", accessor.GetName(), fmt.Errorf("exceeded the limit of %d objects in namespace %q", *max, namespace))
+	}
+	return nil
+}
+
+func (l *ObjectLimits) forbidden(limit, name string, err error) error {
+	objectLimitRejectionsCounter.WithLabelValues(l.resource.Group, l.resource.Resource, limit).Inc()
+	return apierrors.NewForbidden(l.resource, name, err)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+)
+
+func TestObjectLimits(t *testing.T) {
+	newObject := func(namespace string, size int) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]interface{}{"name": "foo", "namespace": namespace},
+			"spec":       map[string]interface{}{"data": strings.Repeat("x", size)},
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		limits       apiextensions.CustomResourceLimits
+		unsynced     bool
+		obj          *unstructured.Unstructured
+		expectCreate func(error) bool
+		expectUpdate func(error) bool
+	}{
+		{
+			name:   "within limits",
+			limits: apiextensions.CustomResourceLimits{MaxObjects: pointer.Int64Ptr(10), MaxObjectsPerNamespace: pointer.Int64Ptr(3), MaxObjectSizeBytes: pointer.Int64Ptr(1024)},
+			obj:    newObject("other", 10),
+		},
+		{
+			name:         "too many objects in namespace",
+			limits:       apiextensions.CustomResourceLimits{MaxObjects: pointer.Int64Ptr(10), MaxObjectsPerNamespace: pointer.Int64Ptr(3)},
+			obj:          newObject("default", 10),
+			expectCreate: apierrors.IsForbidden,
+		},
+		{
+			name:         "too many objects in cluster",
+			limits:       apiextensions.CustomResourceLimits{MaxObjects: pointer.Int64Ptr(5)},
+			obj:          newObject("other", 10),
+			expectCreate: apierrors.IsForbidden,
+		},
+		{
+			name:         "too large object",
+			limits:       apiextensions.CustomResourceLimits{MaxObjectSizeBytes: pointer.Int64Ptr(1024)},
+			obj:          newObject("other", 2048),
+			expectCreate: apierrors.IsForbidden,
+			expectUpdate: apierrors.IsForbidden,
+		},
+		{
+			name:         "counts not synced yet",
+			limits:       apiextensions.CustomResourceLimits{MaxObjects: pointer.Int64Ptr(10)},
+			unsynced:     true,
+			obj:          newObject("other", 10),
+			expectCreate: apierrors.IsServiceUnavailable,
+		},
+		{
+			name:     "size limit does not need counts",
+			limits:   apiextensions.CustomResourceLimits{MaxObjectSizeBytes: pointer.Int64Ptr(1024)},
+			unsynced: true,
+			obj:      newObject("other", 10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewObjectLimits(schema.GroupResource{Group: "example.com", Resource: "widgets"}, &tt.limits)
+			if !tt.unsynced {
+				l.synced, l.total, l.namespaces = true, 5, map[string]int64{"default": 3, "other": 2}
+			}
+
+			_, err := l.BeginCreate(context.TODO(), tt.obj, nil)
+			if tt.expectCreate == nil && err != nil {
+				t.Errorf("unexpected error on create: %v", err)
+			} else if tt.expectCreate != nil && !tt.expectCreate(err) {
+				t.Errorf("unexpected error on create: %v", err)
+			}
+
+			_, err = l.BeginUpdate(context.TODO(), tt.obj, tt.obj, nil)
+			if tt.expectUpdate == nil && err != nil {
+				t.Errorf("unexpected error on update: %v", err)
+			} else if tt.expectUpdate != nil && !tt.expectUpdate(err) {
+				t.Errorf("unexpected error on update: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewObjectLimitsWithoutLimits(t *testing.T) {
+	if l := NewObjectLimits(schema.GroupResource{Group: "example.com", Resource: "widgets"}, nil); l != nil {
+		t.Errorf("expected no limits, got %v", l)
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/deprecation"
+	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/reference"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+// LintPhase is the phase of linting which produced an error or a warning.
+type LintPhase string
+
+const (
+	// LintPhaseTypeMeta checks apiVersion and kind. If it fails, no other phase is run.
+	LintPhaseTypeMeta LintPhase = "typeMeta"
+	// LintPhaseMetadata validates metadata, at the root and of embedded resources.
+	LintPhaseMetadata LintPhase = "metadata"
+	// LintPhaseSchema validates against the OpenAPI schema, including the scale subresource paths.
+	LintPhaseSchema LintPhase = "schema"
+	// LintPhaseListType checks the x-kubernetes-list-type map and set invariants.
+	LintPhaseListType LintPhase = "listType"
+	// LintPhaseValidationRules evaluates the x-kubernetes-validations rules.
+	LintPhaseValidationRules LintPhase = "validationRules"
+	// LintPhaseEmbeddedResources validates embedded resources against the schemas of their kinds.
+	LintPhaseEmbeddedResources LintPhase = "embeddedResources"
+	// LintPhaseReferences looks up the referents of x-kubernetes-reference fields.
+	LintPhaseReferences LintPhase = "references"
+	// LintPhaseDeprecations reports the usage of fields marked with x-kubernetes-deprecated.
+	LintPhaseDeprecations LintPhase = "deprecations"
+	// LintPhaseCreate runs the create in a dry run, and reports the errors not reported by the
+	// other phases, e.g. because an object with the same name exists or a limit is reached.
+	LintPhaseCreate LintPhase = "create"
+)
+
+// LintResult is the response of the lint subresource.
+type LintResult struct {
+	// Pruned are the paths of the unknown fields dropped from the object.
+	Pruned []string `json:"pruned,omitempty"`
+	// Defaulted are the values set by defaulting, by path.
+	Defaulted map[string]interface{} `json:"defaulted,omitempty"`
+	// Object is the object after pruning, defaulting and normalization, as it would be persisted.
+	Object map[string]interface{} `json:"object"`
+	// Errors are the validation errors by the phase which produced them. The object would be
+	// rejected on create if there is any.
+	Errors map[LintPhase][]metav1.StatusCause `json:"errors,omitempty"`
+	// Warnings are the warnings by the phase which produced them.
+	Warnings map[LintPhase][]string `json:"warnings,omitempty"`
+}
+
+// LintREST implements the lint subresource. It runs pruning, defaulting and the validation of
+// a create on a posted object phase by phase, then runs the create in a dry run, and reports the
+// results without persisting anything.
+type LintREST struct {
+	strategy              customResourceStrategy
+	creater               rest.Creater
+	preserveUnknownFields bool
+}
+
+// NewLintREST returns the lint subresource for the custom resources of the given strategy, which
+// are created by creater. Unknown fields are not pruned if preserveUnknownFields is true.
+func NewLintREST(strategy customResourceStrategy, creater rest.Creater, preserveUnknownFields bool) *LintREST {
+	return &LintREST{strategy: strategy, creater: creater, preserveUnknownFields: preserveUnknownFields}
+}
+
+// Lint lints obj as a custom resource of the version of the strategy. obj is mutated.
+func (r *LintREST) Lint(ctx context.Context, obj *unstructured.Unstructured) *LintResult {
+	a := r.strategy
+	s := a.structuralSchemas[a.kind.Version]
+	result := &LintResult{Object: obj.Object}
+
+	if errs := a.validator.ValidateTypeMeta(ctx, obj); len(errs) > 0 {
+		result.addErrors(LintPhaseTypeMeta, errs)
+		return result
+	}
+
+	if s != nil {
+		if !r.preserveUnknownFields {
+			result.Pruned = structuralpruning.PruneWithOptions(obj.Object, s, true, structuralpruning.PruneOptions{ReturnPruned: true})
+			structuraldefaulting.PruneNonNullableNullsWithoutDefaults(obj.Object, s)
+		}
+		if err := schemaobjectmeta.Coerce(nil, obj.Object, s, true, false); err != nil {
+			result.addErrors(LintPhaseMetadata, field.ErrorList{err})
+		}
+
+		orig := runtime.DeepCopyJSON(obj.Object)
+		structuraldefaulting.Default(obj.Object, s)
+		a.pruneAndDefaultEmbeddedResources(obj)
+		result.Defaulted = map[string]interface{}{}
+		defaultedFields(nil, orig, obj.Object, result.Defaulted)
+		structuraldefaulting.Canonicalize(obj.Object, s)
+		structuraldefaulting.Normalize(obj.Object, s)
+	}
+	a.PrepareForCreate(ctx, obj)
+
+	var metadataErrs field.ErrorList
+	if accessor, err := meta.Accessor(obj); err != nil {
+		metadataErrs = append(metadataErrs, field.Invalid(field.NewPath("metadata"), nil, err.Error()))
+	} else {
+		metadataErrs = append(metadataErrs, validation.ValidateObjectMetaAccessor(accessor, a.namespaceScoped, validation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	}
+	metadataErrs = append(metadataErrs, schemaobjectmeta.Validate(nil, obj.Object, s, false)...)
+	result.addErrors(LintPhaseMetadata, a.redactErrors(obj, metadataErrs))
+
+	var schemaErrs field.ErrorList
+	schemaErrs = append(schemaErrs, apiservervalidation.ValidateCustomResource(nil, obj.UnstructuredContent(), a.validator.schemaValidator)...)
+	schemaErrs = append(schemaErrs, a.validator.ValidateScaleSpec(ctx, obj, a.scale)...)
+	schemaErrs = append(schemaErrs, a.validator.ValidateScaleStatus(ctx, obj, a.scale)...)
+	result.addErrors(LintPhaseSchema, a.redactErrors(obj, schemaErrs))
+	result.addErrors(LintPhaseListType, a.redactErrors(obj, structurallisttype.ValidateListSetsAndMaps(nil, s, obj.Object)))
+	if celValidator, ok := a.celValidators[a.kind.Version]; ok {
+		result.addErrors(LintPhaseValidationRules, a.redactErrors(obj, celValidator.Validate(nil, s, obj.Object)))
+	}
+	result.addErrors(LintPhaseEmbeddedResources, a.redactErrors(obj, a.validateEmbeddedResources(obj)))
+	result.addErrors(LintPhaseReferences, a.redactErrors(obj, a.validateReferences(obj, nil)))
+
+	// unlike deprecationWarnings, linting does not count the usage of deprecated fields
+	if a.deprecations[a.kind.Version] {
+		for _, usage := range deprecation.Find(s, nil, obj.Object) {
+			result.addWarnings(LintPhaseDeprecations, usage.Warning())
+		}
+	}
+	if a.references[a.kind.Version] {
+		result.addWarnings(LintPhaseReferences, reference.Warnings(s, obj.Object, nil, obj.GetNamespace(), a.referenceLister)...)
+	}
+
+	r.dryRunCreate(ctx, obj, result)
+	return result
+}
+
+// dryRunCreate creates a copy of obj in a dry run, and adds the errors of the create which are
+// not reported by the other phases to result.
+func (r *LintREST) dryRunCreate(ctx context.Context, obj *unstructured.Unstructured, result *LintResult) {
+	if r.creater == nil {
+		return
+	}
+	ctx = genericapirequest.WithNamespace(ctx, obj.GetNamespace())
+	_, err := r.creater.Create(ctx, obj.DeepCopy(), rest.ValidateAllObjectFunc, &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err == nil {
+		return
+	}
+
+	status, ok := err.(apierrors.APIStatus)
+	if !ok {
+		result.addCauses(LintPhaseCreate, metav1.StatusCause{Message: err.Error()})
+		return
+	}
+	if s := status.Status(); s.Reason != metav1.StatusReasonInvalid || s.Details == nil {
+		result.addCauses(LintPhaseCreate, metav1.StatusCause{Type: metav1.CauseType(s.Reason), Message: s.Message})
+		return
+	}
+	reported := map[metav1.StatusCause]bool{}
+	for _, causes := range result.Errors {
+		for _, cause := range causes {
+			reported[cause] = true
+		}
+	}
+	for _, cause := range status.Status().Details.Causes {
+		if !reported[cause] {
+			result.addCauses(LintPhaseCreate, cause)
+		}
+	}
+}
+
+func (r *LintResult) addErrors(phase LintPhase, errs field.ErrorList) {
+	for _, err := range errs {
+		r.addCauses(phase, metav1.StatusCause{
+			Type:    metav1.CauseType(err.Type),
+			Message: err.ErrorBody(),
+			Field:   err.Field,
+		})
+	}
+}
+
+func (r *LintResult) addCauses(phase LintPhase, causes ...metav1.StatusCause) {
+	if len(causes) == 0 {
+		return
+	}
+	if r.Errors == nil {
+		r.Errors = map[LintPhase][]metav1.StatusCause{}
+	}
+	r.Errors[phase] = append(r.Errors[phase], causes...)
+}
+
+func (r *LintResult) addWarnings(phase LintPhase, warnings ...string) {
+	if len(warnings) == 0 {
+		return
+	}
+	if r.Warnings == nil {
+		r.Warnings = map[LintPhase][]string{}
+	}
+	r.Warnings[phase] = append(r.Warnings[phase], warnings...)
+}
+
+// defaultedFields collects the values of the fields in obj which are unset or null in orig.
+func defaultedFields(fldPath *field.Path, orig, obj interface{}, ret map[string]interface{}) {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		orig, _ := orig.(map[string]interface{})
+		for k, v := range obj {
+			if origV, found := orig[k]; !found || (origV == nil && v != nil) {
+				ret[fldPath.Child(k).String()] = v
+			} else {
+				defaultedFields(fldPath.Child(k), origV, v, ret)
+			}
+		}
+	case []interface{}:
+		orig, _ := orig.([]interface{})
+		if len(orig) != len(obj) {
+			return
+		}
+		for i := range obj {
+			defaultedFields(fldPath.Index(i), orig[i], obj[i], ret)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+)
+
+// fakeCreater checks that creates are dry runs in the namespace of the object, and fails them
+// with err.
+type fakeCreater struct {
+	t   *testing.T
+	err error
+}
+
+func (f fakeCreater) New() runtime.Object {
+	return &unstructured.Unstructured{}
+}
+
+func (f fakeCreater) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if len(options.DryRun) != 1 || options.DryRun[0] != metav1.DryRunAll {
+		f.t.Errorf("expected a dry run, got %v", options.DryRun)
+	}
+	if ns, _ := genericapirequest.NamespaceFrom(ctx); ns != obj.(*unstructured.Unstructured).GetNamespace() {
+		f.t.Errorf("expected namespace %q in the context, got %q", obj.(*unstructured.Unstructured).GetNamespace(), ns)
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return obj, nil
+}
+
+func TestLint(t *testing.T) {
+	minimum := float64(0)
+	defaultMode := apiextensions.JSON("auto")
+	deprecated := "use mode instead"
+	props := &apiextensions.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensions.JSONSchemaProps{
+			"spec": {
+				Type: "object",
+				Properties: map[string]apiextensions.JSONSchemaProps{
+					"replicas": {Type: "integer", Minimum: &minimum},
+					"mode":     {Type: "string", Default: &defaultMode},
+					"old":      {Type: "string", XDeprecated: &deprecated},
+				},
+			},
+		},
+	}
+	s, err := structuralschema.NewStructural(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, _, err := apiservervalidation.NewSchemaValidator(&apiextensions.CustomResourceValidation{OpenAPIV3Schema: props})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	strategy := NewStrategy(nil, true, kind, validator, nil, map[string]*structuralschema.Structural{"v1": s}, nil, nil, nil, nil, nil, nil, apiservervalidation.DefaultFormatRegistry)
+
+	tests := []struct {
+		name                  string
+		preserveUnknownFields bool
+		obj                   string
+		expectedObject        string
+		expectedPruned        []string
+		expectedDefaulted     map[string]interface{}
+		expectedErrors        map[LintPhase][]string
+		expectedWarnings      map[LintPhase]int
+		createErr             error
+	}{
+		{
+			name:              "valid",
+			obj:               `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default"},"spec":{"replicas":1}}`,
+			expectedObject:    `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default","generation":1},"spec":{"replicas":1,"mode":"auto"}}`,
+			expectedDefaulted: map[string]interface{}{"spec.mode": "auto"},
+		},
+		{
+			name:           "pruned, invalid and deprecated fields",
+			obj:            `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default"},"spec":{"replicas":-1,"mode":"manual","old":"x","unknown":true}}`,
+			expectedObject: `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default","generation":1},"spec":{"replicas":-1,"mode":"manual","old":"x"}}`,
+			expectedPruned: []string{"spec.unknown"},
+			expectedErrors: map[LintPhase][]string{
+				LintPhaseSchema: {"spec.replicas"},
+			},
+			expectedWarnings: map[LintPhase]int{
+				LintPhaseDeprecations: 1,
+			},
+		},
+		{
+			name:                  "unknown fields are preserved",
+			preserveUnknownFields: true,
+			obj:                   `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default"},"spec":{"mode":"manual","unknown":true}}`,
+			expectedObject:        `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default","generation":1},"spec":{"mode":"manual","unknown":true}}`,
+		},
+		{
+			name:           "invalid metadata",
+			obj:            `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"Foo_","namespace":"default"},"spec":{"mode":"manual"}}`,
+			expectedObject: `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"Foo_","namespace":"default","generation":1},"spec":{"mode":"manual"}}`,
+			expectedErrors: map[LintPhase][]string{
+				LintPhaseMetadata: {"metadata.name"},
+			},
+		},
+		{
+			name:           "name exists",
+			obj:            `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default"},"spec":{"mode":"manual"}}`,
+			expectedObject: `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default","generation":1},"spec":{"mode":"manual"}}`,
+			createErr:      apierrors.NewAlreadyExists(schema.GroupResource{Group: "example.com", Resource: "widgets"}, "foo"),
+			expectedErrors: map[LintPhase][]string{
+				LintPhaseCreate: {""},
+			},
+		},
+		{
+			name:           "invalid on create only",
+			obj:            `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default"},"spec":{"mode":"manual"}}`,
+			expectedObject: `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"foo","namespace":"default","generation":1},"spec":{"mode":"manual"}}`,
+			createErr: apierrors.NewInvalid(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "foo", field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "mode"), "must not be set by this user"),
+			}),
+			expectedErrors: map[LintPhase][]string{
+				LintPhaseCreate: {"spec.mode"},
+			},
+		},
+		{
+			name:           "wrong kind",
+			obj:            `{"apiVersion":"example.com/v1","kind":"Gadget","metadata":{"name":"foo","namespace":"default"},"spec":{"unknown":true}}`,
+			expectedObject: `{"apiVersion":"example.com/v1","kind":"Gadget","metadata":{"name":"foo","namespace":"default"},"spec":{"unknown":true}}`,
+			expectedErrors: map[LintPhase][]string{
+				LintPhaseTypeMeta: {"kind"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(tt.obj), &obj.Object); err != nil {
+				t.Fatal(err)
+			}
+			var expectedObject map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.expectedObject), &expectedObject); err != nil {
+				t.Fatal(err)
+			}
+
+			result := NewLintREST(strategy, fakeCreater{t: t, err: tt.createErr}, tt.preserveUnknownFields).Lint(context.TODO(), obj)
+
+			if !reflect.DeepEqual(result.Object, expectedObject) {
+				t.Errorf("expected object %v, got %v", expectedObject, result.Object)
+			}
+			if !reflect.DeepEqual(result.Pruned, tt.expectedPruned) {
+				t.Errorf("expected pruned %v, got %v", tt.expectedPruned, result.Pruned)
+			}
+			if len(result.Defaulted) > 0 || len(tt.expectedDefaulted) > 0 {
+				if !reflect.DeepEqual(result.Defaulted, tt.expectedDefaulted) {
+					t.Errorf("expected defaulted %v, got %v", tt.expectedDefaulted, result.Defaulted)
+				}
+			}
+
+			errors := map[LintPhase][]string{}
+			for phase, causes := range result.Errors {
+				for _, cause := range causes {
+					errors[phase] = append(errors[phase], cause.Field)
+				}
+			}
+			if len(errors) > 0 || len(tt.expectedErrors) > 0 {
+				if !reflect.DeepEqual(errors, tt.expectedErrors) {
+					t.Errorf("expected errors for %v, got %v", tt.expectedErrors, result.Errors)
+				}
+			}
+
+			warnings := map[LintPhase]int{}
+			for phase, ws := range result.Warnings {
+				warnings[phase] = len(ws)
+			}
+			if len(warnings) > 0 || len(tt.expectedWarnings) > 0 {
+				if !reflect.DeepEqual(warnings, tt.expectedWarnings) {
+					t.Errorf("expected warnings %v, got %v", tt.expectedWarnings, result.Warnings)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultedFields(t *testing.T) {
+	var orig, obj interface{}
+	if err := json.Unmarshal([]byte(`{"a":{"b":1,"c":null},"list":[{"x":1},{}],"other":[1]}`), &orig); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{"a":{"b":1,"c":2,"d":{"e":3}},"list":[{"x":1},{"y":2}],"other":[1,2],"new":"foo"}`), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]interface{}{}
+	defaultedFields(nil, orig, obj, got)
+	expected := map[string]interface{}{
+		"a.c":       int64(2),
+		"a.d":       map[string]interface{}{"e": int64(3)},
+		"list[1].y": int64(2),
+		"new":       "foo",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
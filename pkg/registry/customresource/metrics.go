@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var (
+	deprecatedFieldUsageCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "apiextensions_deprecated_field_usage_total",
+			Help:           "Counter of writes of custom resources, except dry runs, storing a field marked with x-kubernetes-deprecated, broken down by group, version, kind and field.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "version", "kind", "field"},
+	)
+	objectLimitRejectionsCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "apiextensions_object_limit_rejections_total",
+			Help:           "Counter of creates and updates of custom resources forbidden by the limits of their CustomResourceDefinition, broken down by group, resource and limit.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource", "limit"},
+	)
+	customResourceObjectsGauge = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Name:           "apiextensions_custom_resource_objects",
+			Help:           "Gauge of the number of custom resources in the watch cache, broken down by group and resource. Only reported for CustomResourceDefinitions with a limit on the number of objects.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource"},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(deprecatedFieldUsageCounter)
+	legacyregistry.MustRegister(objectLimitRejectionsCounter)
+	legacyregistry.MustRegister(customResourceObjectsGauge)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+const (
+	// ProjectAsTable is the FieldProjection target of requests served as tables.
+	ProjectAsTable = "Table"
+	// ProjectAsPartialObjectMetadata is the FieldProjection target of requests served as partial
+	// object metadata.
+	ProjectAsPartialObjectMetadata = "PartialObjectMetadata"
+)
+
+// essentialMetadataFields are the fields of the ObjectMeta of the custom resources which are kept
+// by every projection.
+var essentialMetadataFields = []string{"name", "namespace", "uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "labels"}
+
+// metadataFields holds the descriptions of the fields of ObjectMeta by name.
+var metadataFields = metav1.ObjectMeta{}.SwaggerDoc()
+
+// FieldProjection projects the custom resources read through REST to some of their fields.
+type FieldProjection struct {
+	// Paths are the paths of the projected fields in the dot notation, e.g. `spec.replicas`.
+	Paths []string
+	// As is the kind the custom resources are served as, if any. The cells of tables are computed
+	// from the whole custom resources, and only the objects of their rows are projected. Partial
+	// object metadata is not projected.
+	As string
+}
+
+type fieldProjectionKeyType int
+
+const fieldProjectionKey fieldProjectionKeyType = iota
+
+// WithFieldProjection returns a copy of ctx in which the custom resources got or listed through REST
+// are projected to the fields of projection, along with their apiVersion, kind and the essential
+// fields of their metadata.
+func WithFieldProjection(ctx context.Context, projection FieldProjection) context.Context {
+	return context.WithValue(ctx, fieldProjectionKey, projection)
+}
+
+// fieldProjection is a FieldProjection validated against the structural schema of the version.
+type fieldProjection struct {
+	fields [][]string
+	as     string
+}
+
+// fieldProjectionFrom returns the field projection of ctx, or nil if ctx has none. It returns
+// BadRequest if a path is not a field of the custom resources.
+func (e *REST) fieldProjectionFrom(ctx context.Context) (*fieldProjection, error) {
+	projection, ok := ctx.Value(fieldProjectionKey).(FieldProjection)
+	if !ok {
+		return nil, nil
+	}
+	if len(projection.Paths) == 0 {
+		return nil, apierrors.NewBadRequest("at least one field to project is required")
+	}
+	p := &fieldProjection{as: projection.As}
+	for _, path := range projection.Paths {
+		fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+		if err := validateProjectionPath(e.structural, fields); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("cannot project %q: %v", path, err))
+		}
+		p.fields = append(p.fields, fields)
+	}
+	return p, nil
+}
+
+// validateProjectionPath checks that fields is the path of a field of the custom resources with the
+// structural schema s, which does not go through lists.
+func validateProjectionPath(s *structuralschema.Structural, fields []string) error {
+	for _, field := range fields {
+		if len(field) == 0 {
+			return fmt.Errorf("must be in the dot notation")
+		}
+	}
+	switch fields[0] {
+	case "apiVersion", "kind":
+		if len(fields) > 1 {
+			return fmt.Errorf("%s has no fields", fields[0])
+		}
+		return nil
+	case "metadata":
+		if len(fields) == 1 {
+			return nil
+		}
+		if _, ok := metadataFields[fields[1]]; !ok || len(fields) > 2 {
+			return fmt.Errorf("only whole fields of the metadata can be projected")
+		}
+		return nil
+	}
+
+	if s == nil {
+		return fmt.Errorf("the version has no structural schema")
+	}
+	for i, field := range fields {
+		if s.Type == "array" {
+			return fmt.Errorf("%s is a list, which can only be projected as a whole", strings.Join(fields[:i], "."))
+		}
+		if s.XEmbeddedResource && (field == "apiVersion" || field == "kind" || field == "metadata") {
+			return nil
+		}
+		if prop, ok := s.Properties[field]; ok {
+			s = &prop
+			continue
+		}
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+			s = s.AdditionalProperties.Structural
+			continue
+		}
+		if s.XPreserveUnknownFields || (s.AdditionalProperties != nil && s.AdditionalProperties.Bool) {
+			return nil
+		}
+		return fmt.Errorf("%s is not a field of the schema", strings.Join(fields[:i+1], "."))
+	}
+	return nil
+}
+
+// project returns u with only the projected fields, its apiVersion, kind and the essential fields of
+// its metadata. The values are shared with u.
+func (p *fieldProjection) project(u *unstructured.Unstructured) *unstructured.Unstructured {
+	out := map[string]interface{}{}
+	for _, field := range []string{"apiVersion", "kind"} {
+		if value, ok := u.Object[field]; ok {
+			out[field] = value
+		}
+	}
+	if metadata, ok := u.Object["metadata"].(map[string]interface{}); ok {
+		projected := make(map[string]interface{}, len(essentialMetadataFields))
+		for _, field := range essentialMetadataFields {
+			if value, ok := metadata[field]; ok {
+				projected[field] = value
+			}
+		}
+		out["metadata"] = projected
+	}
+	for _, fields := range p.fields {
+		if value, found, err := unstructured.NestedFieldNoCopy(u.Object, fields...); err == nil && found {
+			setNestedFieldNoCopy(out, value, fields)
+		}
+	}
+	return &unstructured.Unstructured{Object: out}
+}
+
+// setNestedFieldNoCopy sets value at the path of fields in obj, creating the maps on the path which
+// are missing. Like for metadata, the maps on the path are copied if they exist.
+func setNestedFieldNoCopy(obj map[string]interface{}, value interface{}, fields []string) {
+	m := obj
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := m[field].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			m[field] = next
+		}
+		m = next
+	}
+	m[fields[len(fields)-1]] = value
+}
+
+// projectList replaces the items of ul by their projection.
+func (p *fieldProjection) projectList(ul *unstructured.UnstructuredList) {
+	for i := range ul.Items {
+		ul.Items[i] = *p.project(&ul.Items[i])
+	}
+}
+
+// ConvertToTable converts the custom resources of object to a table. If ctx has a field projection
+// to tables, the objects included in the rows are projected.
+func (e *REST) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table, err := e.Store.ConvertToTable(ctx, object, tableOptions)
+	if err != nil {
+		return nil, err
+	}
+	p, err := e.fieldProjectionFrom(ctx)
+	if err != nil || p == nil || p.as != ProjectAsTable {
+		return table, err
+	}
+	if options, ok := tableOptions.(*metav1.TableOptions); ok && options.IncludeObject != metav1.IncludeObject {
+		return table, nil
+	}
+	for i := range table.Rows {
+		if u, ok := table.Rows[i].Object.Object.(*unstructured.Unstructured); ok {
+			table.Rows[i].Object.Object = p.project(u)
+		}
+	}
+	return table, nil
+}
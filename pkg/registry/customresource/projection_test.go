@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+func TestValidateProjectionPath(t *testing.T) {
+	s := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"spec": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"replicas": {Generic: structuralschema.Generic{Type: "integer"}},
+					"list": {
+						Generic: structuralschema.Generic{Type: "array"},
+						Items:   &structuralschema.Structural{Generic: structuralschema.Generic{Type: "object"}},
+					},
+					"map": {
+						Generic: structuralschema.Generic{
+							Type:                 "object",
+							AdditionalProperties: &structuralschema.StructuralOrBool{Structural: &structuralschema.Structural{Generic: structuralschema.Generic{Type: "string"}}},
+						},
+					},
+					"unknown": {
+						Generic:    structuralschema.Generic{Type: "object"},
+						Extensions: structuralschema.Extensions{XPreserveUnknownFields: true},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		path  string
+		valid bool
+	}{
+		{path: "spec.replicas", valid: true},
+		{path: ".spec.replicas", valid: true},
+		{path: "spec", valid: true},
+		{path: "spec.list", valid: true},
+		{path: "spec.map.key", valid: true},
+		{path: "spec.unknown.any.field", valid: true},
+		{path: "kind", valid: true},
+		{path: "metadata", valid: true},
+		{path: "metadata.annotations", valid: true},
+		{path: "spec.missing"},
+		{path: "spec.list.field"},
+		{path: "spec..replicas"},
+		{path: "kind.field"},
+		{path: "metadata.unknown"},
+		{path: "metadata.labels.app"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := validateProjectionPath(s, strings.Split(strings.TrimPrefix(tt.path, "."), "."))
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !tt.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	if err := validateProjectionPath(nil, []string{"spec", "replicas"}); err == nil {
+		t.Errorf("expected an error projecting spec fields without structural schema")
+	}
+}
+
+func TestProject(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "mygroup.example.com/v1",
+		"kind":       "Noxu",
+		"metadata": map[string]interface{}{
+			"name":            "foo",
+			"namespace":       "default",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "noxu"},
+			"annotations":     map[string]interface{}{"large": "annotation"},
+			"managedFields":   []interface{}{},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{"large": "template"},
+		},
+		"status": map[string]interface{}{
+			"phase":      "Running",
+			"conditions": []interface{}{},
+		},
+	}}
+	p := &fieldProjection{fields: [][]string{{"spec", "replicas"}, {"status", "phase"}, {"spec", "missing"}}}
+
+	expected := map[string]interface{}{
+		"apiVersion": "mygroup.example.com/v1",
+		"kind":       "Noxu",
+		"metadata": map[string]interface{}{
+			"name":            "foo",
+			"namespace":       "default",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "noxu"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+		},
+	}
+	if projected := p.project(u); !reflect.DeepEqual(projected.Object, expected) {
+		t.Errorf("expected %v, got %v", expected, projected.Object)
+	}
+	if _, ok := u.Object["spec"].(map[string]interface{})["template"]; !ok {
+		t.Errorf("expected the projected custom resource to be unchanged")
+	}
+}
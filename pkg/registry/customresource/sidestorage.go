@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+)
+
+// sideStorage stores custom resources of a CustomResourceDefinition outside of their own storage,
+// under the resource prefix followed by a suffix. Plural names cannot contain dots, so a suffix
+// starting with a dot does not collide with the prefix of another resource.
+//
+// The storage is created on first use, so a side storage costs nothing until it is needed.
+type sideStorage struct {
+	resource    schema.GroupResource
+	suffix      string
+	optsGetter  generic.RESTOptionsGetter
+	attrFunc    storage.AttrFunc
+	newFunc     func() runtime.Object
+	newListFunc func() runtime.Object
+	// keyFunc returns the key of an object below prefix.
+	keyFunc func(prefix string, obj runtime.Object) (string, error)
+
+	lock    sync.Mutex
+	prefix  string
+	storage storage.Interface
+	destroy factory.DestroyFunc
+}
+
+func newSideStorage(resource schema.GroupResource, suffix string, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, store *genericregistry.Store, keyFunc func(prefix string, obj runtime.Object) (string, error)) *sideStorage {
+	return &sideStorage{
+		resource:    resource,
+		suffix:      suffix,
+		optsGetter:  optsGetter,
+		attrFunc:    strategy.GetAttrs,
+		newFunc:     store.NewFunc,
+		newListFunc: store.NewListFunc,
+		keyFunc:     keyFunc,
+	}
+}
+
+// get returns the storage and its key prefix, and creates the storage on first use.
+func (s *sideStorage) get() (storage.Interface, string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.storage != nil {
+		return s.storage, s.prefix, nil
+	}
+	opts, err := s.optsGetter.GetRESTOptions(s.resource)
+	if err != nil {
+		return nil, "", err
+	}
+	prefix := opts.ResourcePrefix
+	if !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	prefix += s.suffix
+	keyFunc := func(obj runtime.Object) (string, error) {
+		return s.keyFunc(prefix, obj)
+	}
+	st, destroy, err := opts.Decorator(opts.StorageConfig, prefix, keyFunc, s.newFunc, s.newListFunc, s.attrFunc, nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	s.prefix, s.storage, s.destroy = prefix, st, destroy
+	return st, prefix, nil
+}
+
+// destroyStorage destroys the storage if it was created.
+func (s *sideStorage) destroyStorage() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.destroy != nil {
+		s.destroy()
+	}
+	s.storage, s.destroy = nil, nil
+}
+
+// nameKey returns the key below prefix under which the objects of the named custom resource are stored.
+func nameKey(prefix string, namespaceScoped bool, namespace, name string) string {
+	if namespaceScoped {
+		return prefix + "/" + namespace + "/" + name
+	}
+	return prefix + "/" + name
+}
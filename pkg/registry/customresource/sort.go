@@ -0,0 +1,375 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+)
+
+const (
+	// sortSnapshotCacheSize is the number of sorted lists kept for each version to serve their
+	// continue pages.
+	sortSnapshotCacheSize = 10
+	// sortSnapshotTTL is how long a sorted list is kept after its first page.
+	sortSnapshotTTL = 5 * time.Minute
+)
+
+// SortOptions orders the custom resources returned by a list request by a value.
+type SortOptions struct {
+	// Path is the JSON path of the value, e.g. `.spec.replicas`. It must be sortable.
+	Path string
+	// Descending reverses the order. Custom resources without the value come last in both orders.
+	Descending bool
+}
+
+type sortOptionsKeyType int
+
+const sortOptionsKey sortOptionsKeyType = iota
+
+// WithSortOptions returns a copy of ctx in which the custom resources listed by REST.List are sorted.
+func WithSortOptions(ctx context.Context, options SortOptions) context.Context {
+	return context.WithValue(ctx, sortOptionsKey, options)
+}
+
+func sortOptionsFrom(ctx context.Context) (SortOptions, bool) {
+	options, ok := ctx.Value(sortOptionsKey).(SortOptions)
+	return options, ok
+}
+
+// splitColumnPaths returns the paths in the dot notation among paths, split into fields. Lists can
+// be sorted and grouped by them.
+func splitColumnPaths(paths []string) map[string][]string {
+	ret := map[string][]string{}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, ".") || path == "." || strings.ContainsAny(path, "[]") {
+			continue
+		}
+		ret[path] = strings.Split(strings.TrimPrefix(path, "."), ".")
+	}
+	return ret
+}
+
+// unknownColumnPath returns the error of a request to sort or group by a path which is not in the dot
+// notation or not a printer column.
+func (e *REST) unknownColumnPath(verb, path string) error {
+	if len(e.columnPaths) == 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("cannot %s by %q: no printer column paths", verb, path))
+	}
+	paths := make([]string, 0, len(e.columnPaths))
+	for path := range e.columnPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return apierrors.NewBadRequest(fmt.Sprintf("cannot %s by %q: possible paths are %s", verb, path, strings.Join(paths, ", ")))
+}
+
+// sortContinueToken is the continue token of sorted lists. Sorted lists are paged by the position of
+// the last returned custom resource in the list of the first page, so all pages are consistent.
+// The list is kept in memory for a while to serve the continue pages. Otherwise, e.g. when the
+// continue page is served by another server, the custom resources are listed again at the
+// resourceVersion of the first page, and the list fails with 410 Expired once that resourceVersion
+// is compacted.
+type sortContinueToken struct {
+	ResourceVersion string      `json:"rv"`
+	Path            string      `json:"path"`
+	Descending      bool        `json:"desc,omitempty"`
+	Key             interface{} `json:"key,omitempty"`
+	Namespace       string      `json:"ns,omitempty"`
+	Name            string      `json:"name"`
+}
+
+func (t *sortContinueToken) encode() (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSortContinueToken(s string) (*sortContinueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	t := &sortContinueToken{}
+	// the JSON utilities decode integers as int64, like in custom resources
+	if err := utiljson.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// sortSnapshotKey identifies a sorted list at a resourceVersion by the options of its request.
+type sortSnapshotKey struct {
+	namespace       string
+	labelSelector   string
+	fieldSelector   string
+	celSelector     string
+	resourceVersion string
+	path            string
+	descending      bool
+}
+
+// sortSnapshot is a sorted list of custom resources.
+type sortSnapshot struct {
+	// object holds the fields of the list other than the items
+	object  map[string]interface{}
+	items   []unstructured.Unstructured
+	entries []sortEntry
+}
+
+// sortedList lists the custom resources matching options and selector, if not nil, sorts them and
+// returns the page after the continue token of options. The first page is listed with the
+// resourceVersion semantics of options, and the following pages are served from the sorted list of
+// the first page if it is still kept.
+func (e *REST) sortedList(ctx context.Context, options *metainternalversion.ListOptions, sortOptions SortOptions, selector *cel.Selector) (runtime.Object, error) {
+	fields, ok := e.columnPaths[sortOptions.Path]
+	if !ok {
+		return nil, e.unknownColumnPath("sort", sortOptions.Path)
+	}
+
+	var token *sortContinueToken
+	if len(options.Continue) > 0 {
+		var err error
+		if token, err = decodeSortContinueToken(options.Continue); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		if token.Path != sortOptions.Path || token.Descending != sortOptions.Descending {
+			return nil, apierrors.NewBadRequest("the continue token was issued for a different sort order")
+		}
+	}
+
+	key := sortSnapshotKey{namespace: genericapirequest.NamespaceValue(ctx), path: sortOptions.Path, descending: sortOptions.Descending}
+	if options.LabelSelector != nil {
+		key.labelSelector = options.LabelSelector.String()
+	}
+	if options.FieldSelector != nil {
+		key.fieldSelector = options.FieldSelector.String()
+	}
+	key.celSelector, _ = ctx.Value(celSelectorKey).(string)
+
+	var snapshot *sortSnapshot
+	if token != nil {
+		key.resourceVersion = token.ResourceVersion
+		if cached, ok := e.sortSnapshots.Get(key); ok {
+			snapshot = cached.(*sortSnapshot)
+		}
+	}
+	if snapshot == nil {
+		listOptions := options.DeepCopy()
+		listOptions.Limit = 0
+		listOptions.Continue = ""
+		if token != nil {
+			listOptions.ResourceVersion = token.ResourceVersion
+			listOptions.ResourceVersionMatch = metav1.ResourceVersionMatchExact
+		}
+		l, err := e.Store.List(ctx, listOptions)
+		if token != nil && (apierrors.IsResourceExpired(err) || apierrors.IsGone(err)) {
+			return nil, apierrors.NewResourceExpired(fmt.Sprintf("the resourceVersion %s of the continue token is too old, the sorted list must be restarted without continue token", token.ResourceVersion))
+		} else if err != nil {
+			return nil, err
+		}
+		ul, ok := l.(*unstructured.UnstructuredList)
+		if !ok {
+			return l, nil
+		}
+		if selector != nil {
+			if err := filterCELSelector(selector, ul); err != nil {
+				return nil, err
+			}
+		}
+		snapshot = newSortSnapshot(ul, fields, sortOptions.Descending)
+		if token == nil && options.Limit > 0 && int64(len(snapshot.items)) > options.Limit {
+			key.resourceVersion = ul.GetResourceVersion()
+			e.sortSnapshots.Add(key, snapshot, sortSnapshotTTL)
+		}
+	}
+
+	entries, items := snapshot.entries, snapshot.items
+	if token != nil {
+		after := &sortEntry{key: token.Key, namespace: token.Namespace, name: token.Name}
+		start := sort.Search(len(entries), func(i int) bool {
+			return after.less(&entries[i], sortOptions.Descending)
+		})
+		entries, items = entries[start:], items[start:]
+	}
+
+	ul := &unstructured.UnstructuredList{Object: runtime.DeepCopyJSON(snapshot.object)}
+	ul.SetContinue("")
+	ul.SetRemainingItemCount(nil)
+	if options.Limit > 0 && int64(len(entries)) > options.Limit {
+		last := &entries[options.Limit-1]
+		next := &sortContinueToken{
+			ResourceVersion: ul.GetResourceVersion(),
+			Path:            sortOptions.Path,
+			Descending:      sortOptions.Descending,
+			Key:             last.key,
+			Namespace:       last.namespace,
+			Name:            last.name,
+		}
+		continueValue, err := next.encode()
+		if err != nil {
+			return nil, apierrors.NewInternalError(err)
+		}
+		remaining := int64(len(entries)) - options.Limit
+		ul.SetContinue(continueValue)
+		ul.SetRemainingItemCount(&remaining)
+		items = items[:options.Limit]
+	}
+	// the items of the snapshot are shared by its pages, which are copied by List
+	ul.Items = append([]unstructured.Unstructured(nil), items...)
+
+	return ul, nil
+}
+
+// newSortSnapshot sorts the custom resources of ul by the value of fields.
+func newSortSnapshot(ul *unstructured.UnstructuredList, fields []string, descending bool) *sortSnapshot {
+	entries := make([]sortEntry, len(ul.Items))
+	for i := range ul.Items {
+		key, _, _ := unstructured.NestedFieldNoCopy(ul.Items[i].Object, fields...)
+		entries[i] = sortEntry{key: key, namespace: ul.Items[i].GetNamespace(), name: ul.Items[i].GetName(), index: i}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].less(&entries[j], descending)
+	})
+	items := make([]unstructured.Unstructured, len(entries))
+	for i, entry := range entries {
+		items[i] = ul.Items[entry.index]
+	}
+	return &sortSnapshot{object: ul.Object, items: items, entries: entries}
+}
+
+// sortEntry is a listed custom resource with its sort key.
+type sortEntry struct {
+	key             interface{}
+	namespace, name string
+	// index is the index of the custom resource in the list
+	index int
+}
+
+// less orders entries by key, then by namespace and name.
+func (e *sortEntry) less(other *sortEntry, descending bool) bool {
+	if c := compareSortKeys(e.key, other.key, descending); c != 0 {
+		return c < 0
+	}
+	if e.namespace != other.namespace {
+		return e.namespace < other.namespace
+	}
+	return e.name < other.name
+}
+
+// compareSortKeys compares two values of custom resources. Missing values come last. Values of
+// different types are ordered by type: booleans, numbers, strings, then other values by their JSON.
+func compareSortKeys(a, b interface{}, descending bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	c := compareValues(a, b)
+	if descending {
+		return -c
+	}
+	return c
+}
+
+func compareValues(a, b interface{}) int {
+	if ra, rb := sortRank(a), sortRank(b); ra != rb {
+		return ra - rb
+	}
+	switch a := a.(type) {
+	case bool:
+		switch b := b.(bool); {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+		return compareFloats(float64(a), toFloat(b))
+	case float64:
+		return compareFloats(a, toFloat(b))
+	case string:
+		return strings.Compare(a, b.(string))
+	}
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+	return strings.Compare(string(aJSON), string(bJSON))
+}
+
+func sortRank(v interface{}) int {
+	switch v.(type) {
+	case bool:
+		return 0
+	case int64, float64:
+		return 1
+	case string:
+		return 2
+	}
+	return 3
+}
+
+func toFloat(v interface{}) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource_test
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"k8s.io/apiextensions-apiserver/pkg/registry/customresource"
+)
+
+func TestSortedList(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.CustomResource.Store.DestroyFunc()
+
+	for name, replicas := range map[string]interface{}{"a": int64(3), "b": int64(1), "c": int64(3), "d": nil} {
+		cr := validNewCustomResource()
+		cr.SetName(name)
+		if replicas == nil {
+			unstructured.RemoveNestedField(cr.Object, "spec", "replicas")
+		} else {
+			unstructured.SetNestedField(cr.Object, replicas, "spec", "replicas")
+		}
+		if _, err := createCustomResource(storage.CustomResource, *cr, t); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list := func(sortOptions customresource.SortOptions, limit int64) []string {
+		ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault)
+		ctx = customresource.WithSortOptions(ctx, sortOptions)
+		var names []string
+		options := &metainternalversion.ListOptions{Limit: limit}
+		for {
+			obj, err := storage.CustomResource.List(ctx, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ul := obj.(*unstructured.UnstructuredList)
+			if int64(len(ul.Items)) > limit {
+				t.Fatalf("expected at most %d items, got %d", limit, len(ul.Items))
+			}
+			for _, item := range ul.Items {
+				names = append(names, item.GetName())
+			}
+			if len(ul.GetContinue()) == 0 {
+				return names
+			}
+			options = &metainternalversion.ListOptions{Limit: limit, Continue: ul.GetContinue()}
+		}
+	}
+
+	if names := list(customresource.SortOptions{Path: ".spec.replicas"}, 1); !reflect.DeepEqual(names, []string{"b", "a", "c", "d"}) {
+		t.Errorf("expected ascending order by replicas then name, missing last, got %v", names)
+	}
+	if names := list(customresource.SortOptions{Path: ".spec.replicas", Descending: true}, 3); !reflect.DeepEqual(names, []string{"a", "c", "b", "d"}) {
+		t.Errorf("expected descending order by replicas then name, missing last, got %v", names)
+	}
+
+	// pages after the first are listed at the resourceVersion of the first page
+	ctx := customresource.WithSortOptions(genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault), customresource.SortOptions{Path: ".spec.replicas"})
+	obj, err := storage.CustomResource.List(ctx, &metainternalversion.ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := validNewCustomResource()
+	cr.SetName("e")
+	unstructured.SetNestedField(cr.Object, int64(2), "spec", "replicas")
+	if _, err := createCustomResource(storage.CustomResource, *cr, t); err != nil {
+		t.Fatal(err)
+	}
+	obj, err = storage.CustomResource.List(ctx, &metainternalversion.ListOptions{Limit: 10, Continue: obj.(*unstructured.UnstructuredList).GetContinue()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, item := range obj.(*unstructured.UnstructuredList).Items {
+		names = append(names, item.GetName())
+	}
+	if expected := []string{"a", "c", "d"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected the second page %v without the custom resource created after the first page, got %v", expected, names)
+	}
+	if names := list(customresource.SortOptions{Path: ".spec.replicas"}, 2); !reflect.DeepEqual(names, []string{"b", "e", "a", "c", "d"}) {
+		t.Errorf("expected a new sorted list to include the custom resource created last, got %v", names)
+	}
+
+	ctx = customresource.WithSortOptions(genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault), customresource.SortOptions{Path: ".spec.stringList"})
+	if _, err := storage.CustomResource.List(ctx, &metainternalversion.ListOptions{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected BadRequest sorting by a path which is not a printer column, got %v", err)
+	}
+}
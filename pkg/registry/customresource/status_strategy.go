@@ -19,6 +19,7 @@ package customresource
 import (
 	"context"
 
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/conditions"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -77,6 +78,12 @@ func (a statusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.O
 	} else {
 		delete(newCustomResource, "status")
 	}
+
+	a.pruneAndDefaultEmbeddedResources(obj)
+	a.setConditionTransitionTimes(old, obj)
+	if v := newCustomResourceObject.GroupVersionKind().Version; a.conditions[v] {
+		conditions.SetObservedGenerations(a.structuralSchemas[v], newCustomResource, newCustomResourceObject.GetGeneration())
+	}
 }
 
 // ValidateUpdate is the default update validation for an end user updating status.
@@ -87,13 +94,14 @@ func (a statusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Obj
 	// validate embedded resources
 	if u, ok := obj.(*unstructured.Unstructured); ok {
 		v := obj.GetObjectKind().GroupVersionKind().Version
+		errs = append(errs, a.customResourceStrategy.validateEmbeddedResources(obj)...)
 
 		// validate x-kubernetes-validations rules
 		if celValidator, ok := a.customResourceStrategy.celValidators[v]; ok {
 			errs = append(errs, celValidator.Validate(nil, a.customResourceStrategy.structuralSchemas[v], u.Object)...)
 		}
 	}
-	return errs
+	return a.customResourceStrategy.redactErrors(obj, errs)
 }
 
 // WarningsOnUpdate returns warnings for the given update.
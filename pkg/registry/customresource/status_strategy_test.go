@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -136,3 +137,130 @@ func TestPrepareForUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestPrepareForUpdateConditions(t *testing.T) {
+	observedGeneration := "observedGeneration"
+	strategy := statusStrategy{customResourceStrategy{
+		structuralSchemas: map[string]*structuralschema.Structural{
+			"v1": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"status": {
+						Generic: structuralschema.Generic{Type: "object"},
+						Properties: map[string]structuralschema.Structural{
+							"conditions": {
+								Generic:    structuralschema.Generic{Type: "array"},
+								Extensions: structuralschema.Extensions{XConditions: &observedGeneration},
+								Items: &structuralschema.Structural{
+									Generic: structuralschema.Generic{Type: "object"},
+									Properties: map[string]structuralschema.Structural{
+										"type":               {Generic: structuralschema.Generic{Type: "string"}},
+										"status":             {Generic: structuralschema.Generic{Type: "string"}},
+										"lastTransitionTime": {Generic: structuralschema.Generic{Type: "string"}},
+										"observedGeneration": {Generic: structuralschema.Generic{Type: "integer"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		conditions: map[string]bool{"v1": true},
+	}}
+
+	condition := func(typ, status, lastTransitionTime string, observedGeneration int64) map[string]interface{} {
+		return map[string]interface{}{
+			"type":               typ,
+			"status":             status,
+			"lastTransitionTime": lastTransitionTime,
+			"observedGeneration": observedGeneration,
+		}
+	}
+	old := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   generation2(),
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					condition("Ready", "False", "2021-09-01T00:00:00Z", 1),
+					condition("Degraded", "False", "2021-09-01T00:00:00Z", 1),
+				},
+			},
+		},
+	}
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   generation2(),
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					condition("Ready", "True", "2000-01-01T00:00:00Z", 1),
+					condition("Degraded", "False", "2000-01-01T00:00:00Z", 1),
+				},
+			},
+		},
+	}
+
+	strategy.PrepareForUpdate(context.TODO(), obj, old)
+
+	got, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	ready, degraded := got[0].(map[string]interface{}), got[1].(map[string]interface{})
+	if ready["lastTransitionTime"] == "2000-01-01T00:00:00Z" || ready["lastTransitionTime"] == "2021-09-01T00:00:00Z" {
+		t.Errorf("expected lastTransitionTime of changed condition to be set to now, got %v", ready["lastTransitionTime"])
+	}
+	if degraded["lastTransitionTime"] != "2021-09-01T00:00:00Z" {
+		t.Errorf("expected lastTransitionTime of unchanged condition to be kept, got %v", degraded["lastTransitionTime"])
+	}
+	for _, c := range got {
+		if generation := c.(map[string]interface{})["observedGeneration"]; generation != int64(2) {
+			t.Errorf("expected observedGeneration 2, got %v", generation)
+		}
+	}
+
+	// conditions written through the main resource, without the status subresource, get transition
+	// times too, on update
+	obj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   generation2(),
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					condition("Ready", "True", "2000-01-01T00:00:00Z", 1),
+					condition("Degraded", "False", "2000-01-01T00:00:00Z", 1),
+				},
+			},
+		},
+	}
+	strategy.customResourceStrategy.PrepareForUpdate(context.TODO(), obj, old)
+	got, _, _ = unstructured.NestedSlice(obj.Object, "status", "conditions")
+	ready, degraded = got[0].(map[string]interface{}), got[1].(map[string]interface{})
+	if ready["lastTransitionTime"] == "2000-01-01T00:00:00Z" || ready["lastTransitionTime"] == "2021-09-01T00:00:00Z" {
+		t.Errorf("expected lastTransitionTime of condition changed through the main resource to be set to now, got %v", ready["lastTransitionTime"])
+	}
+	if degraded["lastTransitionTime"] != "2021-09-01T00:00:00Z" {
+		t.Errorf("expected lastTransitionTime of condition unchanged through the main resource to be kept, got %v", degraded["lastTransitionTime"])
+	}
+
+	// and on create
+	obj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					condition("Ready", "True", "2000-01-01T00:00:00Z", 1),
+				},
+			},
+		},
+	}
+	strategy.customResourceStrategy.PrepareForCreate(context.TODO(), obj)
+	got, _, _ = unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if ready := got[0].(map[string]interface{}); ready["lastTransitionTime"] == "2000-01-01T00:00:00Z" {
+		t.Errorf("expected lastTransitionTime of created condition to be set to now, got %v", ready["lastTransitionTime"])
+	}
+}
@@ -18,12 +18,20 @@ package customresource
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/conditions"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/deprecation"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/embedded"
 	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
 	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/reference"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/sensitive"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +44,7 @@ import (
 	"k8s.io/apiserver/pkg/features"
 	apiserverstorage "k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/apiserver/pkg/util/dryrun"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kube-openapi/pkg/validation/validate"
 
@@ -51,28 +60,66 @@ type customResourceStrategy struct {
 	validator         customResourceValidator
 	structuralSchemas map[string]*structuralschema.Structural
 	celValidators     map[string]*cel.Validator
+	deprecations      map[string]bool
+	sensitive         map[string]sensitive.Paths
+	conditions        map[string]bool
+	embeddedKinds     map[string]bool
+	embeddedResolver  embedded.Resolver
+	references        map[string]bool
+	referenceLister   reference.Lister
 	status            *apiextensions.CustomResourceSubresourceStatus
 	scale             *apiextensions.CustomResourceSubresourceScale
+	custom            []apiextensions.CustomResourceSubresourceCustom
+	generation        *apiextensions.CustomResourceGeneration
 	kind              schema.GroupVersionKind
+	// formats are the string formats supported by the server, which CEL expressions check
+	formats *apiservervalidation.FormatRegistry
 }
 
-func NewStrategy(typer runtime.ObjectTyper, namespaceScoped bool, kind schema.GroupVersionKind, schemaValidator, statusSchemaValidator *validate.SchemaValidator, structuralSchemas map[string]*structuralschema.Structural, status *apiextensions.CustomResourceSubresourceStatus, scale *apiextensions.CustomResourceSubresourceScale) customResourceStrategy {
+func NewStrategy(typer runtime.ObjectTyper, namespaceScoped bool, kind schema.GroupVersionKind, schemaValidator, statusSchemaValidator *validate.SchemaValidator, structuralSchemas map[string]*structuralschema.Structural, status *apiextensions.CustomResourceSubresourceStatus, scale *apiextensions.CustomResourceSubresourceScale, custom []apiextensions.CustomResourceSubresourceCustom, generation *apiextensions.CustomResourceGeneration, embeddedResolver embedded.Resolver, referenceLister reference.Lister, formats *apiservervalidation.FormatRegistry) customResourceStrategy {
 	celValidators := map[string]*cel.Validator{}
 	if utilfeature.DefaultFeatureGate.Enabled(features.CustomResourceValidationExpressions) {
 		for name, s := range structuralSchemas {
-			v := cel.NewValidator(s) // CEL programs are compiled and cached here
+			v := cel.NewValidatorWithFormats(s, formats) // CEL programs are compiled and cached here
 			if v != nil {
 				celValidators[name] = v
 			}
 		}
 	}
 
+	// versions with fields marked with x-kubernetes-deprecated, x-kubernetes-sensitive, x-kubernetes-conditions,
+	// x-kubernetes-embedded-resource-kinds or checked x-kubernetes-reference
+	deprecations := map[string]bool{}
+	sensitiveFields := map[string]sensitive.Paths{}
+	conditionLists := map[string]bool{}
+	embeddedKinds := map[string]bool{}
+	references := map[string]bool{}
+	for name, s := range structuralSchemas {
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return e.XDeprecated != nil }) {
+			deprecations[name] = true
+		}
+		if paths := sensitive.NewPaths(s); len(paths) > 0 {
+			sensitiveFields[name] = paths
+		}
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return e.XConditions != nil }) {
+			conditionLists[name] = true
+		}
+		if structuralschema.HasExtension(s, func(e *structuralschema.Extensions) bool { return len(e.XEmbeddedResourceKinds) > 0 }) {
+			embeddedKinds[name] = true
+		}
+		if structuralschema.HasExtension(s, reference.IsChecked) {
+			references[name] = true
+		}
+	}
+
 	return customResourceStrategy{
 		ObjectTyper:     typer,
 		NameGenerator:   names.SimpleNameGenerator,
 		namespaceScoped: namespaceScoped,
 		status:          status,
 		scale:           scale,
+		custom:          custom,
+		generation:      generation,
 		validator: customResourceValidator{
 			namespaceScoped:       namespaceScoped,
 			kind:                  kind,
@@ -81,7 +128,15 @@ func NewStrategy(typer runtime.ObjectTyper, namespaceScoped bool, kind schema.Gr
 		},
 		structuralSchemas: structuralSchemas,
 		celValidators:     celValidators,
+		deprecations:      deprecations,
+		sensitive:         sensitiveFields,
+		conditions:        conditionLists,
+		embeddedKinds:     embeddedKinds,
+		embeddedResolver:  embeddedResolver,
+		references:        references,
+		referenceLister:   referenceLister,
 		kind:              kind,
+		formats:           formats,
 	}
 }
 
@@ -115,6 +170,9 @@ func (a customResourceStrategy) PrepareForCreate(ctx context.Context, obj runtim
 
 	accessor, _ := meta.Accessor(obj)
 	accessor.SetGeneration(1)
+
+	a.pruneAndDefaultEmbeddedResources(obj)
+	a.setConditionTransitionTimes(nil, obj)
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
@@ -137,17 +195,152 @@ func (a customResourceStrategy) PrepareForUpdate(ctx context.Context, obj, old r
 		}
 	}
 
-	// except for the changes to `metadata`, any other changes
-	// cause the generation to increment.
-	newCopyContent := copyNonMetadata(newCustomResource)
-	oldCopyContent := copyNonMetadata(oldCustomResource)
-	if !apiequality.Semantic.DeepEqual(newCopyContent, oldCopyContent) {
+	a.pruneAndDefaultEmbeddedResources(obj)
+	a.setConditionTransitionTimes(old, obj)
+
+	if a.incrementsGeneration(oldCustomResource, newCustomResource) {
 		oldAccessor, _ := meta.Accessor(oldCustomResourceObject)
 		newAccessor, _ := meta.Accessor(newCustomResourceObject)
 		newAccessor.SetGeneration(oldAccessor.GetGeneration() + 1)
 	}
 }
 
+// setConditionTransitionTimes sets the lastTransitionTime of conditions in lists of obj marked with
+// x-kubernetes-conditions whose status changed compared to old. old is nil on create.
+func (a customResourceStrategy) setConditionTransitionTimes(old, obj runtime.Object) {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.conditions[v] {
+		return
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	var oldContent map[string]interface{}
+	if old, ok := old.(*unstructured.Unstructured); ok {
+		oldContent = old.Object
+	}
+	conditions.SetTransitionTimes(a.structuralSchemas[v], oldContent, u.Object, time.Now())
+}
+
+// pruneAndDefaultEmbeddedResources prunes and defaults the embedded resources of obj with
+// x-kubernetes-embedded-resource-kinds with the schemas of their kinds.
+func (a customResourceStrategy) pruneAndDefaultEmbeddedResources(obj runtime.Object) {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.embeddedKinds[v] {
+		return
+	}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		embedded.PruneAndDefault(u.Object, a.structuralSchemas[v], a.embeddedResolver)
+	}
+}
+
+// validateEmbeddedResources validates the embedded resources of obj with
+// x-kubernetes-embedded-resource-kinds against the schemas of their kinds.
+func (a customResourceStrategy) validateEmbeddedResources(obj runtime.Object) field.ErrorList {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.embeddedKinds[v] {
+		return nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	return embedded.Validate(nil, u.Object, a.structuralSchemas[v], a.embeddedResolver)
+}
+
+// validateReferences rejects references of obj to objects which do not exist, for reference
+// fields with policy Reject. References unchanged compared to old are not checked. old is nil on create.
+func (a customResourceStrategy) validateReferences(obj, old runtime.Object) field.ErrorList {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.references[v] {
+		return nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	var oldContent map[string]interface{}
+	if old, ok := old.(*unstructured.Unstructured); ok {
+		oldContent = old.Object
+	}
+	return reference.Validate(nil, a.structuralSchemas[v], u.Object, oldContent, u.GetNamespace(), a.referenceLister)
+}
+
+// referenceWarnings returns a warning for every reference of obj to an object which does not exist,
+// for reference fields with policy Warn. References unchanged compared to old are not checked.
+func (a customResourceStrategy) referenceWarnings(obj, old runtime.Object) []string {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.references[v] {
+		return nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	var oldContent map[string]interface{}
+	if old, ok := old.(*unstructured.Unstructured); ok {
+		oldContent = old.Object
+	}
+	return reference.Warnings(a.structuralSchemas[v], u.Object, oldContent, u.GetNamespace(), a.referenceLister)
+}
+
+// incrementsGeneration returns true if the changes from oldCustomResource to newCustomResource
+// increment the generation. If generation paths are specified, only changes within those paths
+// increment the generation. Otherwise, except for the changes to `metadata` and to the ignored
+// paths, any other changes cause the generation to increment.
+func (a customResourceStrategy) incrementsGeneration(oldCustomResource, newCustomResource map[string]interface{}) bool {
+	if a.generation != nil && len(a.generation.Paths) > 0 {
+		for _, path := range a.generation.Paths {
+			segments := strings.Split(strings.TrimPrefix(path, "."), ".")
+			oldValue, _, _ := unstructured.NestedFieldNoCopy(oldCustomResource, segments...)
+			newValue, _, _ := unstructured.NestedFieldNoCopy(newCustomResource, segments...)
+			if !apiequality.Semantic.DeepEqual(oldValue, newValue) {
+				return true
+			}
+		}
+		return false
+	}
+
+	newCopyContent := copyNonMetadata(newCustomResource)
+	oldCopyContent := copyNonMetadata(oldCustomResource)
+	if a.generation != nil {
+		for _, path := range a.generation.IgnoredPaths {
+			segments := strings.Split(strings.TrimPrefix(path, "."), ".")
+			newCopyContent = withoutField(newCopyContent, segments)
+			oldCopyContent = withoutField(oldCopyContent, segments)
+		}
+	}
+	return !apiequality.Semantic.DeepEqual(newCopyContent, oldCopyContent)
+}
+
+// withoutField returns obj without the field at the given path. obj is not mutated, only the
+// objects along the path are copied.
+func withoutField(obj map[string]interface{}, path []string) map[string]interface{} {
+	val, ok := obj[path[0]]
+	if !ok {
+		return obj
+	}
+	if len(path) > 1 {
+		child, ok := val.(map[string]interface{})
+		if !ok {
+			return obj
+		}
+		val = withoutField(child, path[1:])
+	}
+
+	ret := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		ret[k] = v
+	}
+	if len(path) == 1 {
+		delete(ret, path[0])
+	} else {
+		ret[path[0]] = val
+	}
+	return ret
+}
+
 func copyNonMetadata(original map[string]interface{}) map[string]interface{} {
 	ret := make(map[string]interface{})
 	for key, val := range original {
@@ -168,6 +361,8 @@ func (a customResourceStrategy) Validate(ctx context.Context, obj runtime.Object
 	if u, ok := obj.(*unstructured.Unstructured); ok {
 		v := obj.GetObjectKind().GroupVersionKind().Version
 		errs = append(errs, schemaobjectmeta.Validate(nil, u.Object, a.structuralSchemas[v], false)...)
+		errs = append(errs, a.validateEmbeddedResources(obj)...)
+		errs = append(errs, a.validateReferences(obj, nil)...)
 
 		// validate x-kubernetes-list-type "map" and "set" invariant
 		errs = append(errs, structurallisttype.ValidateListSetsAndMaps(nil, a.structuralSchemas[v], u.Object)...)
@@ -178,12 +373,12 @@ func (a customResourceStrategy) Validate(ctx context.Context, obj runtime.Object
 		}
 	}
 
-	return errs
+	return a.redactErrors(obj, errs)
 }
 
 // WarningsOnCreate returns warnings for the creation of the given object.
-func (customResourceStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
-	return nil
+func (a customResourceStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return append(a.deprecationWarnings(obj, nil), a.referenceWarnings(obj, nil)...)
 }
 
 // Canonicalize normalizes the object after validation.
@@ -218,6 +413,8 @@ func (a customResourceStrategy) ValidateUpdate(ctx context.Context, obj, old run
 	// Checks the embedded objects. We don't make a difference between update and create for those.
 	v := obj.GetObjectKind().GroupVersionKind().Version
 	errs = append(errs, schemaobjectmeta.Validate(nil, uNew.Object, a.structuralSchemas[v], false)...)
+	errs = append(errs, a.validateEmbeddedResources(obj)...)
+	errs = append(errs, a.validateReferences(obj, old)...)
 
 	// ratcheting validation of x-kubernetes-list-type value map and set
 	if oldErrs := structurallisttype.ValidateListSetsAndMaps(nil, a.structuralSchemas[v], uOld.Object); len(oldErrs) == 0 {
@@ -229,12 +426,71 @@ func (a customResourceStrategy) ValidateUpdate(ctx context.Context, obj, old run
 		errs = append(errs, celValidator.Validate(nil, a.structuralSchemas[v], uNew.Object)...)
 	}
 
-	return errs
+	return a.redactErrors(obj, errs)
+}
+
+// redactErrors replaces the values of fields of obj marked with x-kubernetes-sensitive in errs.
+func (a customResourceStrategy) redactErrors(obj runtime.Object, errs field.ErrorList) field.ErrorList {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	paths := a.sensitive[v]
+	if len(errs) == 0 || len(paths) == 0 {
+		return errs
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return errs
+	}
+	return sensitive.RedactErrors(errs, a.structuralSchemas[v], paths, u.Object)
 }
 
 // WarningsOnUpdate returns warnings for the given update.
-func (customResourceStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
-	return nil
+func (a customResourceStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return append(a.deprecationWarnings(obj, old), a.referenceWarnings(obj, old)...)
+}
+
+// deprecationWarnings returns a warning for every field of obj marked with x-kubernetes-deprecated
+// which is set to a value other than its default and which is new or changed compared to old. old
+// is nil on create.
+func (a customResourceStrategy) deprecationWarnings(obj, old runtime.Object) []string {
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.deprecations[v] {
+		return nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	var oldContent map[string]interface{}
+	if old, ok := old.(*unstructured.Unstructured); ok {
+		oldContent = old.Object
+	}
+
+	var warnings []string
+	for _, usage := range deprecation.Find(a.structuralSchemas[v], oldContent, u.Object) {
+		warnings = append(warnings, usage.Warning())
+	}
+	return warnings
+}
+
+// countDeprecatedFields counts the usage of every field of obj marked with x-kubernetes-deprecated
+// which is set to a value other than its default, whether it changed or not. obj has just been
+// written to storage. Dry runs are not counted.
+func (a customResourceStrategy) countDeprecatedFields(obj runtime.Object, dryRun []string) {
+	if dryrun.IsDryRun(dryRun) {
+		return
+	}
+	v := obj.GetObjectKind().GroupVersionKind().Version
+	if !a.deprecations[v] {
+		return
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	for _, usage := range deprecation.Find(a.structuralSchemas[v], nil, u.Object) {
+		deprecatedFieldUsageCounter.WithLabelValues(a.kind.Group, v, a.kind.Kind, usage.SchemaPath).Inc()
+	}
 }
 
 // GetAttrs returns labels and fields of a given object for filtering purposes.
@@ -22,7 +22,11 @@ import (
 	"testing"
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/component-base/metrics/testutil"
 )
 
 func generation1() map[string]interface{} {
@@ -44,6 +48,7 @@ func TestStrategyPrepareForUpdate(t *testing.T) {
 		old           *unstructured.Unstructured
 		obj           *unstructured.Unstructured
 		statusEnabled bool
+		generation    *apiextensions.CustomResourceGeneration
 		expected      *unstructured.Unstructured
 	}{
 		{
@@ -242,8 +247,113 @@ func TestStrategyPrepareForUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "generation paths, changes within paths increment generation",
+			statusEnabled: true,
+			generation:    &apiextensions.CustomResourceGeneration{Paths: []string{".spec.replicas"}},
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(2), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation2(),
+					"spec":     map[string]interface{}{"replicas": int64(2), "cache": "a"},
+					"status":   "old",
+				},
+			},
+		},
+		{
+			name:          "generation paths, changes outside of paths do not increment generation",
+			statusEnabled: true,
+			generation:    &apiextensions.CustomResourceGeneration{Paths: []string{".spec.replicas"}},
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "b"},
+					"status":   "old",
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "b"},
+					"status":   "old",
+				},
+			},
+		},
+		{
+			name:          "ignored generation paths, changes within ignored paths do not increment generation",
+			statusEnabled: true,
+			generation:    &apiextensions.CustomResourceGeneration{IgnoredPaths: []string{".spec.cache"}},
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "b"},
+					"status":   "old",
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "b"},
+					"status":   "old",
+				},
+			},
+		},
+		{
+			name:          "ignored generation paths, changes outside of ignored paths increment generation",
+			statusEnabled: true,
+			generation:    &apiextensions.CustomResourceGeneration{IgnoredPaths: []string{".spec.cache"}},
+			old: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(1), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation1(),
+					"spec":     map[string]interface{}{"replicas": int64(2), "cache": "a"},
+					"status":   "old",
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": generation2(),
+					"spec":     map[string]interface{}{"replicas": int64(2), "cache": "a"},
+					"status":   "old",
+				},
+			},
+		},
 	}
 	for _, tc := range tcs {
+		strategy.generation = tc.generation
 		if tc.statusEnabled {
 			strategy.status = &apiextensions.CustomResourceSubresourceStatus{}
 		} else {
@@ -255,3 +365,56 @@ func TestStrategyPrepareForUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestCountDeprecatedFields(t *testing.T) {
+	message := "use new instead"
+	kind := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gizmo"}
+	strategy := customResourceStrategy{
+		kind:         kind,
+		deprecations: map[string]bool{"v1": true},
+		structuralSchemas: map[string]*structuralschema.Structural{
+			"v1": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"spec": {
+						Generic: structuralschema.Generic{Type: "object"},
+						Properties: map[string]structuralschema.Structural{
+							"old": {
+								Generic:    structuralschema.Generic{Type: "string"},
+								Extensions: structuralschema.Extensions{XDeprecated: &message},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Gizmo",
+		"spec":       map[string]interface{}{"old": "x"},
+	}}
+	counter := deprecatedFieldUsageCounter.WithLabelValues("example.com", "v1", "Gizmo", "spec.old")
+	count := func() float64 {
+		v, err := testutil.GetCounterMetricValue(counter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	before := count()
+	if warnings := strategy.WarningsOnUpdate(context.TODO(), obj, obj.DeepCopy()); len(warnings) > 0 {
+		t.Errorf("expected no warnings about an unchanged field, got %v", warnings)
+	}
+	strategy.countDeprecatedFields(obj, nil)
+	if got := count() - before; got != 1 {
+		t.Errorf("expected an unchanged field still in use to be counted once, got %v", got)
+	}
+
+	before = count()
+	strategy.countDeprecatedFields(obj, []string{metav1.DryRunAll})
+	if got := count() - before; got != 0 {
+		t.Errorf("expected dry runs not to be counted, got %v", got)
+	}
+}
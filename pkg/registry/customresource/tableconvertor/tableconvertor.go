@@ -23,21 +23,29 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/sensitive"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
 )
 
 var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
 
 // New creates a new table convertor for the provided CRD column definition. If the printer definition cannot be parsed,
-// error will be returned along with a default table convertor.
-func New(crdColumns []apiextensionsv1.CustomResourceColumnDefinition) (rest.TableConvertor, error) {
+// error will be returned along with a default table convertor. Columns pointing to a field with x-kubernetes-value-type
+// in the structural schema s are rendered in a human-readable form, columns pointing to a field marked with
+// x-kubernetes-sensitive are redacted.
+func New(crdColumns []apiextensionsv1.CustomResourceColumnDefinition, s *structuralschema.Structural) (rest.TableConvertor, error) {
 	headers := []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
 	}
@@ -58,6 +66,13 @@ func New(crdColumns []apiextensionsv1.CustomResourceColumnDefinition) (rest.Tabl
 		}
 
 		c.additionalColumns = append(c.additionalColumns, path)
+		fieldSchema, isSensitive := schemaForPath(s, col.JSONPath)
+		valueType := ""
+		if fieldSchema != nil && fieldSchema.XValueType != nil {
+			valueType = *fieldSchema.XValueType
+		}
+		c.valueTypes = append(c.valueTypes, valueType)
+		c.sensitive = append(c.sensitive, isSensitive)
 		c.headers = append(c.headers, metav1.TableColumnDefinition{
 			Name:        col.Name,
 			Type:        col.Type,
@@ -78,6 +93,11 @@ type columnPrinter interface {
 type convertor struct {
 	headers           []metav1.TableColumnDefinition
 	additionalColumns []columnPrinter
+	// valueTypes holds the x-kubernetes-value-type of the field of each additional column, or empty.
+	valueTypes []string
+	// sensitive holds whether the field of each additional column is or is nested in a field
+	// marked with x-kubernetes-sensitive.
+	sensitive []bool
 }
 
 func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
@@ -115,7 +135,15 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 
 			// as we only support simple JSON path, we can assume to have only one result (or none, filtered out above)
 			value := results[0][0].Interface()
-			if customHeaders[i].Type == "string" {
+			if i < len(c.sensitive) && c.sensitive[i] && value != nil {
+				cells = append(cells, sensitive.RedactedValue)
+				continue
+			}
+			valueType := ""
+			if i < len(c.valueTypes) {
+				valueType = c.valueTypes[i]
+			}
+			if customHeaders[i].Type == "string" && len(valueType) == 0 {
 				if err := column.PrintResults(buf, []reflect.Value{reflect.ValueOf(value)}); err == nil {
 					cells = append(cells, buf.String())
 					buf.Reset()
@@ -123,7 +151,7 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 					cells = append(cells, nil)
 				}
 			} else {
-				cells = append(cells, cellForJSONValue(customHeaders[i].Type, value))
+				cells = append(cells, cellForJSONValue(customHeaders[i].Type, valueType, value))
 			}
 		}
 		return cells, nil
@@ -131,11 +159,18 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 	return table, err
 }
 
-func cellForJSONValue(headerType string, value interface{}) interface{} {
+func cellForJSONValue(headerType, valueType string, value interface{}) interface{} {
 	if value == nil {
 		return nil
 	}
 
+	switch valueType {
+	case "quantity":
+		return cellForQuantity(headerType, value)
+	case "duration":
+		return cellForDuration(headerType, value)
+	}
+
 	switch headerType {
 	case "integer":
 		switch typed := value.(type) {
@@ -180,3 +215,96 @@ func cellForJSONValue(headerType string, value interface{}) interface{} {
 
 	return nil
 }
+
+// cellForQuantity renders a quantity given as string or integer. Strings columns show the canonical
+// form, e.g. "1500m" as "1500m" and "1000m" as "1", number and integer columns show its numeric value.
+func cellForQuantity(headerType string, value interface{}) interface{} {
+	var q resource.Quantity
+	switch typed := value.(type) {
+	case string:
+		var err error
+		if q, err = resource.ParseQuantity(typed); err != nil {
+			return "<invalid>"
+		}
+	case int64:
+		q = *resource.NewQuantity(typed, resource.DecimalSI)
+	default:
+		return nil
+	}
+
+	switch headerType {
+	case "string":
+		return q.String()
+	case "integer":
+		return q.Value()
+	case "number":
+		return q.AsApproximateFloat64()
+	}
+	return nil
+}
+
+// cellForDuration renders a duration given as string or integer number of seconds. String columns
+// show a human-readable approximation like "90m", number and integer columns show the seconds.
+func cellForDuration(headerType string, value interface{}) interface{} {
+	var d time.Duration
+	switch typed := value.(type) {
+	case string:
+		parsed, err := strfmt.ParseDuration(typed)
+		if err != nil {
+			return "<invalid>"
+		}
+		d = parsed
+	case int64:
+		d = time.Duration(typed) * time.Second
+	default:
+		return nil
+	}
+
+	switch headerType {
+	case "string":
+		return duration.HumanDuration(d)
+	case "integer":
+		return int64(d / time.Second)
+	case "number":
+		return d.Seconds()
+	}
+	return nil
+}
+
+// schemaForPath returns the schema of the field the simple JSONPath points to in s, or nil if
+// there is none, and whether the field or one of its parents is marked with x-kubernetes-sensitive.
+func schemaForPath(s *structuralschema.Structural, path string) (*structuralschema.Structural, bool) {
+	if s == nil {
+		return nil, false
+	}
+	parser, err := jsonpath.Parse("schema", fmt.Sprintf("{%s}", path))
+	if err != nil || len(parser.Root.Nodes) != 1 {
+		return nil, false
+	}
+	list, ok := parser.Root.Nodes[0].(*jsonpath.ListNode)
+	if !ok {
+		return nil, false
+	}
+	isSensitive := s.XSensitive
+	for _, node := range list.Nodes {
+		switch node := node.(type) {
+		case *jsonpath.FieldNode:
+			if prop, ok := s.Properties[node.Value]; ok {
+				s = &prop
+			} else if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+				s = s.AdditionalProperties.Structural
+			} else {
+				return nil, isSensitive
+			}
+		case *jsonpath.ArrayNode:
+			if s.Items == nil {
+				return nil, isSensitive
+			}
+			s = s.Items
+		default:
+			return nil, isSensitive
+		}
+		isSensitive = isSensitive || s.XSensitive
+	}
+	return s, isSensitive
+}
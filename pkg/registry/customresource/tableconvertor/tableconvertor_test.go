@@ -23,6 +23,9 @@ import (
 	"testing"
 	"time"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/sensitive"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
@@ -34,47 +37,116 @@ import (
 func Test_cellForJSONValue(t *testing.T) {
 	tests := []struct {
 		headerType string
+		valueType  string
 		value      interface{}
 		want       interface{}
 	}{
-		{"integer", int64(42), int64(42)},
-		{"integer", float64(3.14), int64(3)},
-		{"integer", true, nil},
-		{"integer", "foo", nil},
+		{"integer", "", int64(42), int64(42)},
+		{"integer", "", float64(3.14), int64(3)},
+		{"integer", "", true, nil},
+		{"integer", "", "foo", nil},
 
-		{"number", int64(42), float64(42)},
-		{"number", float64(3.14), float64(3.14)},
-		{"number", true, nil},
-		{"number", "foo", nil},
+		{"number", "", int64(42), float64(42)},
+		{"number", "", float64(3.14), float64(3.14)},
+		{"number", "", true, nil},
+		{"number", "", "foo", nil},
 
-		{"boolean", int64(42), nil},
-		{"boolean", float64(3.14), nil},
-		{"boolean", true, true},
-		{"boolean", "foo", nil},
+		{"boolean", "", int64(42), nil},
+		{"boolean", "", float64(3.14), nil},
+		{"boolean", "", true, true},
+		{"boolean", "", "foo", nil},
 
-		{"string", int64(42), nil},
-		{"string", float64(3.14), nil},
-		{"string", true, nil},
-		{"string", "foo", "foo"},
+		{"string", "", int64(42), nil},
+		{"string", "", float64(3.14), nil},
+		{"string", "", true, nil},
+		{"string", "", "foo", "foo"},
 
-		{"date", int64(42), nil},
-		{"date", float64(3.14), nil},
-		{"date", true, nil},
-		{"date", time.Now().Add(-time.Hour*12 - 30*time.Minute).UTC().Format(time.RFC3339), "12h"},
-		{"date", time.Now().Add(+time.Hour*12 + 30*time.Minute).UTC().Format(time.RFC3339), "<invalid>"},
-		{"date", "", "<unknown>"},
+		{"date", "", int64(42), nil},
+		{"date", "", float64(3.14), nil},
+		{"date", "", true, nil},
+		{"date", "", time.Now().Add(-time.Hour*12 - 30*time.Minute).UTC().Format(time.RFC3339), "12h"},
+		{"date", "", time.Now().Add(+time.Hour*12 + 30*time.Minute).UTC().Format(time.RFC3339), "<invalid>"},
+		{"date", "", "", "<unknown>"},
 
-		{"unknown", "foo", nil},
+		{"unknown", "", "foo", nil},
+
+		{"string", "quantity", "1000m", "1"},
+		{"string", "quantity", "1500m", "1500m"},
+		{"string", "quantity", int64(2), "2"},
+		{"string", "quantity", "foo", "<invalid>"},
+		{"integer", "quantity", "1Ki", int64(1024)},
+		{"number", "quantity", "500m", float64(0.5)},
+		{"boolean", "quantity", "1", nil},
+
+		{"string", "duration", "90m", "90m"},
+		{"string", "duration", int64(7200), "120m"},
+		{"string", "duration", "foo", "<invalid>"},
+		{"integer", "duration", "1h", int64(3600)},
+		{"number", "duration", "1500ms", float64(1.5)},
+		{"date", "duration", "1h", nil},
 	}
 	for _, tt := range tests {
-		t.Run(fmt.Sprintf("%#v of type %s", tt.value, tt.headerType), func(t *testing.T) {
-			if got := cellForJSONValue(tt.headerType, tt.value); !reflect.DeepEqual(got, tt.want) {
+		t.Run(fmt.Sprintf("%#v of type %s with value type %q", tt.value, tt.headerType, tt.valueType), func(t *testing.T) {
+			if got := cellForJSONValue(tt.headerType, tt.valueType, tt.value); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("cellForJSONValue() = %#v, want %#v", got, tt.want)
 			}
 		})
 	}
 }
 
+func Test_convertor_sensitiveColumns(t *testing.T) {
+	s := &structuralschema.Structural{
+		Generic: structuralschema.Generic{Type: "object"},
+		Properties: map[string]structuralschema.Structural{
+			"spec": {
+				Generic: structuralschema.Generic{Type: "object"},
+				Properties: map[string]structuralschema.Structural{
+					"host": {Generic: structuralschema.Generic{Type: "string"}},
+					"token": {
+						Generic:    structuralschema.Generic{Type: "string"},
+						Extensions: structuralschema.Extensions{XSensitive: true},
+					},
+					"credentials": {
+						Generic:    structuralschema.Generic{Type: "object"},
+						Extensions: structuralschema.Extensions{XSensitive: true},
+						Properties: map[string]structuralschema.Structural{
+							"password": {Generic: structuralschema.Generic{Type: "string"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	c, err := New([]apiextensionsv1.CustomResourceColumnDefinition{
+		{Name: "Host", Type: "string", JSONPath: ".spec.host"},
+		{Name: "Token", Type: "string", JSONPath: ".spec.token"},
+		{Name: "Password", Type: "string", JSONPath: ".spec.credentials.password"},
+		{Name: "Missing", Type: "string", JSONPath: ".spec.missing"},
+	}, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := c.ConvertToTable(context.Background(), &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "foo"},
+			"spec": map[string]interface{}{
+				"host":        "example.com",
+				"token":       "secret",
+				"credentials": map[string]interface{}{"password": "secret"},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []interface{}{"foo", "example.com", sensitive.RedactedValue, sensitive.RedactedValue, nil}
+	if got := table.Rows[0].Cells; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected cells, got %#v, want %#v", got, want)
+	}
+}
+
 func Test_convertor_ConvertToTable(t *testing.T) {
 	type fields struct {
 		headers           []metav1.TableColumnDefinition
@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/util/dryrun"
+)
+
+// trashPrefixSuffix is appended to the resource prefix of the custom resources to get the prefix of
+// the retained deleted custom resources.
+const trashPrefixSuffix = ".deleted"
+
+// Trash retains the deleted custom resources of one version of a CustomResourceDefinition with
+// retention. The retained copies are keyed by namespace, name and uid, and have
+// metadata.deletionTimestamp set to the time they were removed from storage.
+//
+// The trashes of all versions share their keys, like the storages of the custom resources. The
+// storage of a trash is created on first use, so a trash costs nothing unless retention is enabled
+// or the CustomResourceDefinition is deleted.
+type Trash struct {
+	resource        schema.GroupResource
+	namespaceScoped bool
+	newListFunc     func() runtime.Object
+	// store is the storage of the custom resources. Restored custom resources are created through it.
+	store   *genericregistry.Store
+	storage *sideStorage
+}
+
+func newTrash(resource schema.GroupResource, strategy customResourceStrategy, optsGetter generic.RESTOptionsGetter, store *genericregistry.Store) *Trash {
+	t := &Trash{
+		resource:        resource,
+		namespaceScoped: strategy.NamespaceScoped(),
+		newListFunc:     store.NewListFunc,
+		store:           store,
+	}
+	t.storage = newSideStorage(resource, trashPrefixSuffix, strategy, optsGetter, store, t.keyOf)
+	return t
+}
+
+// destroyStorage destroys the storage of the trash if it was created.
+func (t *Trash) destroyStorage() {
+	t.storage.destroyStorage()
+}
+
+func (t *Trash) key(prefix, namespace, name string, uid types.UID) string {
+	return nameKey(prefix, t.namespaceScoped, namespace, name) + "/" + string(uid)
+}
+
+func (t *Trash) keyOf(prefix string, obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return t.key(prefix, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID()), nil
+}
+
+// retain implements the AfterDelete hook of the store of the custom resources. It keeps a copy of
+// obj, which has just been removed from storage.
+func (t *Trash) retain(obj runtime.Object, options *metav1.DeleteOptions) {
+	if options != nil && dryrun.IsDryRun(options.DryRun) {
+		return
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	s, prefix, err := t.storage.get()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to retain deleted %s %s: %v", t.resource, u.GetName(), err))
+		return
+	}
+
+	retained := u.DeepCopy()
+	now := metav1.Now()
+	retained.SetDeletionTimestamp(&now)
+	retained.SetResourceVersion("")
+	err = s.Create(context.TODO(), t.key(prefix, u.GetNamespace(), u.GetName(), u.GetUID()), retained, t.store.NewFunc(), 0)
+	if err != nil && !storage.IsExist(err) {
+		// an object removed concurrently by several requests is retained once
+		utilruntime.HandleError(fmt.Errorf("failed to retain deleted %s %s: %v", t.resource, u.GetName(), err))
+	}
+}
+
+// New implements rest.Getter for the deleted subresource.
+func (t *Trash) New() runtime.Object {
+	return t.newListFunc()
+}
+
+// Get implements rest.Getter for the deleted subresource. It returns the list of the retained
+// copies of the named custom resource in the namespace of ctx, the most recently deleted first.
+func (t *Trash) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	s, prefix, err := t.storage.get()
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	list := t.newListFunc()
+	if err := s.List(ctx, nameKey(prefix, t.namespaceScoped, genericapirequest.NamespaceValue(ctx), name), storage.ListOptions{Predicate: storage.Everything}, list); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if ul, ok := list.(*unstructured.UnstructuredList); ok {
+		sort.SliceStable(ul.Items, func(i, j int) bool {
+			return deletedAt(&ul.Items[j]).Before(deletedAt(&ul.Items[i]))
+		})
+	}
+	return list, nil
+}
+
+// Restorable returns a retained copy of the named custom resource in the namespace of ctx, prepared
+// to be created again, and the uid of the copy. If uid is empty, the most recently deleted copy is
+// returned. The custom resource is restored like a new one: it gets a new uid and new managed fields,
+// and its status is dropped if the status subresource is enabled. Owner references and finalizers
+// are kept.
+func (t *Trash) Restorable(ctx context.Context, name string, uid types.UID) (*unstructured.Unstructured, types.UID, error) {
+	list, err := t.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	var retained *unstructured.Unstructured
+	items := list.(*unstructured.UnstructuredList).Items
+	for i := range items {
+		if len(uid) == 0 || items[i].GetUID() == uid {
+			retained = &items[i]
+			break
+		}
+	}
+	if retained == nil {
+		return nil, "", apierrors.NewNotFound(t.resource, name)
+	}
+
+	obj := retained.DeepCopy()
+	for _, f := range []string{"uid", "resourceVersion", "selfLink", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "managedFields"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", f)
+	}
+	return obj, retained.GetUID(), nil
+}
+
+// Restorer returns a rest.Creater which creates custom resources through the storage of the custom
+// resources, and removes the retained copy with the given uid of the created custom resource from
+// the trash. It creates the custom resources returned by Restorable.
+func (t *Trash) Restorer(uid types.UID) rest.Creater {
+	return &trashRestorer{trash: t, uid: uid}
+}
+
+type trashRestorer struct {
+	trash *Trash
+	uid   types.UID
+}
+
+// New implements rest.Creater.
+func (r *trashRestorer) New() runtime.Object {
+	return r.trash.store.New()
+}
+
+// Create implements rest.Creater.
+func (r *trashRestorer) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	out, err := r.trash.store.Create(ctx, obj, createValidation, options)
+	if err != nil {
+		return nil, err
+	}
+	if options != nil && dryrun.IsDryRun(options.DryRun) {
+		return out, nil
+	}
+	accessor, err := meta.Accessor(out)
+	if err != nil {
+		return out, nil
+	}
+	s, prefix, err := r.trash.storage.get()
+	if err == nil {
+		err = s.Delete(ctx, r.trash.key(prefix, accessor.GetNamespace(), accessor.GetName(), r.uid), r.trash.store.NewFunc(), nil, storage.ValidateAllObjectFunc, nil)
+	}
+	if err != nil && !storage.IsNotFound(err) {
+		// the custom resource is restored, only a copy lingers until it expires
+		utilruntime.HandleError(fmt.Errorf("failed to remove restored %s %s from the trash: %v", r.trash.resource, accessor.GetName(), err))
+	}
+	return out, nil
+}
+
+// Purge removes the retained custom resources of all namespaces which were deleted before the given
+// time, and returns how many were removed.
+func (t *Trash) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	s, prefix, err := t.storage.get()
+	if err != nil {
+		return 0, err
+	}
+	list := &unstructured.UnstructuredList{}
+	if err := s.List(ctx, prefix, storage.ListOptions{Predicate: storage.Everything}, list); err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for i := range list.Items {
+		item := &list.Items[i]
+		if !deletedAt(item).Before(deletedBefore) {
+			continue
+		}
+		key := t.key(prefix, item.GetNamespace(), item.GetName(), item.GetUID())
+		if err := s.Delete(ctx, key, t.store.NewFunc(), nil, storage.ValidateAllObjectFunc, nil); err != nil && !storage.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+	}
+	return purged, utilerrors.NewAggregate(errs)
+}
+
+// deletedAt returns the time a retained custom resource was removed from storage.
+func deletedAt(obj *unstructured.Unstructured) time.Time {
+	if ts := obj.GetDeletionTimestamp(); ts != nil {
+		return ts.Time
+	}
+	return time.Time{}
+}
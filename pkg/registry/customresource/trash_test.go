@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresource_test
+
+import (
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+func TestTrash(t *testing.T) {
+	storage, server := newStorageWithOptions(t, true, 0)
+	defer server.Terminate(t)
+	defer storage.CustomResource.Store.DestroyFunc()
+
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), metav1.NamespaceDefault)
+	deleted := func() []unstructured.Unstructured {
+		list, err := storage.Trash.Get(ctx, "foo", &metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list.(*unstructured.UnstructuredList).Items
+	}
+
+	created, _ := createCustomResource(storage.CustomResource, *validNewCustomResource(), t)
+	if _, _, err := storage.CustomResource.Delete(ctx, "foo", rest.ValidateAllObjectFunc, &metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		t.Fatal(err)
+	}
+	if items := deleted(); len(items) != 0 {
+		t.Fatalf("expected dry-run delete not to be retained, got %v", items)
+	}
+	if _, _, err := storage.CustomResource.Delete(ctx, "foo", rest.ValidateAllObjectFunc, &metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	items := deleted()
+	if len(items) != 1 || items[0].GetUID() != created.GetUID() || items[0].GetDeletionTimestamp() == nil {
+		t.Fatalf("expected the deleted object to be retained with a deletion timestamp, got %v", items)
+	}
+
+	if _, _, err := storage.Trash.Restorable(ctx, "foo", "unknown"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound restoring an unknown uid, got %v", err)
+	}
+	restorable, uid, err := storage.Trash.Restorable(ctx, "foo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid != created.GetUID() {
+		t.Errorf("expected the most recently deleted copy %s, got %s", created.GetUID(), uid)
+	}
+	if len(restorable.GetUID()) > 0 || len(restorable.GetResourceVersion()) > 0 || restorable.GetDeletionTimestamp() != nil || restorable.GetManagedFields() != nil {
+		t.Errorf("expected the restorable object to be prepared for creation, got %v", restorable)
+	}
+	if _, err := storage.Trash.Restorer(uid).Create(ctx, restorable.DeepCopy(), rest.ValidateAllObjectFunc, &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		t.Fatal(err)
+	}
+	if items := deleted(); len(items) != 1 {
+		t.Fatalf("expected a dry-run restore to keep the copy, got %v", items)
+	}
+	restored, err := storage.Trash.Restorer(uid).Create(ctx, restorable, rest.ValidateAllObjectFunc, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u := restored.(*unstructured.Unstructured); u.GetUID() == created.GetUID() || u.GetDeletionTimestamp() != nil {
+		t.Errorf("expected the restored object to be created anew, got %v", u)
+	}
+	if _, err := storage.CustomResource.Get(ctx, "foo", &metav1.GetOptions{}); err != nil {
+		t.Errorf("expected the restored object to exist: %v", err)
+	}
+	if items := deleted(); len(items) != 0 {
+		t.Errorf("expected the restored object to be removed from the trash, got %v", items)
+	}
+
+	if _, _, err := storage.CustomResource.Delete(ctx, "foo", rest.ValidateAllObjectFunc, &metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if purged, err := storage.Trash.Purge(ctx, time.Now().Add(-time.Hour)); err != nil || purged != 0 {
+		t.Errorf("expected no recently deleted object to be purged, got %d, %v", purged, err)
+	}
+	if err := storage.CustomResource.PurgeDeleted(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if items := deleted(); len(items) != 0 {
+		t.Errorf("expected all deleted objects to be purged, got %v", items)
+	}
+}
@@ -86,13 +86,21 @@ func (a customResourceValidator) ValidateUpdate(ctx context.Context, obj, old ru
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(objAccessor, oldAccessor, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, apiservervalidation.ValidateCustomResource(nil, u.UnstructuredContent(), a.schemaValidator)...)
+	allErrs = append(allErrs, apiservervalidation.ValidateCustomResourceUpdate(nil, u.UnstructuredContent(), unstructuredContent(old), a.schemaValidator)...)
 	allErrs = append(allErrs, a.ValidateScaleSpec(ctx, u, scale)...)
 	allErrs = append(allErrs, a.ValidateScaleStatus(ctx, u, scale)...)
 
 	return allErrs
 }
 
+// unstructuredContent returns the content of obj if it is unstructured, nil otherwise.
+func unstructuredContent(obj runtime.Object) interface{} {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.UnstructuredContent()
+	}
+	return nil
+}
+
 // WarningsOnUpdate returns warnings for the given update.
 func (customResourceValidator) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
 	return nil
@@ -119,7 +127,7 @@ func (a customResourceValidator) ValidateStatusUpdate(ctx context.Context, obj,
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(objAccessor, oldAccessor, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, apiservervalidation.ValidateCustomResource(nil, u.UnstructuredContent(), a.schemaValidator)...)
+	allErrs = append(allErrs, apiservervalidation.ValidateCustomResourceUpdate(nil, u.UnstructuredContent(), unstructuredContent(old), a.schemaValidator)...)
 	allErrs = append(allErrs, a.ValidateScaleStatus(ctx, u, scale)...)
 
 	return allErrs
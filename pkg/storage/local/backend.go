@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Entry is a key with its value and the revision it was last written at.
+type Entry struct {
+	Key      string
+	Value    []byte
+	Revision uint64
+}
+
+// Backend persists the entries of a Database. Writes are serialized by the Database, and
+// every write carries the new revision of the Database, which the backend must persist
+// along with the entry so that it is returned by Revision after a restart.
+//
+// Implementations must be safe for concurrent reads.
+type Backend interface {
+	// Get returns the entry of key, or nil if there is none.
+	Get(key string) (*Entry, error)
+	// List returns the entries with keys starting with prefix, sorted by key.
+	List(prefix string) ([]Entry, error)
+	// Count returns the number of entries with keys starting with prefix.
+	Count(prefix string) (int64, error)
+	// Revision returns the revision of the last write, or 0 if there was none.
+	Revision() (uint64, error)
+	// Put creates or replaces the entry of key.
+	Put(key string, value []byte, revision uint64) error
+	// Delete deletes the entry of key.
+	Delete(key string, revision uint64) error
+	// Close releases the resources of the backend.
+	Close() error
+}
+
+// memoryBackend keeps the entries in memory.
+type memoryBackend struct {
+	lock     sync.RWMutex
+	entries  map[string]Entry
+	revision uint64
+}
+
+// NewMemoryBackend returns a backend keeping the entries in memory. They are lost when the
+// process exits.
+func NewMemoryBackend() Backend {
+	return &memoryBackend{entries: map[string]Entry{}}
+}
+
+func (b *memoryBackend) Get(key string) (*Entry, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	e, ok := b.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	return &e, nil
+}
+
+func (b *memoryBackend) List(prefix string) ([]Entry, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	var ret []Entry
+	for k, e := range b.entries {
+		if strings.HasPrefix(k, prefix) {
+			ret = append(ret, e)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Key < ret[j].Key })
+	return ret, nil
+}
+
+func (b *memoryBackend) Count(prefix string) (int64, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	count := int64(0)
+	for k := range b.entries {
+		if strings.HasPrefix(k, prefix) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (b *memoryBackend) Revision() (uint64, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.revision, nil
+}
+
+func (b *memoryBackend) Put(key string, value []byte, revision uint64) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	// values are not modified after they are written, so they can be shared with readers
+	b.entries[key] = Entry{Key: key, Value: value, Revision: revision}
+	b.revision = revision
+	return nil
+}
+
+func (b *memoryBackend) Delete(key string, revision uint64) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.entries, key)
+	b.revision = revision
+	return nil
+}
+
+func (b *memoryBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// StorageTypeMemory is the storage type of databases with the in-memory backend.
+	StorageTypeMemory = "memory"
+	// StorageTypeSQLite is the storage type of databases with the SQLite backend.
+	StorageTypeSQLite = "sqlite"
+
+	// DefaultHistorySize is the default number of events kept to serve watches and lists at
+	// older resource versions.
+	DefaultHistorySize = 10000
+
+	// watcherBufferSize is the number of events buffered for a watcher. Watchers falling
+	// further behind are terminated, and have to watch again from their last resource version.
+	watcherBufferSize = 1000
+)
+
+var (
+	errKeyExists      = errors.New("key exists")
+	errKeyNotFound    = errors.New("key not found")
+	errConflict       = errors.New("revision conflict")
+	errCompacted      = errors.New("revision compacted")
+	errFutureRevision = errors.New("revision in the future")
+)
+
+// event is a write to a key of the database.
+type event struct {
+	key      string
+	value    []byte
+	revision uint64
+	// prevValue and prevRevision are the value and the revision of the key before the write,
+	// nil and 0 if it was created.
+	prevValue    []byte
+	prevRevision uint64
+	deleted      bool
+}
+
+// Database is a key-value store with revisions, on top of a Backend. The revision is increased
+// by every write, like the revision of etcd, and is used as resource version of the objects.
+// The most recent writes are kept in memory to serve watches and consistent lists at older
+// revisions. Writes are serialized, so it is meant for a single API server.
+//
+// The storages of all resources of a server should share one Database.
+type Database struct {
+	backend     Backend
+	historySize int
+
+	lock     sync.RWMutex
+	revision uint64
+	// compacted is the oldest revision the history can go back to.
+	compacted uint64
+	history   []event
+	watchers  map[int]*dbWatcher
+	nextID    int
+}
+
+// NewDatabase returns a database on top of backend, keeping historySize writes in memory.
+func NewDatabase(backend Backend, historySize int) (*Database, error) {
+	revision, err := backend.Revision()
+	if err != nil {
+		return nil, err
+	}
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Database{
+		backend:     backend,
+		historySize: historySize,
+		revision:    revision,
+		compacted:   revision,
+		watchers:    map[int]*dbWatcher{},
+	}, nil
+}
+
+// Close closes the backend of the database.
+func (d *Database) Close() error {
+	return d.backend.Close()
+}
+
+// get returns the entry of key and the current revision.
+func (d *Database) get(key string) (*Entry, uint64, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	e, err := d.backend.Get(key)
+	return e, d.revision, err
+}
+
+// count returns the number of keys starting with prefix.
+func (d *Database) count(prefix string) (int64, error) {
+	return d.backend.Count(prefix)
+}
+
+// list returns the entries with keys starting with prefix at the given revision, or at the
+// current revision if revision is 0, and the revision they are at.
+func (d *Database) list(prefix string, revision uint64) ([]Entry, uint64, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if revision > d.revision {
+		return nil, d.revision, errFutureRevision
+	}
+	if revision != 0 && revision < d.compacted {
+		return nil, d.revision, errCompacted
+	}
+	entries, err := d.backend.List(prefix)
+	if err != nil {
+		return nil, d.revision, err
+	}
+	if revision == 0 || revision == d.revision {
+		return entries, d.revision, nil
+	}
+
+	// undo the writes after revision
+	byKey := make(map[string]Entry, len(entries))
+	for _, e := range entries {
+		byKey[e.Key] = e
+	}
+	for i := len(d.history) - 1; i >= 0 && d.history[i].revision > revision; i-- {
+		ev := d.history[i]
+		if !strings.HasPrefix(ev.key, prefix) {
+			continue
+		}
+		if ev.prevValue == nil {
+			delete(byKey, ev.key)
+		} else {
+			byKey[ev.key] = Entry{Key: ev.key, Value: ev.prevValue, Revision: ev.prevRevision}
+		}
+	}
+	entries = make([]Entry, 0, len(byKey))
+	for _, e := range byKey {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries, revision, nil
+}
+
+// create creates key, and returns the revision of the write.
+func (d *Database) create(key string, value []byte) (uint64, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	existing, err := d.backend.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if existing != nil {
+		return existing.Revision, errKeyExists
+	}
+	return d.commit_locked(event{key: key, value: value})
+}
+
+// update replaces the value of key if it was last written at expectedRevision, and returns
+// the revision of the write.
+func (d *Database) update(key string, value []byte, expectedRevision uint64) (uint64, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	existing, err := d.backend.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if existing == nil {
+		return 0, errKeyNotFound
+	}
+	if existing.Revision != expectedRevision {
+		return existing.Revision, errConflict
+	}
+	return d.commit_locked(event{key: key, value: value, prevValue: existing.Value, prevRevision: existing.Revision})
+}
+
+// delete deletes key if it was last written at expectedRevision, and returns the revision of the write.
+func (d *Database) delete(key string, expectedRevision uint64) (uint64, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	existing, err := d.backend.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if existing == nil {
+		return 0, errKeyNotFound
+	}
+	if existing.Revision != expectedRevision {
+		return existing.Revision, errConflict
+	}
+	return d.commit_locked(event{key: key, prevValue: existing.Value, prevRevision: existing.Revision, deleted: true})
+}
+
+// commit_locked writes ev at the next revision, records it in the history and sends it to the watchers.
+// NOTE: Caller MUST hold d.lock for writing.
+func (d *Database) commit_locked(ev event) (uint64, error) {
+	ev.revision = d.revision + 1
+	var err error
+	if ev.deleted {
+		err = d.backend.Delete(ev.key, ev.revision)
+	} else {
+		err = d.backend.Put(ev.key, ev.value, ev.revision)
+	}
+	if err != nil {
+		return 0, err
+	}
+	d.revision = ev.revision
+
+	d.history = append(d.history, ev)
+	if len(d.history) > d.historySize {
+		d.compacted = d.history[0].revision
+		d.history[0] = event{}
+		d.history = d.history[1:]
+	}
+
+	for id, w := range d.watchers {
+		if !w.matches(ev.key) {
+			continue
+		}
+		select {
+		case w.incoming <- ev:
+		default:
+			// terminate watchers which are too slow instead of blocking all writes
+			delete(d.watchers, id)
+			close(w.incoming)
+		}
+	}
+	return ev.revision, nil
+}
+
+// dbWatcher receives the writes to a key, or to the keys starting with a prefix.
+type dbWatcher struct {
+	id        int
+	key       string
+	recursive bool
+	// initial are the events to send before those received from incoming.
+	initial  []event
+	incoming chan event
+}
+
+func (w *dbWatcher) matches(key string) bool {
+	if w.recursive {
+		return strings.HasPrefix(key, w.key)
+	}
+	return key == w.key
+}
+
+// watch returns a watcher of the writes after revision to key, or to all keys starting with key
+// if recursive is true. If revision is 0, the watcher starts with creates of the current entries.
+func (d *Database) watch(key string, recursive bool, revision uint64) (*dbWatcher, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	w := &dbWatcher{
+		id:        d.nextID,
+		key:       key,
+		recursive: recursive,
+		incoming:  make(chan event, watcherBufferSize),
+	}
+	switch {
+	case revision == 0:
+		var entries []Entry
+		if recursive {
+			var err error
+			if entries, err = d.backend.List(key); err != nil {
+				return nil, err
+			}
+		} else if e, err := d.backend.Get(key); err != nil {
+			return nil, err
+		} else if e != nil {
+			entries = []Entry{*e}
+		}
+		for _, e := range entries {
+			w.initial = append(w.initial, event{key: e.Key, value: e.Value, revision: e.Revision})
+		}
+	case revision < d.compacted:
+		return nil, errCompacted
+	default:
+		i := sort.Search(len(d.history), func(i int) bool { return d.history[i].revision > revision })
+		for _, ev := range d.history[i:] {
+			if w.matches(ev.key) {
+				w.initial = append(w.initial, ev)
+			}
+		}
+	}
+
+	d.nextID++
+	d.watchers[w.id] = w
+	return w, nil
+}
+
+// stopWatch stops sending events to the watcher.
+func (d *Database) stopWatch(w *dbWatcher) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, ok := d.watchers[w.id]; ok {
+		delete(d.watchers, w.id)
+		close(w.incoming)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlite implements a local storage backend persisting to a SQLite database file.
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	// the pure Go driver keeps the server a single static binary
+	_ "modernc.org/sqlite"
+
+	"k8s.io/apiextensions-apiserver/pkg/storage/local"
+)
+
+const driverName = "sqlite"
+
+var schemaStatements = []string{
+	`PRAGMA journal_mode=WAL`,
+	`CREATE TABLE IF NOT EXISTS kv (key TEXT PRIMARY KEY, value BLOB NOT NULL, revision INTEGER NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS meta (name TEXT PRIMARY KEY, value INTEGER NOT NULL)`,
+}
+
+// backend implements local.Backend with a SQLite database.
+type backend struct {
+	db *sql.DB
+}
+
+// NewBackend opens or creates the SQLite database at path, and returns a backend persisting to it.
+// The database must not be used by more than one backend at a time.
+func NewBackend(path string) (local.Backend, error) {
+	if len(path) == 0 {
+		return nil, errors.New("the path of the SQLite database must not be empty")
+	}
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database %s: %v", path, err)
+	}
+	for _, stmt := range schemaStatements {
+		if _, err := db.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to initialize SQLite database %s: %v", path, err)
+		}
+	}
+	return &backend{db: db}, nil
+}
+
+func (b *backend) Get(key string) (*local.Entry, error) {
+	e := local.Entry{Key: key}
+	err := b.db.QueryRow(`SELECT value, revision FROM kv WHERE key = ?`, key).Scan(&e.Value, &e.Revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (b *backend) List(prefix string) ([]local.Entry, error) {
+	rows, err := b.db.Query(`SELECT key, value, revision FROM kv WHERE key >= ? AND key < ? ORDER BY key`, prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []local.Entry
+	for rows.Next() {
+		var e local.Entry
+		if err := rows.Scan(&e.Key, &e.Value, &e.Revision); err != nil {
+			return nil, err
+		}
+		ret = append(ret, e)
+	}
+	return ret, rows.Err()
+}
+
+func (b *backend) Count(prefix string) (int64, error) {
+	var count int64
+	err := b.db.QueryRow(`SELECT COUNT(*) FROM kv WHERE key >= ? AND key < ?`, prefix, prefixEnd(prefix)).Scan(&count)
+	return count, err
+}
+
+func (b *backend) Revision() (uint64, error) {
+	var revision uint64
+	err := b.db.QueryRow(`SELECT value FROM meta WHERE name = 'revision'`).Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return revision, err
+}
+
+func (b *backend) Put(key string, value []byte, revision uint64) error {
+	return b.write(revision, `INSERT INTO kv (key, value, revision) VALUES (?, ?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value, revision = excluded.revision`, key, value, revision)
+}
+
+func (b *backend) Delete(key string, revision uint64) error {
+	return b.write(revision, `DELETE FROM kv WHERE key = ?`, key)
+}
+
+// write executes stmt and records revision as the revision of the last write, in one transaction.
+func (b *backend) write(revision uint64, stmt string, args ...interface{}) error {
+	tx, err := b.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(stmt, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO meta (name, value) VALUES ('revision', ?) ON CONFLICT (name) DO UPDATE SET value = excluded.value`, revision); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (b *backend) Close() error {
+	return b.db.Close()
+}
+
+// prefixEnd returns the smallest key greater than all keys starting with prefix.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	// all keys are greater than or equal to an empty prefix, or one of only 0xff bytes
+	return "\xff\xff\xff\xff"
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+
+	"k8s.io/apiextensions-apiserver/pkg/storage/local"
+	storagetesting "k8s.io/apiextensions-apiserver/pkg/storage/local/testing"
+)
+
+func newTestDatabase(t *testing.T, path string, historySize int) *local.Database {
+	b, err := NewBackend(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := local.NewDatabase(b, historySize)
+	if err != nil {
+		b.Close()
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestStore(t *testing.T, historySize int) storage.Interface {
+	db := newTestDatabase(t, filepath.Join(t.TempDir(), "storage.db"), historySize)
+	t.Cleanup(func() { db.Close() })
+	return local.NewStore(db, unstructured.UnstructuredJSONScheme, "/registry")
+}
+
+func TestStoreCreateGetUpdateDelete(t *testing.T) {
+	storagetesting.RunTestCreateGetUpdateDelete(t, newTestStore)
+}
+
+func TestStoreList(t *testing.T) {
+	storagetesting.RunTestList(t, newTestStore)
+}
+
+func TestStoreListCompacted(t *testing.T) {
+	storagetesting.RunTestListCompacted(t, newTestStore)
+}
+
+func TestStoreWatch(t *testing.T) {
+	storagetesting.RunTestWatch(t, newTestStore)
+}
+
+func TestStoreWatchCompacted(t *testing.T) {
+	storagetesting.RunTestWatchCompacted(t, newTestStore)
+}
+
+func TestStoreRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "storage.db")
+	ctx := context.TODO()
+
+	db := newTestDatabase(t, path, 0)
+	s := local.NewStore(db, unstructured.UnstructuredJSONScheme, "/registry")
+	for _, name := range []string{"a", "b"} {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		}}
+		if err := s.Create(ctx, "/widgets/default/"+name, obj, nil, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Delete(ctx, "/widgets/default/b", &unstructured.Unstructured{}, nil, storage.ValidateAllObjectFunc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db = newTestDatabase(t, path, 0)
+	defer db.Close()
+	s = local.NewStore(db, unstructured.UnstructuredJSONScheme, "/registry")
+
+	// the objects and the resource version survive the restart
+	list := &unstructured.UnstructuredList{}
+	if err := s.List(ctx, "/widgets", storage.ListOptions{Predicate: storage.Everything}, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].GetName() != "a" || list.Items[0].GetResourceVersion() != "1" || list.GetResourceVersion() != "3" {
+		t.Errorf("unexpected list after restart %v", list)
+	}
+	// the history is not persisted, so older resource versions are expired
+	err := s.List(ctx, "/widgets", storage.ListOptions{ResourceVersion: "2", ResourceVersionMatch: metav1.ResourceVersionMatchExact, Predicate: storage.Everything}, list)
+	if !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected resource expired error, got %v", err)
+	}
+	if _, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "2", Predicate: storage.Everything}); !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected resource expired error, got %v", err)
+	}
+
+	// watches from the current resource version see the writes after the restart at increasing revisions
+	w, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "3", Predicate: storage.Everything})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	updated := &unstructured.Unstructured{}
+	if err := s.GuaranteedUpdate(ctx, "/widgets/default/a", updated, false, nil, func(input runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		u := input.(*unstructured.Unstructured).DeepCopy()
+		u.SetLabels(map[string]string{"app": "a"})
+		return u, nil, nil
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetResourceVersion() != "4" {
+		t.Errorf("expected resource version 4, got %q", updated.GetResourceVersion())
+	}
+	select {
+	case event := <-w.ResultChan():
+		if event.Type != watch.Modified || event.Object.(*unstructured.Unstructured).GetResourceVersion() != "4" {
+			t.Errorf("unexpected event %s at %s", event.Type, event.Object.(*unstructured.Unstructured).GetResourceVersion())
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("timed out waiting for the modified event")
+	}
+}
+
+func TestBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "storage.db")
+	b, err := NewBackend(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, key := range []string{"/registry/widgets/a", "/registry/widgets/b", "/registry/widgetsets/a"} {
+		if err := b.Put(key, []byte(key), uint64(i+1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Put("/registry/widgets/a", []byte("updated"), 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Delete("/registry/widgets/b", 5); err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries and the revision survive a restart
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = NewBackend(path); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	if revision, err := b.Revision(); err != nil || revision != 5 {
+		t.Errorf("expected revision 5, got %d, %v", revision, err)
+	}
+	if e, err := b.Get("/registry/widgets/a"); err != nil || e == nil || string(e.Value) != "updated" || e.Revision != 4 {
+		t.Errorf("unexpected entry %v, %v", e, err)
+	}
+	if e, err := b.Get("/registry/widgets/b"); err != nil || e != nil {
+		t.Errorf("expected deleted entry, got %v, %v", e, err)
+	}
+
+	entries, err := b.List("/registry/widgets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []local.Entry{
+		{Key: "/registry/widgets/a", Value: []byte("updated"), Revision: 4},
+		{Key: "/registry/widgetsets/a", Value: []byte("/registry/widgetsets/a"), Revision: 3},
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("expected entries %v, got %v", expected, entries)
+	}
+	if count, err := b.Count("/registry/widgets/"); err != nil || count != 1 {
+		t.Errorf("expected count of 1, got %d, %v", count, err)
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	for prefix, expected := range map[string]string{
+		"/registry/":   "/registry0",
+		"a\xff":        "b",
+		"/registry/a~": "/registry/a\x7f",
+	} {
+		if got := prefixEnd(prefix); got != expected {
+			t.Errorf("expected end of %q to be %q, got %q", prefix, expected, got)
+		}
+	}
+}
@@ -0,0 +1,501 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+)
+
+// store implements storage.Interface on top of a Database, like the etcd3 store does on top of etcd.
+type store struct {
+	db         *Database
+	codec      runtime.Codec
+	versioner  storage.Versioner
+	pathPrefix string
+}
+
+var _ storage.Interface = &store{}
+
+// NewStore returns a storage.Interface storing objects in db, encoded with codec, under the keys
+// prefixed with prefix. Objects are not expired, so TTLs are ignored.
+func NewStore(db *Database, codec runtime.Codec, prefix string) storage.Interface {
+	return &store{
+		db:         db,
+		codec:      codec,
+		versioner:  storage.APIObjectVersioner{},
+		pathPrefix: path.Join("/", prefix),
+	}
+}
+
+// NewStorage has the signature of generic.StorageDecorator, and returns a store in the database for
+// the given resource. It is used instead of generic.UndecoratedStorage, and must not be wrapped in
+// a watch cache, which only wraps etcd.
+func (d *Database) NewStorage(
+	config *storagebackend.ConfigForResource,
+	resourcePrefix string,
+	keyFunc func(obj runtime.Object) (string, error),
+	newFunc func() runtime.Object,
+	newListFunc func() runtime.Object,
+	getAttrsFunc storage.AttrFunc,
+	trigger storage.IndexerFuncs,
+	indexers *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+	// the database is shared by all resources, and closed when the server stops
+	return NewStore(d, config.Codec, config.Prefix), func() {}, nil
+}
+
+// Versioner implements storage.Interface.Versioner.
+func (s *store) Versioner() storage.Versioner {
+	return s.versioner
+}
+
+// Create implements storage.Interface.Create.
+func (s *store) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	if version, err := s.versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
+		return errors.New("resourceVersion should not be set on objects to be created")
+	}
+	if err := s.versioner.PrepareObjectForStorage(obj); err != nil {
+		return fmt.Errorf("PrepareObjectForStorage failed: %v", err)
+	}
+	data, err := runtime.Encode(s.codec, obj)
+	if err != nil {
+		return err
+	}
+	key = path.Join(s.pathPrefix, key)
+
+	revision, err := s.db.create(key, data)
+	if err != nil {
+		return s.interpretError(key, revision, err)
+	}
+	if out != nil {
+		return s.decode(data, out, revision)
+	}
+	return nil
+}
+
+// Delete implements storage.Interface.Delete.
+func (s *store) Delete(ctx context.Context, key string, out runtime.Object, preconditions *storage.Preconditions, validateDeletion storage.ValidateObjectFunc, cachedExistingObject runtime.Object) error {
+	v, err := conversion.EnforcePtr(out)
+	if err != nil {
+		return fmt.Errorf("unable to convert output object to pointer: %v", err)
+	}
+	key = path.Join(s.pathPrefix, key)
+
+	for {
+		e, _, err := s.db.get(key)
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			return storage.NewKeyNotFoundError(key, 0)
+		}
+		obj := reflect.New(v.Type()).Interface().(runtime.Object)
+		if err := s.decode(e.Value, obj, e.Revision); err != nil {
+			return err
+		}
+		if preconditions != nil {
+			if err := preconditions.Check(key, obj); err != nil {
+				return err
+			}
+		}
+		if err := validateDeletion(ctx, obj); err != nil {
+			return err
+		}
+
+		if _, err := s.db.delete(key, e.Revision); errors.Is(err, errConflict) {
+			continue
+		} else if err != nil {
+			return s.interpretError(key, 0, err)
+		}
+		return s.decode(e.Value, out, e.Revision)
+	}
+}
+
+// Watch implements storage.Interface.Watch.
+func (s *store) Watch(ctx context.Context, key string, opts storage.ListOptions) (watch.Interface, error) {
+	return s.watch(ctx, key, opts, false)
+}
+
+// WatchList implements storage.Interface.WatchList.
+func (s *store) WatchList(ctx context.Context, key string, opts storage.ListOptions) (watch.Interface, error) {
+	return s.watch(ctx, key, opts, true)
+}
+
+func (s *store) watch(ctx context.Context, key string, opts storage.ListOptions, recursive bool) (watch.Interface, error) {
+	revision, err := s.versioner.ParseResourceVersion(opts.ResourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	key = path.Join(s.pathPrefix, key)
+	if recursive && !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+
+	w, err := s.db.watch(key, recursive, revision)
+	if errors.Is(err, errCompacted) {
+		return nil, apierrors.NewResourceExpired(fmt.Sprintf("too old resource version: %d", revision))
+	} else if err != nil {
+		return nil, err
+	}
+	return newStoreWatcher(ctx, s, w, revision, opts.Predicate), nil
+}
+
+// Get implements storage.Interface.Get.
+func (s *store) Get(ctx context.Context, key string, opts storage.GetOptions, out runtime.Object) error {
+	key = path.Join(s.pathPrefix, key)
+	e, revision, err := s.db.get(key)
+	if err != nil {
+		return err
+	}
+	if err := s.validateMinimumResourceVersion(opts.ResourceVersion, revision); err != nil {
+		return err
+	}
+	if e == nil {
+		if opts.IgnoreNotFound {
+			return runtime.SetZeroValue(out)
+		}
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	return s.decode(e.Value, out, e.Revision)
+}
+
+// GetToList implements storage.Interface.GetToList.
+func (s *store) GetToList(ctx context.Context, key string, opts storage.ListOptions, listObj runtime.Object) error {
+	listPtr, err := meta.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		return fmt.Errorf("need ptr to slice: %v", err)
+	}
+	key = path.Join(s.pathPrefix, key)
+
+	e, revision, err := s.db.get(key)
+	if err != nil {
+		return err
+	}
+	if err := s.validateMinimumResourceVersion(opts.ResourceVersion, revision); err != nil {
+		return err
+	}
+	if e != nil {
+		if _, err := s.appendListItem(v, e, opts.Predicate, newItemFunc(listObj, v)); err != nil {
+			return err
+		}
+	}
+	return s.versioner.UpdateList(listObj, revision, "", nil)
+}
+
+// List implements storage.Interface.List.
+func (s *store) List(ctx context.Context, key string, opts storage.ListOptions, listObj runtime.Object) error {
+	listPtr, err := meta.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		return fmt.Errorf("need ptr to slice: %v", err)
+	}
+	key = path.Join(s.pathPrefix, key)
+	// keys are directories, so we only return children of the directory and not other keys with the
+	// same prefix
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	pred := opts.Predicate
+
+	var fromRV *uint64
+	if len(opts.ResourceVersion) > 0 {
+		parsed, err := s.versioner.ParseResourceVersion(opts.ResourceVersion)
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid resource version: %v", err))
+		}
+		fromRV = &parsed
+	}
+
+	// revision is the revision to list at, 0 for the current one
+	var revision uint64
+	var startKey string
+	switch {
+	case len(pred.Continue) > 0:
+		if fromRV != nil && *fromRV != 0 {
+			return apierrors.NewBadRequest("specifying resource version is not allowed when using continue")
+		}
+		startKey, revision, err = decodeContinue(pred.Continue, key)
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+	case fromRV != nil && opts.ResourceVersionMatch == metav1.ResourceVersionMatchExact:
+		revision = *fromRV
+	case fromRV != nil && opts.ResourceVersionMatch == "" && pred.Limit > 0:
+		// legacy case: a limited list at a resource version is served at exactly that version
+		revision = *fromRV
+	}
+
+	entries, listRevision, err := s.db.list(key, revision)
+	switch {
+	case errors.Is(err, errCompacted) && len(pred.Continue) > 0:
+		return apierrors.NewResourceExpired("The provided continue parameter is too old to display a consistent list result. You can start a new list without the continue parameter.")
+	case errors.Is(err, errCompacted):
+		return apierrors.NewResourceExpired(fmt.Sprintf("too old resource version: %d", revision))
+	case errors.Is(err, errFutureRevision):
+		return storage.NewTooLargeResourceVersionError(revision, listRevision, 1)
+	case err != nil:
+		return err
+	}
+	if fromRV != nil && revision == 0 {
+		if err := s.validateMinimumResourceVersion(opts.ResourceVersion, listRevision); err != nil {
+			return err
+		}
+	}
+
+	newItem := newItemFunc(listObj, v)
+	var continueValue string
+	var remainingItemCount *int64
+	count := int64(0)
+	for i := range entries {
+		if entries[i].Key < startKey {
+			continue
+		}
+		if pred.Limit > 0 && count == pred.Limit {
+			continueValue, err = encodeContinue(entries[i-1].Key+"\x00", key, listRevision)
+			if err != nil {
+				return err
+			}
+			// the remaining items are only known if they are not filtered
+			if pred.Empty() {
+				remaining := int64(len(entries) - i)
+				remainingItemCount = &remaining
+			}
+			break
+		}
+		matched, err := s.appendListItem(v, &entries[i], pred, newItem)
+		if err != nil {
+			return err
+		}
+		if matched {
+			count++
+		}
+	}
+	return s.versioner.UpdateList(listObj, listRevision, continueValue, remainingItemCount)
+}
+
+// GuaranteedUpdate implements storage.Interface.GuaranteedUpdate.
+func (s *store) GuaranteedUpdate(ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool, preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	v, err := conversion.EnforcePtr(destination)
+	if err != nil {
+		return fmt.Errorf("unable to convert output object to pointer: %v", err)
+	}
+	key = path.Join(s.pathPrefix, key)
+
+	for {
+		e, _, err := s.db.get(key)
+		if err != nil {
+			return err
+		}
+		if e == nil && !ignoreNotFound {
+			return storage.NewKeyNotFoundError(key, 0)
+		}
+		existing := reflect.New(v.Type()).Interface().(runtime.Object)
+		var existingRevision uint64
+		if e != nil {
+			existingRevision = e.Revision
+			if err := s.decode(e.Value, existing, e.Revision); err != nil {
+				return err
+			}
+		}
+		if preconditions != nil {
+			if err := preconditions.Check(key, existing); err != nil {
+				return err
+			}
+		}
+
+		ret, _, err := tryUpdate(existing, storage.ResponseMeta{ResourceVersion: existingRevision})
+		if err != nil {
+			return err
+		}
+		if err := s.versioner.PrepareObjectForStorage(ret); err != nil {
+			return fmt.Errorf("PrepareObjectForStorage failed: %v", err)
+		}
+		data, err := runtime.Encode(s.codec, ret)
+		if err != nil {
+			return err
+		}
+		if e != nil && bytes.Equal(data, e.Value) {
+			// nothing changed, so nothing is written
+			return s.decode(e.Value, destination, e.Revision)
+		}
+
+		var revision uint64
+		if e == nil {
+			revision, err = s.db.create(key, data)
+		} else {
+			revision, err = s.db.update(key, data, e.Revision)
+		}
+		if errors.Is(err, errConflict) || errors.Is(err, errKeyExists) || errors.Is(err, errKeyNotFound) {
+			// the key was written concurrently, so the update is retried on its new state
+			continue
+		} else if err != nil {
+			return err
+		}
+		return s.decode(data, destination, revision)
+	}
+}
+
+// Count implements storage.Interface.Count.
+func (s *store) Count(key string) (int64, error) {
+	key = path.Join(s.pathPrefix, key)
+	// we need to make sure the key ended with "/" so that we only get children "directories".
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	return s.db.count(key)
+}
+
+// decode decodes data into out, with the resource version set to revision.
+func (s *store) decode(data []byte, out runtime.Object, revision uint64) error {
+	if _, err := conversion.EnforcePtr(out); err != nil {
+		return fmt.Errorf("unable to convert output object to pointer: %v", err)
+	}
+	if _, _, err := s.codec.Decode(data, nil, out); err != nil {
+		return err
+	}
+	return s.versioner.UpdateObject(out, revision)
+}
+
+// appendListItem decodes e and appends it to v if it matches pred. It returns whether it matched.
+func (s *store) appendListItem(v reflect.Value, e *Entry, pred storage.SelectionPredicate, newItem func() runtime.Object) (bool, error) {
+	obj, _, err := s.codec.Decode(e.Value, nil, newItem())
+	if err != nil {
+		return false, err
+	}
+	if err := s.versioner.UpdateObject(obj, e.Revision); err != nil {
+		return false, err
+	}
+	if matched, err := pred.Matches(obj); err != nil || !matched {
+		return false, nil
+	}
+	v.Set(reflect.Append(v, reflect.ValueOf(obj).Elem()))
+	return true, nil
+}
+
+func (s *store) validateMinimumResourceVersion(minimumResourceVersion string, actualRevision uint64) error {
+	if minimumResourceVersion == "" {
+		return nil
+	}
+	minimumRV, err := s.versioner.ParseResourceVersion(minimumResourceVersion)
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid resource version: %v", err))
+	}
+	// Enforce the storage.Interface guarantee that the resource version of the returned data
+	// "will be at least 'resourceVersion'".
+	if minimumRV > actualRevision {
+		return storage.NewTooLargeResourceVersionError(minimumRV, actualRevision, 0)
+	}
+	return nil
+}
+
+func (s *store) interpretError(key string, revision uint64, err error) error {
+	switch {
+	case errors.Is(err, errKeyExists):
+		return storage.NewKeyExistsError(key, int64(revision))
+	case errors.Is(err, errKeyNotFound):
+		return storage.NewKeyNotFoundError(key, int64(revision))
+	case errors.Is(err, errConflict):
+		return storage.NewResourceVersionConflictsError(key, int64(revision))
+	}
+	return err
+}
+
+func newItemFunc(listObj runtime.Object, v reflect.Value) func() runtime.Object {
+	// For unstructured lists with a target group/version, preserve the group/version in the instantiated list items
+	if unstructuredList, isUnstructured := listObj.(*unstructured.UnstructuredList); isUnstructured {
+		if apiVersion := unstructuredList.GetAPIVersion(); len(apiVersion) > 0 {
+			return func() runtime.Object {
+				return &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": apiVersion}}
+			}
+		}
+	}
+
+	// Otherwise just instantiate an empty item
+	elem := v.Type().Elem()
+	return func() runtime.Object {
+		return reflect.New(elem).Interface().(runtime.Object)
+	}
+}
+
+// continueToken is a simple structured object for encoding the state of a continue token, like
+// the one of the etcd3 store.
+type continueToken struct {
+	APIVersion      string `json:"v"`
+	ResourceVersion uint64 `json:"rv"`
+	StartKey        string `json:"start"`
+}
+
+// encodeContinue returns a continue token resuming a list at key, at the given revision.
+func encodeContinue(key, keyPrefix string, revision uint64) (string, error) {
+	nextKey := strings.TrimPrefix(key, keyPrefix)
+	if nextKey == key {
+		return "", fmt.Errorf("unable to encode next field: the key and key prefix do not match")
+	}
+	out, err := json.Marshal(&continueToken{APIVersion: "meta.k8s.io/v1", ResourceVersion: revision, StartKey: nextKey})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(out), nil
+}
+
+// decodeContinue returns the key and the revision to resume a list at.
+func decodeContinue(continueValue, keyPrefix string) (string, uint64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(continueValue)
+	if err != nil {
+		return "", 0, fmt.Errorf("continue key is not valid: %v", err)
+	}
+	var c continueToken
+	if err := json.Unmarshal(data, &c); err != nil {
+		return "", 0, fmt.Errorf("continue key is not valid: %v", err)
+	}
+	if c.APIVersion != "meta.k8s.io/v1" {
+		return "", 0, fmt.Errorf("continue key is not valid: server does not recognize this encoded version %q", c.APIVersion)
+	}
+	if c.ResourceVersion == 0 {
+		return "", 0, fmt.Errorf("continue key is not valid: incorrect encoded start resourceVersion (version meta.k8s.io/v1)")
+	}
+	if len(c.StartKey) == 0 || strings.Contains(c.StartKey, "..") {
+		return "", 0, fmt.Errorf("continue key is not valid: invalid start key")
+	}
+	return keyPrefix + c.StartKey, c.ResourceVersion, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apiserver/pkg/storage"
+
+	storagetesting "k8s.io/apiextensions-apiserver/pkg/storage/local/testing"
+)
+
+func newTestStore(t *testing.T, historySize int) storage.Interface {
+	db, err := NewDatabase(NewMemoryBackend(), historySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(db, unstructured.UnstructuredJSONScheme, "/registry")
+}
+
+func TestStoreCreateGetUpdateDelete(t *testing.T) {
+	storagetesting.RunTestCreateGetUpdateDelete(t, newTestStore)
+}
+
+func TestStoreList(t *testing.T) {
+	storagetesting.RunTestList(t, newTestStore)
+}
+
+func TestStoreListCompacted(t *testing.T) {
+	storagetesting.RunTestListCompacted(t, newTestStore)
+}
+
+func TestStoreWatch(t *testing.T) {
+	storagetesting.RunTestWatch(t, newTestStore)
+}
+
+func TestStoreWatchCompacted(t *testing.T) {
+	storagetesting.RunTestWatchCompacted(t, newTestStore)
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testing contains the tests every storage.Interface of the local storage must pass,
+// independent of the backend of its database.
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// NewStoreFunc returns an empty store under the prefix "/registry", whose database keeps
+// historySize writes, or a default number if historySize is 0.
+type NewStoreFunc func(t *testing.T, historySize int) storage.Interface
+
+func newTestObject(name string, labels map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+	}}
+	u.SetLabels(labels)
+	return u
+}
+
+func labelPredicate(selector string) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label: labels.SelectorFromSet(labels.Set{"app": selector}),
+		Field: fields.Everything(),
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			return obj.(*unstructured.Unstructured).GetLabels(), nil, nil
+		},
+	}
+}
+
+func setLabel(value string) storage.UpdateFunc {
+	return func(input runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		u := input.(*unstructured.Unstructured).DeepCopy()
+		u.SetLabels(map[string]string{"app": value})
+		return u, nil, nil
+	}
+}
+
+// RunTestCreateGetUpdateDelete tests the basic operations on a single key.
+func RunTestCreateGetUpdateDelete(t *testing.T, newStore NewStoreFunc) {
+	s := newStore(t, 0)
+	ctx := context.TODO()
+
+	created := &unstructured.Unstructured{}
+	if err := s.Create(ctx, "/widgets/default/foo", newTestObject("foo", nil), created, 0); err != nil {
+		t.Fatal(err)
+	}
+	if created.GetResourceVersion() != "1" {
+		t.Errorf("expected resource version 1, got %q", created.GetResourceVersion())
+	}
+	if err := s.Create(ctx, "/widgets/default/foo", newTestObject("foo", nil), nil, 0); !storage.IsExist(err) {
+		t.Errorf("expected key exists error, got %v", err)
+	}
+
+	updated := &unstructured.Unstructured{}
+	if err := s.GuaranteedUpdate(ctx, "/widgets/default/foo", updated, false, nil, setLabel("a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetResourceVersion() != "2" || updated.GetLabels()["app"] != "a" {
+		t.Errorf("unexpected updated object %v", updated.Object)
+	}
+	// a no-op update is not written
+	if err := s.GuaranteedUpdate(ctx, "/widgets/default/foo", updated, false, nil, setLabel("a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetResourceVersion() != "2" {
+		t.Errorf("expected no-op update to keep resource version 2, got %q", updated.GetResourceVersion())
+	}
+
+	got := &unstructured.Unstructured{}
+	if err := s.Get(ctx, "/widgets/default/foo", storage.GetOptions{}, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetResourceVersion() != "2" {
+		t.Errorf("expected resource version 2, got %q", got.GetResourceVersion())
+	}
+	if err := s.Get(ctx, "/widgets/default/foo", storage.GetOptions{ResourceVersion: "10"}, got); !storage.IsTooLargeResourceVersion(err) {
+		t.Errorf("expected too large resource version error, got %v", err)
+	}
+
+	staleRV := "1"
+	if err := s.Delete(ctx, "/widgets/default/foo", &unstructured.Unstructured{}, &storage.Preconditions{ResourceVersion: &staleRV}, storage.ValidateAllObjectFunc, nil); !storage.IsInvalidObj(err) {
+		t.Errorf("expected failed precondition, got %v", err)
+	}
+	if err := s.Delete(ctx, "/widgets/default/foo", &unstructured.Unstructured{}, nil, storage.ValidateAllObjectFunc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Get(ctx, "/widgets/default/foo", storage.GetOptions{}, got); !storage.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if err := s.Get(ctx, "/widgets/default/foo", storage.GetOptions{IgnoreNotFound: true}, got); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// RunTestList tests paginated and filtered lists, and lists at a resource version.
+func RunTestList(t *testing.T, newStore NewStoreFunc) {
+	s := newStore(t, 0)
+	ctx := context.TODO()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		if err := s.Create(ctx, "/widgets/default/"+name, newTestObject(name, map[string]string{"app": name}), nil, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// another resource with a common prefix is not listed
+	if err := s.Create(ctx, "/widgetsets/default/a", newTestObject("a", nil), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := s.Count("/widgets"); err != nil || count != 4 {
+		t.Errorf("expected count of 4, got %d, %v", count, err)
+	}
+
+	pred := storage.Everything
+	pred.Limit = 2
+	list := &unstructured.UnstructuredList{}
+	if err := s.List(ctx, "/widgets", storage.ListOptions{Predicate: pred}, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 || list.GetContinue() == "" || list.GetRemainingItemCount() == nil || *list.GetRemainingItemCount() != 2 {
+		t.Fatalf("unexpected first page %v", list)
+	}
+	firstRV := list.GetResourceVersion()
+
+	// the next page is consistent with the first, even after writes
+	if err := s.Create(ctx, "/widgets/default/e", newTestObject("e", nil), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, "/widgets/default/d", &unstructured.Unstructured{}, nil, storage.ValidateAllObjectFunc, nil); err != nil {
+		t.Fatal(err)
+	}
+	pred.Continue = list.GetContinue()
+	list = &unstructured.UnstructuredList{}
+	if err := s.List(ctx, "/widgets", storage.ListOptions{Predicate: pred}, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 || list.Items[0].GetName() != "c" || list.Items[1].GetName() != "d" || list.GetContinue() != "" {
+		t.Errorf("unexpected second page %v", list)
+	}
+	if list.GetResourceVersion() != firstRV {
+		t.Errorf("expected resource version %s, got %s", firstRV, list.GetResourceVersion())
+	}
+
+	list = &unstructured.UnstructuredList{}
+	if err := s.List(ctx, "/widgets", storage.ListOptions{Predicate: labelPredicate("b")}, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].GetName() != "b" {
+		t.Errorf("unexpected filtered list %v", list)
+	}
+
+	list = &unstructured.UnstructuredList{}
+	err := s.List(ctx, "/widgets", storage.ListOptions{ResourceVersion: "100", ResourceVersionMatch: metav1.ResourceVersionMatchNotOlderThan, Predicate: storage.Everything}, list)
+	if !storage.IsTooLargeResourceVersion(err) {
+		t.Errorf("expected too large resource version error, got %v", err)
+	}
+}
+
+// RunTestListCompacted tests lists at resource versions older than the kept history.
+func RunTestListCompacted(t *testing.T, newStore NewStoreFunc) {
+	s := newStore(t, 2)
+	ctx := context.TODO()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		if err := s.Create(ctx, "/widgets/default/"+name, newTestObject(name, nil), nil, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := &unstructured.UnstructuredList{}
+	err := s.List(ctx, "/widgets", storage.ListOptions{ResourceVersion: "1", ResourceVersionMatch: metav1.ResourceVersionMatchExact, Predicate: storage.Everything}, list)
+	if !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected resource expired error, got %v", err)
+	}
+	err = s.List(ctx, "/widgets", storage.ListOptions{ResourceVersion: "3", ResourceVersionMatch: metav1.ResourceVersionMatchExact, Predicate: storage.Everything}, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 3 {
+		t.Errorf("expected 3 items at resource version 3, got %d", len(list.Items))
+	}
+}
+
+// RunTestWatch tests watches from resource version 0 and from a given resource version.
+func RunTestWatch(t *testing.T, newStore NewStoreFunc) {
+	s := newStore(t, 0)
+	ctx := context.TODO()
+	if err := s.Create(ctx, "/widgets/default/foo", newTestObject("foo", map[string]string{"app": "a"}), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// a watch from resource version 0 starts with the existing objects
+	fromZero, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "0", Predicate: labelPredicate("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fromZero.Stop()
+	fromOne, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "1", Predicate: labelPredicate("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fromOne.Stop()
+
+	if err := s.Create(ctx, "/widgets/default/bar", newTestObject("bar", map[string]string{"app": "a"}), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	// the object does not match anymore, so it is deleted for the watchers
+	if err := s.GuaranteedUpdate(ctx, "/widgets/default/foo", &unstructured.Unstructured{}, false, nil, setLabel("b"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expectEvents(t, fromZero, []watch.EventType{watch.Added, watch.Added, watch.Deleted}, []string{"1", "2", "3"})
+	expectEvents(t, fromOne, []watch.EventType{watch.Added, watch.Deleted}, []string{"2", "3"})
+}
+
+// RunTestWatchCompacted tests watches from resource versions older than the kept history.
+func RunTestWatchCompacted(t *testing.T, newStore NewStoreFunc) {
+	s := newStore(t, 1)
+	ctx := context.TODO()
+	for _, name := range []string{"a", "b", "c"} {
+		if err := s.Create(ctx, "/widgets/default/"+name, newTestObject(name, nil), nil, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "1", Predicate: storage.Everything}); !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected resource expired error, got %v", err)
+	}
+	w, err := s.WatchList(ctx, "/widgets", storage.ListOptions{ResourceVersion: "2", Predicate: storage.Everything})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	expectEvents(t, w, []watch.EventType{watch.Added}, []string{"3"})
+}
+
+func expectEvents(t *testing.T, w watch.Interface, types []watch.EventType, resourceVersions []string) {
+	t.Helper()
+	for i := range types {
+		select {
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				t.Fatalf("watch closed, expected %s event", types[i])
+			}
+			u := event.Object.(*unstructured.Unstructured)
+			if event.Type != types[i] || u.GetResourceVersion() != resourceVersions[i] {
+				t.Errorf("expected %s event at resource version %s, got %s at %s", types[i], resourceVersions[i], event.Type, u.GetResourceVersion())
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for %s event", types[i])
+		}
+	}
+}
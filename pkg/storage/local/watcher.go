@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// outgoingBufSize is the size of the result channel of a watcher, like in the etcd3 watcher.
+const outgoingBufSize = 100
+
+// storeWatcher turns the writes received from a database watcher into watch events of the objects
+// of a store, filtered by a predicate.
+type storeWatcher struct {
+	store *store
+	w     *dbWatcher
+	// revision is the revision the watch started from. Earlier writes are not sent.
+	revision uint64
+	pred     storage.SelectionPredicate
+
+	ctx        context.Context
+	cancel     context.CancelFunc
+	resultChan chan watch.Event
+}
+
+func newStoreWatcher(ctx context.Context, s *store, w *dbWatcher, revision uint64, pred storage.SelectionPredicate) *storeWatcher {
+	ret := &storeWatcher{
+		store:      s,
+		w:          w,
+		revision:   revision,
+		pred:       pred,
+		resultChan: make(chan watch.Event, outgoingBufSize),
+	}
+	ret.ctx, ret.cancel = context.WithCancel(ctx)
+	go ret.run()
+	return ret
+}
+
+// Stop implements watch.Interface.Stop.
+func (sw *storeWatcher) Stop() {
+	sw.cancel()
+}
+
+// ResultChan implements watch.Interface.ResultChan.
+func (sw *storeWatcher) ResultChan() <-chan watch.Event {
+	return sw.resultChan
+}
+
+func (sw *storeWatcher) run() {
+	defer close(sw.resultChan)
+	defer sw.store.db.stopWatch(sw.w)
+
+	for _, ev := range sw.w.initial {
+		if !sw.send(ev) {
+			return
+		}
+	}
+	for {
+		select {
+		case ev, ok := <-sw.w.incoming:
+			if !ok {
+				// the watcher was too slow, and has to watch again
+				return
+			}
+			if !sw.send(ev) {
+				return
+			}
+		case <-sw.ctx.Done():
+			return
+		}
+	}
+}
+
+// send sends the watch event of ev, if any. It returns false if the watcher is stopped.
+func (sw *storeWatcher) send(ev event) bool {
+	if sw.revision != 0 && ev.revision <= sw.revision {
+		return true
+	}
+	watchEvent, err := sw.transform(ev)
+	if err != nil {
+		utilruntime.HandleError(err)
+		watchEvent = &watch.Event{Type: watch.Error, Object: &apierrors.NewInternalError(err).ErrStatus}
+	}
+	if watchEvent == nil {
+		return true
+	}
+	select {
+	case sw.resultChan <- *watchEvent:
+		return true
+	case <-sw.ctx.Done():
+		return false
+	}
+}
+
+// transform returns the watch event of ev, or nil if neither the old nor the new object matches
+// the predicate.
+func (sw *storeWatcher) transform(ev event) (*watch.Event, error) {
+	var curObj, oldObj runtime.Object
+	if !ev.deleted {
+		obj, err := sw.decode(ev.value, ev.revision)
+		if err != nil {
+			return nil, err
+		}
+		curObj = obj
+	}
+	if ev.prevValue != nil {
+		// like etcd3, the old object is sent with the revision of the write
+		obj, err := sw.decode(ev.prevValue, ev.revision)
+		if err != nil {
+			return nil, err
+		}
+		oldObj = obj
+	}
+
+	curMatches := curObj != nil && sw.matches(curObj)
+	oldMatches := oldObj != nil && sw.matches(oldObj)
+	switch {
+	case curMatches && oldMatches:
+		return &watch.Event{Type: watch.Modified, Object: curObj}, nil
+	case curMatches:
+		return &watch.Event{Type: watch.Added, Object: curObj}, nil
+	case oldMatches:
+		return &watch.Event{Type: watch.Deleted, Object: oldObj}, nil
+	}
+	return nil, nil
+}
+
+func (sw *storeWatcher) matches(obj runtime.Object) bool {
+	matches, err := sw.pred.Matches(obj)
+	return err == nil && matches
+}
+
+func (sw *storeWatcher) decode(data []byte, revision uint64) (runtime.Object, error) {
+	obj, err := runtime.Decode(sw.store.codec, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode watch event: %v", err)
+	}
+	if err := sw.store.versioner.UpdateObject(obj, revision); err != nil {
+		return nil, fmt.Errorf("failed to set resource version of watch event: %v", err)
+	}
+	return obj, nil
+}
@@ -27,10 +27,35 @@ import (
 // if their schema is not exposed.
 //
 // The CEL declaration for objects with XPreserveUnknownFields does not expose unknown fields.
+//
+// Recursive definitions are declared as dynamic type where they are nested in themselves.
 func SchemaDeclType(s *schema.Structural, isResourceRoot bool) *DeclType {
+	return schemaDeclType(s, isResourceRoot, map[string]bool{})
+}
+
+// schemaDeclType converts the structural schema to a CEL declaration. expanding holds the
+// definitions currently being converted further up in the schema.
+func schemaDeclType(s *schema.Structural, isResourceRoot bool, expanding map[string]bool) *DeclType {
 	if s == nil {
 		return nil
 	}
+	if len(s.Definition) > 0 {
+		if expanding[s.Definition] {
+			return DynType
+		}
+		expanding[s.Definition] = true
+		defer delete(expanding, s.Definition)
+	}
+	if s.XValueType != nil {
+		// quantities and durations are exposed as comparable typed values, whether
+		// they are strings or x-kubernetes-int-or-string.
+		switch *s.XValueType {
+		case "quantity":
+			return DoubleType
+		case "duration":
+			return DurationType
+		}
+	}
 	if s.XIntOrString {
 		// schemas using XIntOrString are not required to have a type.
 
@@ -60,7 +85,7 @@ func SchemaDeclType(s *schema.Structural, isResourceRoot bool) *DeclType {
 	switch s.Type {
 	case "array":
 		if s.Items != nil {
-			itemsType := SchemaDeclType(s.Items, s.Items.XEmbeddedResource)
+			itemsType := schemaDeclType(s.Items, s.Items.XEmbeddedResource, expanding)
 			if itemsType != nil {
 				return NewListType(itemsType)
 			}
@@ -68,7 +93,7 @@ func SchemaDeclType(s *schema.Structural, isResourceRoot bool) *DeclType {
 		return nil
 	case "object":
 		if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
-			propsType := SchemaDeclType(s.AdditionalProperties.Structural, s.AdditionalProperties.Structural.XEmbeddedResource)
+			propsType := schemaDeclType(s.AdditionalProperties.Structural, s.AdditionalProperties.Structural.XEmbeddedResource, expanding)
 			if propsType != nil {
 				return NewMapType(StringType, propsType)
 			}
@@ -89,7 +114,7 @@ func SchemaDeclType(s *schema.Structural, isResourceRoot bool) *DeclType {
 					enumValues = append(enumValues, e.Object)
 				}
 			}
-			if fieldType := SchemaDeclType(&prop, prop.XEmbeddedResource); fieldType != nil {
+			if fieldType := schemaDeclType(&prop, prop.XEmbeddedResource, expanding); fieldType != nil {
 				if propName, ok := Escape(name); ok {
 					fields[propName] = &DeclField{
 						Name:         propName,